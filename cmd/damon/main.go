@@ -13,6 +13,10 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/rivo/tview"
 
+	"github.com/hcjulz/damon/config"
+	"github.com/hcjulz/damon/layout"
+	"github.com/hcjulz/damon/logbuffer"
+	"github.com/hcjulz/damon/models"
 	"github.com/hcjulz/damon/nomad"
 	"github.com/hcjulz/damon/state"
 	"github.com/hcjulz/damon/styles"
@@ -26,7 +30,12 @@ import (
 var refreshIntervalDefault = time.Second * 2
 
 type options struct {
-	Version bool `short:"v" long:"version" description:"Show Damon version"`
+	Version    bool   `short:"v" long:"version" description:"Show Damon version"`
+	Split      string `long:"split" choice:"off" choice:"side" choice:"stacked" default:"off" description:"Show a detail panel for the selected row next to (side) or below (stacked) the main table"`
+	SplitRatio int    `long:"split-ratio" default:"6" description:"Share of the main table when the detail panel is shown (2-8, out of 10)"`
+	Config     string `long:"config" description:"Path of the config file which holds the bookmarks (default: damon/config.json in the user config directory)"`
+	LogLines   int    `long:"log-lines" default:"1000" description:"Number of log lines which are kept and shown when tailing logs"`
+	StartView  string `long:"start-view" choice:"jobs" choice:"overview" default:"jobs" description:"The view shown on start, the jobs table or the cluster overview"`
 }
 
 func main() {
@@ -44,13 +53,21 @@ func main() {
 		os.Exit(0)
 	}
 
-	nomadClient, err := nomad.New(nomad.Default)
+	nomadClient, err := nomad.New(nomad.Default, nomad.WithLogLines(opts.LogLines))
 	if err != nil {
 		fmt.Println("failed to generate Nomad client: ", err)
 		os.Exit(1)
 	}
 
+	// a broken config shouldn't keep Damon from starting,
+	// it starts without bookmarks and reports the error.
+	cfg, cfgErr := loadConfig(opts.Config)
+	if cfgErr != nil {
+		cfg = &config.Config{}
+	}
+
 	state := initializeState(nomadClient)
+	state.LogBuffer = logbuffer.New(opts.LogLines)
 
 	clusterInfo := component.NewClusterInfo()
 	selections := component.NewSelections(state)
@@ -65,13 +82,48 @@ func main() {
 	taskGroups := component.NewTaskGroupTable()
 	taskEvents := component.NewTaskEventsTable()
 	taskTable := component.NewTaskTable()
+	allocFS := component.NewAllocFSTable()
+	taskInfo := component.NewTaskInfo()
+	allocInfo := component.NewAllocInfo()
+	variables := component.NewVariableTable()
+	variableItems := component.NewVariableItemsTable()
+	services := component.NewServiceTable()
+	serviceInstances := component.NewServiceInstanceTable()
+	volumes := component.NewVolumeTable()
+	volumeClaims := component.NewVolumeClaimTable()
+	plugins := component.NewPluginTable()
+	aclPolicies := component.NewACLPolicyTable()
+	aclPolicyRules := component.NewACLPolicyRules()
+	aclRoles := component.NewACLRoleTable()
+	aclTokens := component.NewACLTokenTable()
+	overview := component.NewClusterOverview()
+	servers := component.NewServerTable()
+	nodes := component.NewNodeTable()
+	nodeInfo := component.NewNodeInfo()
+	nodePools := component.NewNodePoolTable()
+	evaluations := component.NewEvaluationTable()
+	jobVersions := component.NewJobVersionTable()
+	jobSpec := component.NewJobSpec()
+	deploymentInfo := component.NewDeploymentInfo()
 	logs := component.NewLogger()
 	jumpToJob := component.NewJumpToJob()
+	bookmarks := component.NewBookmarks()
+	bookmarkName := component.NewSearchField("bookmark")
+	scale := component.NewSearchField("count")
+	specFind := component.NewSearchField("find")
 	logSearch := component.NewSearchField("/")
 	logHighlight := component.NewSearchField("highlight")
+	logFind := component.NewSearchField("find")
+	logSave := component.NewSearchField("save to")
+	logStart := component.NewSearchField("from (start, end, +bytes, -bytes, lines)")
+	jobStatusDetail := component.NewJobStatus()
+	taskDetail := component.NewTaskTable()
+	taskEventsDetail := component.NewTaskEventsTable()
+	serviceCheckDetail := component.NewServiceCheckTable()
 	errorComp := component.NewError()
 	info := component.NewInfo()
 	failure := component.NewInfo()
+	denied := component.NewInfo()
 	confirm := component.NewModal(
 		"confirm",
 		"confirm",
@@ -93,20 +145,62 @@ func main() {
 		TaskGroupTable:  taskGroups,
 		TaskEventsTable: taskEvents,
 		TaskTable:       taskTable,
+		AllocFSTable:    allocFS,
+		TaskInfo:        taskInfo,
+		AllocInfo:       allocInfo,
+		VariableTable:   variables,
+		VariableItems:   variableItems,
+		ServiceTable:    services,
+		VolumeTable:     volumes,
+		PluginTable:     plugins,
+		ACLPolicyTable:  aclPolicies,
+		ACLPolicyRules:  aclPolicyRules,
+		ACLRoleTable:    aclRoles,
+		ACLTokenTable:   aclTokens,
+		ClusterOverview: overview,
+		ServerTable:     servers,
+		NodeTable:       nodes,
+		NodeInfo:        nodeInfo,
+		NodePoolTable:   nodePools,
+		EvaluationTable: evaluations,
+		JobVersionTable: jobVersions,
+		JobSpec:         jobSpec,
+		DeploymentInfo:  deploymentInfo,
 		LogStream:       logs,
 		LogHighlight:    logHighlight,
+		LogFind:         logFind,
+		LogSave:         logSave,
+		LogStart:        logStart,
 		JumpToJob:       jumpToJob,
 		Error:           errorComp,
 		Info:            info,
 		Failure:         failure,
+		Denied:          denied,
 		LogSearch:       logSearch,
 		Confirm:         confirm,
+		Bookmarks:       bookmarks,
+		BookmarkName:    bookmarkName,
+		Scale:           scale,
+		SpecFind:        specFind,
+
+		ServiceInstanceTable: serviceInstances,
+		VolumeClaimTable:     volumeClaims,
+
+		JobStatusDetail:  jobStatusDetail,
+		TaskDetail:       taskDetail,
+		TaskEventsDetail: taskEventsDetail,
+
+		ServiceCheckDetail: serviceCheckDetail,
 	}
 
 	watcher := watcher.NewWatcher(state, nomadClient, refreshIntervalDefault)
 	go watcher.Watch()
 
 	view := view.New(components, watcher, nomadClient, state)
+	view.Config = cfg
+	view.ConfigErr = cfgErr
+	view.StartView = opts.StartView
+	view.Layout.SetSplit(layout.SplitMode(opts.Split), opts.SplitRatio)
 	view.Init(version.GetHumanVersion())
 
 	err = view.Layout.Container.Run()
@@ -115,6 +209,18 @@ func main() {
 	}
 }
 
+func loadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		var err error
+		path, err = config.DefaultPath()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return config.Load(path)
+}
+
 func initializeState(client *nomad.Nomad) *state.State {
 	state := state.New()
 	namespaces, err := client.Namespaces(nil)
@@ -122,8 +228,14 @@ func initializeState(client *nomad.Nomad) *state.State {
 		log.Fatal("cannot initialize view. Is Nomad running?")
 	}
 
+	token, err := client.Token()
+	if err != nil {
+		token = &models.Token{Unresolved: true}
+	}
+
 	state.NomadAddress = client.Address()
 	state.Namespaces = namespaces
+	state.Token = token
 
 	return state
 }
@@ -0,0 +1,459 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleACLPolicies = "ACL Policies"
+	TableTitleACLRoles    = "ACL Roles"
+	TableTitleACLTokens   = "ACL Tokens"
+	TitleACLPolicy        = "ACL Policy"
+)
+
+var (
+	TableHeaderACLPolicies = []string{
+		LabelName,
+		LabelDescription,
+		LabelModifyIndex,
+	}
+
+	TableHeaderACLRoles = []string{
+		LabelName,
+		LabelID,
+		LabelDescription,
+		LabelPolicies,
+	}
+
+	TableHeaderACLTokens = []string{
+		LabelAccessorID,
+		LabelName,
+		LabelType,
+		LabelGlobal,
+		LabelPolicies,
+		LabelRoles,
+		LabelCreated,
+		LabelExpires,
+	}
+)
+
+type SelectACLPolicyFunc func(name string)
+
+// ACLPolicyTable lists the ACL policies.
+type ACLPolicyTable struct {
+	Table Table
+	Props *ACLPolicyTableProps
+
+	slot *tview.Flex
+}
+
+type ACLPolicyTableProps struct {
+	SelectPolicy      SelectACLPolicyFunc
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.ACLPolicy
+}
+
+func NewACLPolicyTable() *ACLPolicyTable {
+	return &ACLPolicyTable{
+		Table: primitive.NewTable(),
+		Props: &ACLPolicyTableProps{},
+	}
+}
+
+func (t *ACLPolicyTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ACLPolicyTable) Render() error {
+	if t.Props.SelectPolicy == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno ACL policies found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.policySelected)
+
+	t.Table.SetTitle(TableTitleACLPolicies)
+	t.Table.RenderHeader(TableHeaderACLPolicies)
+
+	for i, p := range t.Props.Data {
+		row := []string{
+			p.Name,
+			p.Description,
+			fmt.Sprint(p.ModifyIndex),
+		}
+
+		t.Table.RenderRow(row, i+1, tcell.ColorWhite)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// GetNameForSelection returns the name of the selected policy.
+func (t *ACLPolicyTable) GetNameForSelection() string {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return ""
+	}
+
+	return t.Table.GetCellContent(row, 0)
+}
+
+func (t *ACLPolicyTable) policySelected(row, column int) {
+	if name := t.GetNameForSelection(); name != "" {
+		t.Props.SelectPolicy(name)
+	}
+}
+
+// ACLRoleTable lists the ACL roles with their policies.
+type ACLRoleTable struct {
+	Table Table
+	Props *ACLRoleTableProps
+
+	slot *tview.Flex
+}
+
+type ACLRoleTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.ACLRole
+}
+
+func NewACLRoleTable() *ACLRoleTable {
+	return &ACLRoleTable{
+		Table: primitive.NewTable(),
+		Props: &ACLRoleTableProps{},
+	}
+}
+
+func (t *ACLRoleTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ACLRoleTable) Render() error {
+	if t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno ACL roles found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle(TableTitleACLRoles)
+	t.Table.RenderHeader(TableHeaderACLRoles)
+
+	for i, role := range t.Props.Data {
+		row := []string{
+			role.Name,
+			role.ID,
+			role.Description,
+			strings.Join(role.Policies, ","),
+		}
+
+		t.Table.RenderRow(row, i+1, tcell.ColorWhite)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// ACLTokenTable lists the ACL tokens. Management tokens
+// are highlighted, expired tokens are marked.
+type ACLTokenTable struct {
+	Table Table
+	Props *ACLTokenTableProps
+
+	slot *tview.Flex
+}
+
+type ACLTokenTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.ACLToken
+}
+
+func NewACLTokenTable() *ACLTokenTable {
+	return &ACLTokenTable{
+		Table: primitive.NewTable(),
+		Props: &ACLTokenTableProps{},
+	}
+}
+
+func (t *ACLTokenTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ACLTokenTable) Render() error {
+	if t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno ACL tokens found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle(TableTitleACLTokens)
+	t.Table.RenderHeader(TableHeaderACLTokens)
+
+	now := time.Now()
+	for i, token := range t.Props.Data {
+		expires := "never"
+		c := tcell.ColorWhite
+		if token.Expires != nil {
+			expires = token.Expires.Format(time.RFC3339)
+			if token.Expires.Before(now) {
+				expires = fmt.Sprintf("%s (expired)", expires)
+				c = tcell.ColorGray
+			}
+		}
+
+		if token.Type == models.TokenTypeManagement {
+			c = tcell.GetColor(styles.ColorWarningHex)
+		}
+
+		row := []string{
+			token.AccessorID,
+			token.Name,
+			token.Type,
+			fmt.Sprint(token.Global),
+			strings.Join(token.Policies, ","),
+			strings.Join(token.Roles, ","),
+			token.Created.Format(time.RFC3339),
+			expires,
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// GetTokenForSelection returns the selected token, or
+// nil if no token is selected.
+func (t *ACLTokenTable) GetTokenForSelection() *models.ACLToken {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return nil
+	}
+
+	accessorID := t.Table.GetCellContent(row, 0)
+	for _, token := range t.Props.Data {
+		if token.AccessorID == accessorID {
+			return token
+		}
+	}
+
+	return nil
+}
+
+// ACLPolicyRules shows the rules of an ACL
+// policy with HCL syntax highlighting.
+type ACLPolicyRules struct {
+	TextView TextView
+	Props    *ACLPolicyRulesProps
+	slot     *tview.Flex
+}
+
+type ACLPolicyRulesProps struct {
+	Data *models.ACLPolicy
+}
+
+func NewACLPolicyRules() *ACLPolicyRules {
+	return &ACLPolicyRules{
+		TextView: primitive.NewTextView(tview.AlignLeft),
+		Props:    &ACLPolicyRulesProps{},
+	}
+}
+
+func (p *ACLPolicyRules) Bind(slot *tview.Flex) {
+	p.slot = slot
+}
+
+func (p *ACLPolicyRules) Render() error {
+	if p.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	p.slot.Clear()
+
+	policy := p.Props.Data
+	if policy == nil {
+		p.TextView.SetText("Policy not available.")
+		p.slot.AddItem(p.TextView.Primitive(), 0, 1, true)
+		return nil
+	}
+
+	p.TextView.ModifyPrimitive(func(textView *tview.TextView) {
+		textView.SetScrollable(true)
+		textView.SetBorder(true)
+		textView.SetTitle(fmt.Sprintf("%s (%s)", TitleACLPolicy, policy.Name))
+	})
+
+	text := HighlightHCL(policy.Rules)
+	if policy.Description != "" {
+		text = fmt.Sprintf("%s%s%s\n\n%s", styles.ColorLighGreyTag, tview.Escape(policy.Description), styles.StandardColorTag, text)
+	}
+
+	p.TextView.SetText(text)
+	p.slot.AddItem(p.TextView.Primitive(), 0, 1, true)
+	return nil
+}
+
+// HighlightHCL colours HCL for a text view with dynamic colors:
+// block types, attribute names, strings and comments get their
+// own colour. Everything else is escaped and left as it is.
+func HighlightHCL(src string) string {
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		lines[i] = highlightHCLLine(line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func highlightHCLLine(line string) string {
+	var b, plain strings.Builder
+
+	// plain text is escaped in runs, such that brackets
+	// like [1, 2] aren't taken for tags
+	flush := func() {
+		b.WriteString(tview.Escape(plain.String()))
+		plain.Reset()
+	}
+	colored := func(tag, s string) {
+		flush()
+		b.WriteString(tag)
+		b.WriteString(tview.Escape(s))
+		b.WriteString(styles.ColorWhiteTag)
+	}
+
+	b.WriteString(styles.ColorWhiteTag)
+
+	first := true
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == '#' || strings.HasPrefix(line[i:], "//"):
+			colored(styles.ColorLighGreyTag, line[i:])
+			return b.String()
+
+		case c == '"':
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end < len(line) {
+				end++
+			}
+			if end > len(line) {
+				end = len(line)
+			}
+
+			colored(styles.HighlightPrimaryTag, line[i:end])
+			i, first = end, false
+
+		case isIdentStart(c):
+			end := i + 1
+			for end < len(line) && isIdentPart(line[end]) {
+				end++
+			}
+
+			word := line[i:end]
+			rest := strings.TrimLeft(line[end:], " \t")
+			switch {
+			case strings.HasPrefix(rest, "=") && !strings.HasPrefix(rest, "=="):
+				colored(styles.ColorActiveTag, word)
+			case first:
+				colored(styles.HighlightSecondaryTag, word)
+			default:
+				plain.WriteString(word)
+			}
+			i, first = end, false
+
+		default:
+			if !unicode.IsSpace(rune(c)) {
+				first = false
+			}
+
+			plain.WriteByte(c)
+			i++
+		}
+	}
+
+	flush()
+	return b.String()
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || c == '-' || (c >= '0' && c <= '9')
+}
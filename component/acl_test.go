@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestACLPolicyTable(t *testing.T) {
+	t.Run("It renders the policies", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		pt := component.NewACLPolicyTable()
+		pt.Table = fakeTable
+		pt.Props.Data = []*models.ACLPolicy{
+			{Name: "read", Description: "read all", ModifyIndex: 12},
+		}
+
+		var selected string
+		pt.Props.SelectPolicy = func(name string) {
+			selected = name
+		}
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		pt.Bind(tview.NewFlex())
+
+		r.NoError(pt.Render())
+
+		r.Equal(component.TableHeaderACLPolicies, fakeTable.RenderHeaderArgsForCall(0))
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"read", "read all", "12"}, row)
+		r.Equal(tcell.ColorWhite, c)
+
+		fakeTable.GetSelectionReturns(1, 0)
+		fakeTable.GetCellContentReturns("read")
+		fakeTable.SetSelectedFuncArgsForCall(0)(1, 0)
+		r.Equal("read", selected)
+	})
+
+	t.Run("When there are no policies", func(t *testing.T) {
+		r := require.New(t)
+
+		pt := component.NewACLPolicyTable()
+		pt.Table = &componentfakes.FakeTable{}
+
+		var called bool
+		pt.Props.SelectPolicy = func(name string) {}
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		pt.Bind(tview.NewFlex())
+
+		r.NoError(pt.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		pt := component.NewACLPolicyTable()
+		r.ErrorIs(pt.Render(), component.ErrComponentPropsNotSet)
+
+		pt.Props.SelectPolicy = func(name string) {}
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(pt.Render(), component.ErrComponentNotBound)
+	})
+}
+
+func TestACLRoleTable(t *testing.T) {
+	r := require.New(t)
+
+	fakeTable := &componentfakes.FakeTable{}
+	rt := component.NewACLRoleTable()
+	rt.Table = fakeTable
+	rt.Props.Data = []*models.ACLRole{
+		{ID: "1", Name: "ops", Description: "operators", Policies: []string{"write", "node"}},
+	}
+	rt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+	r.ErrorIs(rt.Render(), component.ErrComponentNotBound)
+
+	rt.Bind(tview.NewFlex())
+	r.NoError(rt.Render())
+
+	row, _, _ := fakeTable.RenderRowArgsForCall(0)
+	r.Equal([]string{"ops", "1", "operators", "write,node"}, row)
+}
+
+func TestACLTokenTable(t *testing.T) {
+	t.Run("It renders the tokens", func(t *testing.T) {
+		r := require.New(t)
+
+		created := time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC)
+		expired := time.Date(2023, 7, 2, 10, 0, 0, 0, time.UTC)
+
+		fakeTable := &componentfakes.FakeTable{}
+		tt := component.NewACLTokenTable()
+		tt.Table = fakeTable
+		tt.Props.Data = []*models.ACLToken{
+			{AccessorID: "a", Name: "root", Type: "management", Global: true, Created: created},
+			{AccessorID: "b", Name: "ci", Type: "client", Policies: []string{"deploy"}, Roles: []string{"dev"}, Created: created, Expires: &expired},
+		}
+		tt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		tt.Bind(tview.NewFlex())
+
+		r.NoError(tt.Render())
+
+		r.Equal(component.TableHeaderACLTokens, fakeTable.RenderHeaderArgsForCall(0))
+
+		// It highlights management tokens
+		row1, _, c1 := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"a", "root", "management", "true", "", "", "2023-07-01T10:00:00Z", "never"}, row1)
+		r.Equal(tcell.GetColor(styles.ColorWarningHex), c1)
+
+		// It marks expired tokens
+		row2, _, c2 := fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"b", "ci", "client", "false", "deploy", "dev", "2023-07-01T10:00:00Z", "2023-07-02T10:00:00Z (expired)"}, row2)
+		r.Equal(tcell.ColorGray, c2)
+
+		fakeTable.GetSelectionReturns(2, 0)
+		fakeTable.GetCellContentReturns("b")
+		r.Equal(tt.Props.Data[1], tt.GetTokenForSelection())
+	})
+
+	t.Run("When the props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		tt := component.NewACLTokenTable()
+		r.ErrorIs(tt.Render(), component.ErrComponentPropsNotSet)
+	})
+}
+
+func TestACLPolicyRules(t *testing.T) {
+	r := require.New(t)
+
+	textView := &componentfakes.FakeTextView{}
+	pr := component.NewACLPolicyRules()
+	pr.TextView = textView
+
+	r.ErrorIs(pr.Render(), component.ErrComponentNotBound)
+
+	pr.Bind(tview.NewFlex())
+	pr.Props.Data = &models.ACLPolicy{Name: "read", Description: "read all", Rules: `namespace "*" {}`}
+	r.NoError(pr.Render())
+
+	text := textView.SetTextArgsForCall(0)
+	r.Contains(text, "read all")
+	r.Contains(text, component.HighlightHCL(`namespace "*" {}`))
+}
+
+func TestHighlightHCL(t *testing.T) {
+	r := require.New(t)
+
+	src := `# read everything
+namespace "*" {
+  policy       = "read"
+  capabilities = ["submit-job"]
+}`
+
+	w := styles.ColorWhiteTag
+	r.Equal(
+		w+styles.ColorLighGreyTag+"# read everything"+w+"\n"+
+			w+styles.HighlightSecondaryTag+"namespace"+w+" "+styles.HighlightPrimaryTag+`"*"`+w+" {\n"+
+			w+"  "+styles.ColorActiveTag+"policy"+w+"       = "+styles.HighlightPrimaryTag+`"read"`+w+"\n"+
+			w+"  "+styles.ColorActiveTag+"capabilities"+w+" = "+tview.Escape("[")+styles.HighlightPrimaryTag+`"submit-job"`+w+"]\n"+
+			w+"}",
+		component.HighlightHCL(src),
+	)
+
+	// It escapes brackets which look like tags
+	r.Equal(w+styles.ColorActiveTag+"ports"+w+" = [1, 2[]", component.HighlightHCL("ports = [1, 2]"))
+}
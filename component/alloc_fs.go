@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const TableTitleAllocFS = "Files"
+
+var (
+	TableHeaderAllocFS = []string{
+		LabelName,
+		LabelSize,
+		LabelMode,
+		LabelModified,
+	}
+)
+
+type SelectFileFunc func(file *models.AllocFile)
+
+// AllocFSTable lists a directory in the filesystem of an allocation.
+type AllocFSTable struct {
+	Table Table
+	Props *AllocFSTableProps
+
+	slot *tview.Flex
+}
+
+type AllocFSTableProps struct {
+	SelectFile        SelectFileFunc
+	HandleNoResources models.HandlerFunc
+
+	AllocID string
+	Path    string
+
+	Data []*models.AllocFile
+}
+
+func NewAllocFSTable() *AllocFSTable {
+	return &AllocFSTable{
+		Table: primitive.NewTable(),
+		Props: &AllocFSTableProps{},
+	}
+}
+
+func (t *AllocFSTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *AllocFSTable) Render() error {
+	if t.Props.SelectFile == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno files in %s\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			t.Props.Path,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.fileSelected)
+
+	t.Table.SetTitle("%s (%s:%s)", TableTitleAllocFS, shortID(t.Props.AllocID), t.Props.Path)
+	t.Table.RenderHeader(TableHeaderAllocFS)
+	t.renderRows()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *AllocFSTable) renderRows() {
+	for i, f := range t.Props.Data {
+		name, size, c := f.Name, FormatBytes(f.Size), tcell.ColorWhite
+		if f.IsDir {
+			name, size, c = f.Name+"/", "-", styles.TcellColorHighlighPrimary
+		}
+
+		row := []string{
+			name,
+			size,
+			f.Mode,
+			f.Modified.Format(time.RFC3339),
+		}
+
+		index := i + 1
+		t.Table.RenderRow(row, index, c)
+	}
+
+	t.Table.Commit()
+}
+
+func (t *AllocFSTable) fileSelected(row, column int) {
+	if file := t.GetFileForSelection(); file != nil {
+		t.Props.SelectFile(file)
+	}
+}
+
+// GetFileForSelection returns the selected file or nil. The file
+// is looked up by its name, as the rows may be sorted.
+func (t *AllocFSTable) GetFileForSelection() *models.AllocFile {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return nil
+	}
+
+	name := t.Table.GetCellContent(row, 0)
+	dir := strings.HasSuffix(name, "/")
+	name = strings.TrimSuffix(name, "/")
+	for _, f := range t.Props.Data {
+		if f.Name == name && f.IsDir == dir {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// FormatBytes formats a number of bytes with a binary unit, e.g. 1.5KiB.
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
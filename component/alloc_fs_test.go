@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestAllocFSTable_Happy(t *testing.T) {
+	r := require.New(t)
+
+	modified := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	fakeTable := &componentfakes.FakeTable{}
+	fs := component.NewAllocFSTable()
+	fs.Table = fakeTable
+	fs.Props.AllocID = "a1b2c3d4-e5f6"
+	fs.Props.Path = "/web"
+	fs.Props.Data = []*models.AllocFile{
+		{Name: "local", Path: "/web/local", IsDir: true, Mode: "drwxrwxrwx", Modified: modified},
+		{Name: "template.env", Path: "/web/template.env", Size: 1536, Mode: "-rw-r--r--", Modified: modified},
+	}
+
+	var selected *models.AllocFile
+	fs.Props.SelectFile = func(file *models.AllocFile) {
+		selected = file
+	}
+	fs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+	fs.Bind(tview.NewFlex())
+
+	err := fs.Render()
+	r.NoError(err)
+
+	// It renders the header and the title
+	r.Equal(component.TableHeaderAllocFS, fakeTable.RenderHeaderArgsForCall(0))
+	format, args := fakeTable.SetTitleArgsForCall(0)
+	r.Equal("Files (a1b2c3d4:/web)", fmt.Sprintf(format, args...))
+
+	// It renders directories with a trailing slash
+	row1, index1, c1 := fakeTable.RenderRowArgsForCall(0)
+	r.Equal([]string{"local/", "-", "drwxrwxrwx", "2023-01-02T15:04:05Z"}, row1)
+	r.Equal(1, index1)
+	r.Equal(styles.TcellColorHighlighPrimary, c1)
+
+	row2, index2, c2 := fakeTable.RenderRowArgsForCall(1)
+	r.Equal([]string{"template.env", "1.5KiB", "-rw-r--r--", "2023-01-02T15:04:05Z"}, row2)
+	r.Equal(2, index2)
+	r.Equal(tcell.ColorWhite, c2)
+	r.Equal(1, fakeTable.CommitCallCount())
+
+	// It selects the file of the row
+	fakeTable.GetSelectionReturns(2, 0)
+	fakeTable.GetCellContentReturns("template.env")
+	selectFn := fakeTable.SetSelectedFuncArgsForCall(0)
+	selectFn(2, 0)
+	r.Equal(fs.Props.Data[1], selected)
+
+	// The header isn't a file
+	fakeTable.GetSelectionReturns(0, 0)
+	r.Nil(fs.GetFileForSelection())
+}
+
+func TestAllocFSTable_Sorted(t *testing.T) {
+	r := require.New(t)
+
+	table := primitive.NewTable()
+	fs := component.NewAllocFSTable()
+	fs.Table = table
+	fs.Props.Data = []*models.AllocFile{
+		{Name: "a.txt", Path: "/a.txt"},
+		{Name: "b.txt", Path: "/b.txt"},
+		{Name: "logs", Path: "/logs", IsDir: true},
+	}
+	fs.Props.SelectFile = func(file *models.AllocFile) {}
+	fs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+	fs.Bind(tview.NewFlex())
+
+	r.NoError(fs.Render())
+
+	// It selects the file shown in the row, not the
+	// file rendered in the row before the sort
+	table.SortBy(0, true)
+	table.Primitive().(*tview.Table).Select(1, 0)
+	r.Equal("logs/", fs.Table.GetCellContent(1, 0))
+	r.Equal(fs.Props.Data[2], fs.GetFileForSelection())
+
+	table.Primitive().(*tview.Table).Select(3, 0)
+	r.Equal("a.txt", fs.Table.GetCellContent(3, 0))
+	r.Equal(fs.Props.Data[0], fs.GetFileForSelection())
+}
+
+func TestAllocFSTable_Sad(t *testing.T) {
+	t.Run("When the directory is empty", func(t *testing.T) {
+		r := require.New(t)
+
+		fs := component.NewAllocFSTable()
+		fs.Table = &componentfakes.FakeTable{}
+		fs.Props.Path = "/web/secrets"
+		fs.Props.SelectFile = func(file *models.AllocFile) {}
+
+		var called bool
+		fs.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+			r.Equal("/web/secrets", args[1])
+		}
+
+		fs.Bind(tview.NewFlex())
+		r.NoError(fs.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		fs := component.NewAllocFSTable()
+		r.ErrorIs(fs.Render(), component.ErrComponentPropsNotSet)
+
+		fs.Props.SelectFile = func(file *models.AllocFile) {}
+		fs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(fs.Render(), component.ErrComponentNotBound)
+	})
+}
+
+func TestFormatBytes(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("0B", component.FormatBytes(0))
+	r.Equal("1023B", component.FormatBytes(1023))
+	r.Equal("1.0KiB", component.FormatBytes(1024))
+	r.Equal("2.5MiB", component.FormatBytes(5<<19))
+}
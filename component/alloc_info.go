@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+)
+
+const (
+	TitleAllocInfo = "Allocation"
+)
+
+// AllocInfo shows the details of an allocation: why it is in its
+// state, the allocations it replaced, its deployment health, ports
+// and how the scheduler placed it.
+type AllocInfo struct {
+	TextView TextView
+	Props    *AllocInfoProps
+	slot     *tview.Flex
+}
+
+type AllocInfoProps struct {
+	Data *models.AllocDetail
+}
+
+func NewAllocInfo() *AllocInfo {
+	return &AllocInfo{
+		TextView: primitive.NewTextView(tview.AlignLeft),
+		Props:    &AllocInfoProps{},
+	}
+}
+
+func (a *AllocInfo) Bind(slot *tview.Flex) {
+	a.slot = slot
+}
+
+func (a *AllocInfo) Render() error {
+	if a.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	a.slot.Clear()
+
+	alloc := a.Props.Data
+	if alloc == nil {
+		a.TextView.SetText("Allocation not available.")
+		a.slot.AddItem(a.TextView.Primitive(), 0, 1, true)
+		return nil
+	}
+
+	title := fmt.Sprintf("%s (%s)", TitleAllocInfo, alloc.ID)
+	a.TextView.ModifyPrimitive(func(textView *tview.TextView) {
+		textView.SetScrollable(true)
+		textView.SetBorder(true)
+		textView.SetTitle(title)
+	})
+
+	sections := []string{
+		"\n",
+		a.renderInfo(),
+		fmt.Sprintf("\n  %s\n", LabelRescheduleTrail),
+		a.renderReschedules(),
+		fmt.Sprintf("\n  %s\n", LabelDeployment),
+		a.renderDeployment(),
+		fmt.Sprintf("\n  %s\n", LabelPorts),
+		a.renderPorts(),
+		fmt.Sprintf("\n  %s\n", LabelPlacementMetrics),
+		a.renderMetrics(),
+		fmt.Sprintf("\n  %s\n", LabelScores),
+		a.renderScores(),
+	}
+
+	a.TextView.SetText(tview.Escape(strings.Join(sections, "")))
+	a.slot.AddItem(a.TextView.Primitive(), 0, 1, true)
+	return nil
+}
+
+func (a *AllocInfo) renderInfo() string {
+	alloc := a.Props.Data
+
+	return renderKeyValues([][]string{
+		{LabelID, alloc.ID},
+		{LabelName, alloc.Name},
+		{LabelNamespace, alloc.Namespace},
+		{LabelJobID, alloc.JobID},
+		{LabelVersion, fmt.Sprint(alloc.JobVersion)},
+		{LabelTaskGroup, alloc.TaskGroup},
+		{LabelNodeName, alloc.NodeName},
+		{LabelNodeID, alloc.NodeID},
+		{LabelDesiredStatus, alloc.DesiredStatus},
+		{LabelDesiredDescription, orDash(alloc.DesiredDescription)},
+		{LabelStatus, alloc.Status},
+		{LabelStatusDescriptionLong, orDash(alloc.StatusDescription)},
+		{LabelCreated, formatTime(alloc.Created)},
+		{LabelModified, formatTime(alloc.Modified)},
+		{LabelPreviousAlloc, orDash(alloc.PreviousAllocation)},
+		{LabelNextAlloc, orDash(alloc.NextAllocation)},
+	})
+}
+
+func (a *AllocInfo) renderReschedules() string {
+	events := a.Props.Data.Reschedules
+	if len(events) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+
+		rows = append(rows, []string{
+			formatTime(time.Unix(0, e.RescheduleTime)),
+			e.PrevAllocID,
+			shortID(e.PrevNodeID),
+		})
+	}
+
+	return renderTable([]string{LabelTime, LabelPreviousAlloc, LabelPreviousNode}, rows)
+}
+
+func (a *AllocInfo) renderDeployment() string {
+	alloc := a.Props.Data
+	if alloc.DeploymentID == "" {
+		return "  -\n"
+	}
+
+	healthy, canary, modified := "-", "false", "-"
+	if s := alloc.DeploymentStatus; s != nil {
+		if s.Healthy != nil {
+			healthy = fmt.Sprint(*s.Healthy)
+		}
+
+		canary = fmt.Sprint(s.Canary)
+		if !s.Timestamp.IsZero() {
+			modified = formatTime(s.Timestamp)
+		}
+	}
+
+	return renderKeyValues([][]string{
+		{LabelID, alloc.DeploymentID},
+		{LabelHealthy, healthy},
+		{LabelCanary, canary},
+		{LabelModified, modified},
+	})
+}
+
+func (a *AllocInfo) renderPorts() string {
+	ports := a.Props.Data.Ports
+	if len(ports) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, p := range ports {
+		to := "-"
+		if p.To > 0 {
+			to = fmt.Sprint(p.To)
+		}
+
+		rows = append(rows, []string{
+			p.Label,
+			fmt.Sprintf("%s:%d", p.HostIP, p.Value),
+			to,
+		})
+	}
+
+	return renderTable([]string{LabelLabel, LabelHostAddress, LabelTo}, rows)
+}
+
+func (a *AllocInfo) renderMetrics() string {
+	m := a.Props.Data.Metrics
+	if m == nil {
+		return "  -\n"
+	}
+
+	available := []string{}
+	for _, dc := range sortedCountKeys(m.NodesAvailable) {
+		available = append(available, fmt.Sprintf("%s=%d", dc, m.NodesAvailable[dc]))
+	}
+
+	info := renderKeyValues([][]string{
+		{LabelNodesEvaluated, fmt.Sprint(m.NodesEvaluated)},
+		{LabelNodesFiltered, fmt.Sprint(m.NodesFiltered)},
+		{LabelNodesExhausted, fmt.Sprint(m.NodesExhausted)},
+		{LabelNodesAvailable, orDash(strings.Join(available, ", "))},
+		{LabelAllocationTime, m.AllocationTime.String()},
+	})
+
+	rows := [][]string{}
+	appendCounts := func(prefix string, counts map[string]int) {
+		for _, k := range sortedCountKeys(counts) {
+			rows = append(rows, []string{prefix + k, fmt.Sprint(counts[k])})
+		}
+	}
+
+	appendCounts("constraint ", m.ConstraintFiltered)
+	appendCounts("class ", m.ClassFiltered)
+	appendCounts("exhausted class ", m.ClassExhausted)
+	appendCounts("exhausted ", m.DimensionExhausted)
+	for _, q := range m.QuotaExhausted {
+		rows = append(rows, []string{"quota " + q, "-"})
+	}
+
+	if len(rows) == 0 {
+		return info
+	}
+
+	return info + "\n" + renderTable([]string{LabelReason, LabelNodes}, rows)
+}
+
+// renderScores renders the scores of the nodes the scheduler ranked
+// for the allocation, best node first.
+func (a *AllocInfo) renderScores() string {
+	m := a.Props.Data.Metrics
+	if m == nil || len(m.ScoreMetaData) == 0 {
+		return "  -\n"
+	}
+
+	scores := append([]*api.NodeScoreMeta{}, m.ScoreMetaData...)
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].NormScore > scores[j].NormScore
+	})
+
+	names := map[string]bool{}
+	for _, s := range scores {
+		for name := range s.Scores {
+			names[name] = true
+		}
+	}
+
+	columns := make([]string, 0, len(names))
+	for name := range names {
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+
+	header := append([]string{LabelNode}, columns...)
+	header = append(header, LabelFinalScore)
+
+	rows := [][]string{}
+	for _, s := range scores {
+		row := []string{shortID(s.NodeID)}
+		for _, name := range columns {
+			score, ok := s.Scores[name]
+			if !ok {
+				row = append(row, "-")
+				continue
+			}
+
+			row = append(row, fmt.Sprintf("%.3g", score))
+		}
+
+		row = append(row, fmt.Sprintf("%.3g", s.NormScore))
+		rows = append(rows, row)
+	}
+
+	return renderTable(header, rows)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return fmt.Sprintf("%s (%s ago)", t.Format("2006-01-02 15:04:05"), formatTimeSince(time.Since(t)))
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
+
+func sortedCountKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestAllocInfo(t *testing.T) {
+	healthy := false
+	alloc := &models.AllocDetail{
+		ID:                 "a1b2c3d4-e5f6",
+		Name:               "web.app[0]",
+		JobID:              "web",
+		JobVersion:         3,
+		TaskGroup:          "app",
+		NodeID:             "9f8e7d6c-5b4a",
+		NodeName:           "worker-1",
+		DesiredStatus:      "run",
+		Status:             "failed",
+		StatusDescription:  "Failed tasks",
+		PreviousAllocation: "0a0b0c0d-1234",
+		Reschedules: []*api.RescheduleEvent{
+			{RescheduleTime: time.Now().Add(-time.Hour).UnixNano(), PrevAllocID: "0a0b0c0d-1234", PrevNodeID: "1a2b3c4d-5678"},
+		},
+		DeploymentID:     "d1e2p3l4-oy",
+		DeploymentStatus: &api.AllocDeploymentStatus{Healthy: &healthy, Canary: true},
+		Ports: []api.PortMapping{
+			{Label: "http", Value: 25000, To: 8080, HostIP: "10.0.0.1"},
+		},
+		Metrics: &api.AllocationMetric{
+			NodesEvaluated:     4,
+			NodesFiltered:      2,
+			NodesAvailable:     map[string]int{"dc1": 4},
+			ConstraintFiltered: map[string]int{"${attr.kernel.name} = linux": 2},
+			DimensionExhausted: map[string]int{"memory": 1},
+			ScoreMetaData: []*api.NodeScoreMeta{
+				{NodeID: "2b3c4d5e-6789", Scores: map[string]float64{"binpack": 0.5}, NormScore: 0.5},
+				{NodeID: "9f8e7d6c-5b4a", Scores: map[string]float64{"binpack": 0.9, "node-reschedule-penalty": -1}, NormScore: 0.75},
+			},
+		},
+	}
+
+	t.Run("It renders the details of the allocation", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewAllocInfo()
+		info.TextView = textView
+		info.Props.Data = alloc
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+		text := textView.SetTextArgsForCall(0)
+
+		r.Regexp(`Version\s+= 3`, text)
+		r.Regexp(`NodeName\s+= worker-1`, text)
+		r.Regexp(`Desired Description\s+= -`, text)
+		r.Regexp(`Status Description\s+= Failed tasks`, text)
+		r.Regexp(`Previous Allocation\s+= 0a0b0c0d-1234`, text)
+		r.Regexp(`Next Allocation\s+= -`, text)
+
+		// the reschedule trail
+		r.Regexp(`\(1h ago\)\s+0a0b0c0d-1234\s+1a2b3c4d`, text)
+
+		// the deployment health
+		r.Regexp(`Healthy\s+= false`, text)
+		r.Regexp(`Canary\s+= true`, text)
+
+		// the ports
+		r.Regexp(`http\s+10.0.0.1:25000\s+8080`, text)
+
+		// the placement metrics
+		r.Regexp(`Nodes Evaluated\s+= 4`, text)
+		r.Regexp(`Nodes Filtered\s+= 2`, text)
+		r.Regexp(`Nodes Available\s+= dc1=4`, text)
+		r.Regexp(`constraint \$\{attr.kernel.name\} = linux\s+2`, text)
+		r.Regexp(`exhausted memory\s+1`, text)
+
+		// the scores, best node first
+		r.Regexp(`Node\s+binpack\s+node-reschedule-penalty\s+Final Score`, text)
+		r.Regexp(`9f8e7d6c\s+0.9\s+-1\s+0.75\s+2b3c4d5e\s+0.5\s+-\s+0.5`, text)
+	})
+
+	t.Run("When the allocation doesn't exist", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewAllocInfo()
+		info.TextView = textView
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+		r.Equal("Allocation not available.", textView.SetTextArgsForCall(0))
+	})
+
+	t.Run("When the component isn't bound", func(t *testing.T) {
+		r := require.New(t)
+
+		info := component.NewAllocInfo()
+		r.ErrorIs(info.Render(), component.ErrComponentNotBound)
+	})
+}
@@ -29,7 +29,12 @@ var (
 		LabelNodeID,
 		LabelNodeName,
 		LabelDesiredStatus,
+		LabelCPU,
+		LabelMemory,
 	}
+
+	// the usage columns are volatile, as they change with every sample
+	allocationUsageColumns = []int{9, 10}
 )
 
 type SelectAllocationFunc func(allocID string)
@@ -42,12 +47,20 @@ type AllocationTable struct {
 }
 
 type AllocationTableProps struct {
-	SelectAllocation  SelectAllocationFunc
-	HandleNoResources models.HandlerFunc
+	SelectAllocation    SelectAllocationFunc
+	HighlightAllocation SelectAllocationFunc
+	HandleNoResources   models.HandlerFunc
 
 	JobID string
 
+	// NodeName is set when the allocations of a client are listed.
+	NodeName string
+
 	Data []*models.Alloc
+
+	// Stats holds the recent resource usage of
+	// allocations by their ID, if available.
+	Stats map[string]*models.AllocStats
 }
 
 func NewAllocationTable() *AllocationTable {
@@ -89,11 +102,20 @@ func (t *AllocationTable) Render() error {
 	}
 
 	t.Table.SetSelectedFunc(t.allocationSelected)
+	t.Table.SetSelectionChangedFunc(t.allocationHighlighted)
 
 	t.Table.RenderHeader(TableHeaderAllocations)
+	t.Table.SetVolatileColumns(allocationUsageColumns...)
 	t.renderRows()
 
-	t.Table.SetTitle(fmt.Sprintf("%s (Job: %s)", TableTitleAllocations, t.Props.JobID))
+	switch {
+	case t.Props.NodeName != "":
+		t.Table.SetTitle(fmt.Sprintf("%s (Client: %s)", TableTitleAllocations, t.Props.NodeName))
+	case t.Props.JobID == "":
+		t.Table.SetTitle(fmt.Sprintf("%s (all Jobs)", TableTitleAllocations))
+	default:
+		t.Table.SetTitle(fmt.Sprintf("%s (Job: %s)", TableTitleAllocations, t.Props.JobID))
+	}
 	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
 
 	return nil
@@ -119,11 +141,30 @@ func (t *AllocationTable) renderRows() {
 			a.DesiredStatus,
 		}
 
+		row = append(row, t.renderUsage(a)...)
+
 		index := i + 1
 
 		c := t.getCellColor(a.DesiredStatus)
 		t.Table.RenderRow(row, index, c)
 	}
+
+	t.Table.Commit()
+}
+
+// renderUsage renders the CPU and memory usage of the allocation
+// compared to the resources allocated to its tasks.
+func (t *AllocationTable) renderUsage(a *models.Alloc) []string {
+	stats, ok := t.Props.Stats[a.ID]
+	if !ok || stats == nil {
+		return []string{"-", "-"}
+	}
+
+	cpu, memory := allocLimits(a)
+	return []string{
+		renderCPU(stats.Usage, cpu),
+		renderMemory(stats.Usage, memory),
+	}
 }
 
 func (t *AllocationTable) getCellColor(status string) tcell.Color {
@@ -142,6 +183,15 @@ func (t *AllocationTable) allocationSelected(row, column int) {
 	t.Props.SelectAllocation(allocID)
 }
 
+func (t *AllocationTable) allocationHighlighted(row, column int) {
+	if t.Props.HighlightAllocation == nil || row < 1 {
+		return
+	}
+
+	allocID := t.Table.GetCellContent(row, 0)
+	t.Props.HighlightAllocation(allocID)
+}
+
 func (t *AllocationTable) GetIDForSelection() string {
 	row, _ := t.Table.GetSelection()
 	return t.Table.GetCellContent(row, 0)
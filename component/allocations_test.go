@@ -84,8 +84,8 @@ func TestAllocation_Happy(t *testing.T) {
 
 		row1, index1, c1 := fakeTable.RenderRowArgsForCall(0)
 		row2, index2, c2 := fakeTable.RenderRowArgsForCall(1)
-		expectedRow1 := []string{"ichi", "tokio", "japan", "manga", "namespace", "[addr1]", "node", "node", "run"}
-		expectedRow2 := []string{"ni", "tokio", "japan", "manga", "namespace", "[addr2]", "node", "node", "stop"}
+		expectedRow1 := []string{"ichi", "tokio", "japan", "manga", "namespace", "[addr1]", "node", "node", "run", "-", "-"}
+		expectedRow2 := []string{"ni", "tokio", "japan", "manga", "namespace", "[addr2]", "node", "node", "stop", "-", "-"}
 
 		// It render the correct data for the rows
 		r.Equal(expectedRow1, row1)
@@ -100,6 +100,47 @@ func TestAllocation_Happy(t *testing.T) {
 		r.Equal(c2, tcell.ColorDarkGrey)
 	})
 
+	t.Run("When there are stats to render", func(t *testing.T) {
+		fakeTable := &componentfakes.FakeTable{}
+		at := component.NewAllocationTable()
+
+		at.Table = fakeTable
+		at.Props.Data = []*models.Alloc{
+			{
+				ID: "ichi",
+				TaskList: []*models.Task{
+					{Name: "web", Allocated: &models.TaskResources{CPU: 400, MemoryMB: 200}},
+					{Name: "sidecar", Allocated: &models.TaskResources{CPU: 100, MemoryMB: 50, MemoryMaxMB: 56}},
+				},
+			},
+		}
+		at.Props.Stats = map[string]*models.AllocStats{
+			"ichi": {
+				Usage: []models.ResourceUsage{
+					{CPU: 125, MemoryBytes: 128 << 20},
+					{CPU: 250, MemoryBytes: 240 << 20},
+				},
+			},
+		}
+
+		at.Props.SelectAllocation = func(id string) {}
+		at.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+		slot := tview.NewFlex()
+		at.Bind(slot)
+
+		err := at.Render()
+		r.NoError(err)
+
+		// It marks the usage columns as volatile
+		r.Equal([]int{9, 10}, fakeTable.SetVolatileColumnsArgsForCall(0))
+
+		// It renders the usage compared to the resources of all tasks
+		row, _, _ := fakeTable.RenderRowArgsForCall(0)
+		r.Equal("        ▃▅ 250MHz/500MHz", row[9])
+		r.Equal("[#d98b6a]        ▅█ 240.0MiB/256.0MiB 94%", row[10])
+	})
+
 	t.Run("When render called again", func(t *testing.T) {
 		fakeTable := &componentfakes.FakeTable{}
 		at := component.NewAllocationTable()
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/primitives"
+)
+
+const (
+	PageNameBookmarks = "bookmarks"
+
+	TableTitleBookmarks = "Bookmarks (<enter> open, <1-9> bind key, <d> delete, <esc> close)"
+)
+
+var TableHeaderBookmarks = []string{
+	LabelName,
+	LabelKey,
+	LabelView,
+	LabelNamespace,
+	LabelFilter,
+}
+
+type SelectBookmarkFunc func(name string)
+
+type BindBookmarkFunc func(name string, key int)
+
+// Bookmarks is a quick-pick modal which lists the saved bookmarks.
+type Bookmarks struct {
+	Modal Selector
+	Props *BookmarksProps
+	pages *tview.Pages
+}
+
+type BookmarksProps struct {
+	Data []*models.Bookmark
+
+	SelectBookmark SelectBookmarkFunc
+	DeleteBookmark SelectBookmarkFunc
+	BindBookmark   BindBookmarkFunc
+	Close          func()
+}
+
+func NewBookmarks() *Bookmarks {
+	return &Bookmarks{
+		Modal: primitives.NewSelectionModal(),
+		Props: &BookmarksProps{},
+	}
+}
+
+func (b *Bookmarks) Render() error {
+	if b.pages == nil {
+		return ErrComponentNotBound
+	}
+
+	if b.Props.SelectBookmark == nil ||
+		b.Props.DeleteBookmark == nil ||
+		b.Props.BindBookmark == nil ||
+		b.Props.Close == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	table := b.Modal.GetTable()
+	table.Clear()
+	table.SetInputCapture(b.handleInput)
+	table.SetTitle(TableTitleBookmarks)
+	table.RenderHeader(TableHeaderBookmarks)
+
+	if len(b.Props.Data) == 0 {
+		table.RenderRow([]string{"no bookmarks yet, hit <b> to bookmark a view"}, 1, tcell.ColorWhite)
+	}
+
+	for i, bookmark := range b.Props.Data {
+		key := ""
+		if bookmark.Key > 0 {
+			key = strconv.Itoa(bookmark.Key)
+		}
+
+		row := []string{
+			bookmark.Name,
+			key,
+			bookmark.View,
+			bookmark.Namespace,
+			bookmark.Filter,
+		}
+
+		table.RenderRow(row, i+1, tcell.ColorWhite)
+	}
+
+	table.Commit()
+
+	b.pages.AddPage(PageNameBookmarks, b.Modal.Container(), true, true)
+
+	return nil
+}
+
+func (b *Bookmarks) Bind(pages *tview.Pages) {
+	b.pages = pages
+}
+
+func (b *Bookmarks) Close() {
+	b.pages.RemovePage(PageNameBookmarks)
+}
+
+func (b *Bookmarks) selected() (string, bool) {
+	if len(b.Props.Data) == 0 {
+		return "", false
+	}
+
+	table := b.Modal.GetTable()
+	row, _ := table.GetSelection()
+	if row < 1 {
+		return "", false
+	}
+
+	return table.GetCellContent(row, 0), true
+}
+
+func (b *Bookmarks) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEsc:
+		b.Props.Close()
+		return nil
+
+	case tcell.KeyEnter:
+		if name, ok := b.selected(); ok {
+			b.Props.SelectBookmark(name)
+		}
+		return nil
+
+	case tcell.KeyRune:
+		if event.Rune() == 'd' {
+			if name, ok := b.selected(); ok {
+				b.Props.DeleteBookmark(name)
+			}
+			return nil
+		}
+
+		if key := BookmarkKey(event); key > 0 {
+			if name, ok := b.selected(); ok {
+				b.Props.BindBookmark(name, key)
+			}
+			return nil
+		}
+	}
+
+	return event
+}
+
+// BookmarkKey returns the number key bound to bookmarks
+// for the event, or 0 if the event isn't a number key.
+func BookmarkKey(event *tcell.EventKey) int {
+	if event.Key() != tcell.KeyRune {
+		return 0
+	}
+
+	r := event.Rune()
+	if r < '1' || r > '9' {
+		return 0
+	}
+
+	return int(r - '0')
+}
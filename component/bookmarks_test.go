@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/primitives"
+)
+
+func TestBookmarks_Happy(t *testing.T) {
+	r := require.New(t)
+
+	fakeSelector := &componentfakes.FakeSelector{}
+	table := primitives.NewTable()
+	fakeSelector.GetTableReturns(table)
+
+	pages := tview.NewPages()
+
+	b := component.NewBookmarks()
+	b.Modal = fakeSelector
+	b.Bind(pages)
+
+	var selected, deleted string
+	var boundName string
+	var boundKey int
+	var closed bool
+
+	b.Props.SelectBookmark = func(name string) { selected = name }
+	b.Props.DeleteBookmark = func(name string) { deleted = name }
+	b.Props.BindBookmark = func(name string, key int) {
+		boundName = name
+		boundKey = key
+	}
+	b.Props.Close = func() { closed = true }
+	b.Props.Data = []*models.Bookmark{
+		{Name: "prod failing services", View: "jobs", Namespace: "prod", Filter: "status!=running", Key: 1},
+		{Name: "deployments", View: "deployments"},
+	}
+
+	err := b.Render()
+	r.NoError(err)
+
+	// It renders the header and the bookmarks
+	r.Equal(component.LabelName, table.GetCellContent(0, 0))
+	r.Equal("prod failing services", table.GetCellContent(1, 0))
+	r.Equal("1", table.GetCellContent(1, 1))
+	r.Equal("status!=running", table.GetCellContent(1, 4))
+	r.Equal("deployments", table.GetCellContent(2, 0))
+	r.Equal("", table.GetCellContent(2, 1))
+
+	// It adds the modal to the pages
+	r.True(pages.HasPage(component.PageNameBookmarks))
+
+	p := table.Primitive().(*tview.Table)
+	p.SetSelectable(true, false)
+	p.Select(2, 0)
+
+	capture := p.GetInputCapture()
+
+	capture(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	r.Equal("deployments", selected)
+
+	capture(tcell.NewEventKey(tcell.KeyRune, '3', tcell.ModNone))
+	r.Equal("deployments", boundName)
+	r.Equal(3, boundKey)
+
+	capture(tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone))
+	r.Equal("deployments", deleted)
+
+	capture(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone))
+	r.True(closed)
+}
+
+func TestBookmarks_Sad(t *testing.T) {
+	t.Run("When the component is not bound", func(t *testing.T) {
+		r := require.New(t)
+
+		b := component.NewBookmarks()
+		err := b.Render()
+
+		r.ErrorIs(err, component.ErrComponentNotBound)
+	})
+
+	t.Run("When component properties are not set", func(t *testing.T) {
+		r := require.New(t)
+
+		b := component.NewBookmarks()
+		b.Bind(tview.NewPages())
+		err := b.Render()
+
+		r.ErrorIs(err, component.ErrComponentPropsNotSet)
+	})
+}
+
+func TestBookmarkKey(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(1, component.BookmarkKey(tcell.NewEventKey(tcell.KeyRune, '1', tcell.ModNone)))
+	r.Equal(9, component.BookmarkKey(tcell.NewEventKey(tcell.KeyRune, '9', tcell.ModNone)))
+	r.Equal(0, component.BookmarkKey(tcell.NewEventKey(tcell.KeyRune, '0', tcell.ModNone)))
+	r.Equal(0, component.BookmarkKey(tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone)))
+	r.Equal(0, component.BookmarkKey(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone)))
+}
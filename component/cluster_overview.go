@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleClusterOverview = "Cluster Overview"
+
+	// The sections of the cluster overview.
+	OverviewServers     = "Servers"
+	OverviewRaft        = "Raft Peers"
+	OverviewClients     = "Clients"
+	OverviewJobs        = "Jobs"
+	OverviewJobTypes    = "Job Types"
+	OverviewAllocations = "Allocations"
+	OverviewDeployments = "Deployments"
+	OverviewEvaluations = "Evaluations"
+
+	// The statuses which aren't statuses of Nomad.
+	OverviewVoters      = "voters"
+	OverviewNonVoters   = "non-voters"
+	OverviewIneligible  = "ineligible"
+	OverviewDraining    = "draining"
+	OverviewUnavailable = "unavailable"
+)
+
+var (
+	TableHeaderClusterOverview = []string{
+		LabelSection,
+		LabelStatus,
+		LabelCount,
+		LabelDetails,
+	}
+)
+
+// OverviewTile is a row of the cluster overview: the
+// number of resources of a section in a status.
+type OverviewTile struct {
+	Section string
+	Status  string
+	Count   int
+	Detail  string
+}
+
+type SelectOverviewTileFunc func(tile *OverviewTile)
+
+// ClusterOverview shows the health of the cluster at a glance:
+// the servers, clients, jobs, allocations, deployments and blocked
+// evaluations by their status. Every row can be selected to
+// open the view of its resources.
+type ClusterOverview struct {
+	Table Table
+	Props *ClusterOverviewProps
+
+	slot  *tview.Flex
+	tiles []*OverviewTile
+}
+
+type ClusterOverviewProps struct {
+	SelectTile SelectOverviewTileFunc
+
+	Cluster     *models.Cluster
+	Jobs        []*models.Job
+	Allocations []*models.Alloc
+	Deployments []*models.Deployment
+	Namespace   string
+}
+
+func NewClusterOverview() *ClusterOverview {
+	// the rows are grouped by section and select
+	// their tile by position, so they can't be sorted
+	table := primitive.NewTable()
+	table.SetSortable(false)
+
+	return &ClusterOverview{
+		Table: table,
+		Props: &ClusterOverviewProps{},
+	}
+}
+
+func (o *ClusterOverview) Bind(slot *tview.Flex) {
+	o.slot = slot
+}
+
+func (o *ClusterOverview) Render() error {
+	if o.Props.SelectTile == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if o.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	o.slot.Clear()
+	o.Table.Clear()
+
+	o.Table.SetSelectedFunc(o.tileSelected)
+
+	region := "-"
+	if o.Props.Cluster != nil && o.Props.Cluster.Region != "" {
+		region = o.Props.Cluster.Region
+	}
+
+	o.Table.SetTitle(fmt.Sprintf("%s (Region: %s, Namespace: %s)", TableTitleClusterOverview, region, o.Props.Namespace))
+	o.Table.RenderHeader(TableHeaderClusterOverview)
+	o.Table.SetKeyColumns(0, 1)
+
+	o.tiles = o.overviewTiles()
+
+	section := ""
+	for i, tile := range o.tiles {
+		// name each section once
+		name := ""
+		if tile.Section != section {
+			name, section = tile.Section, tile.Section
+		}
+
+		count := fmt.Sprint(tile.Count)
+		if tile.Status == OverviewUnavailable {
+			count = "-"
+		}
+
+		row := []string{name, tile.Status, count, tile.Detail}
+		o.Table.RenderRow(row, i+1, tileColor(tile))
+	}
+
+	o.Table.Commit()
+
+	o.slot.AddItem(o.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// GetTileForSelection returns the selected
+// tile, or nil if no tile is selected.
+func (o *ClusterOverview) GetTileForSelection() *OverviewTile {
+	row, _ := o.Table.GetSelection()
+	if row < 1 || row > len(o.tiles) {
+		return nil
+	}
+
+	return o.tiles[row-1]
+}
+
+func (o *ClusterOverview) tileSelected(row, column int) {
+	if tile := o.GetTileForSelection(); tile != nil {
+		o.Props.SelectTile(tile)
+	}
+}
+
+func (o *ClusterOverview) overviewTiles() []*OverviewTile {
+	cluster := o.Props.Cluster
+	if cluster == nil {
+		cluster = &models.Cluster{}
+	}
+
+	tiles := []*OverviewTile{}
+	tiles = append(tiles, serverTiles(cluster)...)
+	tiles = append(tiles, raftTiles(cluster)...)
+	tiles = append(tiles, clientTiles(cluster)...)
+	tiles = append(tiles, o.jobTiles()...)
+	tiles = append(tiles, o.allocationTiles()...)
+	tiles = append(tiles, o.deploymentTiles()...)
+	tiles = append(tiles, evaluationTiles(cluster)...)
+
+	return tiles
+}
+
+func serverTiles(cluster *models.Cluster) []*OverviewTile {
+	if err, ok := cluster.Errors[models.ClusterServers]; ok {
+		return []*OverviewTile{unavailableTile(OverviewServers, err)}
+	}
+
+	counts := map[string]int{}
+	leader := "no leader"
+	for _, s := range cluster.Servers {
+		counts[s.Status]++
+		if s.Leader {
+			leader = fmt.Sprintf("leader: %s", s.Name)
+		}
+	}
+
+	tiles := countTiles(OverviewServers, counts, models.MemberStatusAlive, models.MemberStatusFailed)
+	tiles[0].Detail = leader
+
+	return tiles
+}
+
+func raftTiles(cluster *models.Cluster) []*OverviewTile {
+	if err, ok := cluster.Errors[models.ClusterRaft]; ok {
+		return []*OverviewTile{unavailableTile(OverviewRaft, err)}
+	}
+
+	voters := 0
+	for _, p := range cluster.RaftPeers {
+		if p.Voter {
+			voters++
+		}
+	}
+
+	detail := fmt.Sprintf("quorum: %d, failure tolerance: %d", voters/2+1, (voters-1)/2)
+
+	// autopilot knows which voters are healthy
+	if a := cluster.Autopilot; a != nil {
+		health := "unhealthy"
+		if a.Healthy {
+			health = "healthy"
+		}
+
+		detail = fmt.Sprintf("quorum: %d, failure tolerance: %d, autopilot: %s", voters/2+1, a.FailureTolerance, health)
+	}
+
+	tiles := []*OverviewTile{{
+		Section: OverviewRaft,
+		Status:  OverviewVoters,
+		Count:   voters,
+		Detail:  detail,
+	}}
+
+	if nonVoters := len(cluster.RaftPeers) - voters; nonVoters > 0 {
+		tiles = append(tiles, &OverviewTile{Section: OverviewRaft, Status: OverviewNonVoters, Count: nonVoters})
+	}
+
+	return tiles
+}
+
+func clientTiles(cluster *models.Cluster) []*OverviewTile {
+	if err, ok := cluster.Errors[models.ClusterNodes]; ok {
+		return []*OverviewTile{unavailableTile(OverviewClients, err)}
+	}
+
+	counts := map[string]int{}
+	for _, n := range cluster.Nodes {
+		counts[n.Status]++
+		if n.Eligibility == models.NodeIneligible {
+			counts[OverviewIneligible]++
+		}
+		if n.Drain {
+			counts[OverviewDraining]++
+		}
+	}
+
+	return countTiles(OverviewClients, counts,
+		models.NodeStatusReady,
+		models.NodeStatusInitializing,
+		models.NodeStatusDown,
+		models.NodeStatusDisconnected,
+		OverviewIneligible,
+		OverviewDraining,
+	)
+}
+
+func (o *ClusterOverview) jobTiles() []*OverviewTile {
+	status := map[string]int{}
+	types := map[string]int{}
+	for _, j := range o.Props.Jobs {
+		status[j.Status]++
+		types[j.Type]++
+	}
+
+	tiles := countTiles(OverviewJobs, status, models.StatusRunning, models.StatusPending, models.StatusDead)
+	return append(tiles, countTiles(OverviewJobTypes, types)...)
+}
+
+func (o *ClusterOverview) allocationTiles() []*OverviewTile {
+	counts := map[string]int{}
+	for _, a := range o.Props.Allocations {
+		counts[a.Status]++
+	}
+
+	return countTiles(OverviewAllocations, counts, models.StatusRunning, models.StatusPending, models.StatusFailed)
+}
+
+func (o *ClusterOverview) deploymentTiles() []*OverviewTile {
+	counts := map[string]int{}
+	for _, d := range o.Props.Deployments {
+		counts[d.Status]++
+	}
+
+	// only the deployments which need attention are of interest
+	return []*OverviewTile{
+		{Section: OverviewDeployments, Status: models.StatusRunning, Count: counts[models.StatusRunning]},
+		{Section: OverviewDeployments, Status: models.StatusFailed, Count: counts[models.StatusFailed]},
+	}
+}
+
+func evaluationTiles(cluster *models.Cluster) []*OverviewTile {
+	if err, ok := cluster.Errors[models.ClusterEvals]; ok {
+		return []*OverviewTile{unavailableTile(OverviewEvaluations, err)}
+	}
+
+	return []*OverviewTile{{
+		Section: OverviewEvaluations,
+		Status:  models.EvalStatusBlocked,
+		Count:   len(cluster.BlockedEvals),
+	}}
+}
+
+// countTiles returns a tile per status of the section. The given
+// statuses come first and are always shown, the others are sorted
+// and only shown if there are resources with the status.
+func countTiles(section string, counts map[string]int, statuses ...string) []*OverviewTile {
+	tiles := []*OverviewTile{}
+	shown := map[string]bool{}
+	for _, status := range statuses {
+		shown[status] = true
+		tiles = append(tiles, &OverviewTile{Section: section, Status: status, Count: counts[status]})
+	}
+
+	others := []string{}
+	for status := range counts {
+		if !shown[status] {
+			others = append(others, status)
+		}
+	}
+
+	sort.Strings(others)
+	for _, status := range others {
+		tiles = append(tiles, &OverviewTile{Section: section, Status: status, Count: counts[status]})
+	}
+
+	return tiles
+}
+
+func unavailableTile(section, err string) *OverviewTile {
+	return &OverviewTile{Section: section, Status: OverviewUnavailable, Detail: err}
+}
+
+func tileColor(tile *OverviewTile) tcell.Color {
+	switch {
+	case tile.Status == OverviewUnavailable:
+		return styles.TcellColorAttention
+	case tile.Section == OverviewServers && tile.Detail == "no leader",
+		tile.Section == OverviewRaft && strings.HasSuffix(tile.Detail, "autopilot: unhealthy"):
+		return tcell.ColorRed
+	case tile.Count == 0:
+		return tcell.ColorGray
+	}
+
+	switch tile.Status {
+	case models.StatusFailed, models.NodeStatusDown, models.NodeStatusDisconnected, models.EvalStatusBlocked, models.StatusLost:
+		return tcell.ColorRed
+	case models.StatusPending, models.NodeStatusInitializing, OverviewIneligible, OverviewDraining:
+		return tcell.ColorYellow
+	case models.StatusRunning, models.NodeStatusReady, models.MemberStatusAlive:
+		return styles.TcellColorHighlighPrimary
+	}
+
+	return tcell.ColorWhite
+}
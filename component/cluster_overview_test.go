@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestClusterOverview(t *testing.T) {
+	rows := func(table *componentfakes.FakeTable) [][]string {
+		result := [][]string{}
+		for i := 0; i < table.RenderRowCallCount(); i++ {
+			row, _, _ := table.RenderRowArgsForCall(i)
+			result = append(result, row)
+		}
+
+		return result
+	}
+
+	t.Run("It renders the counts of every section", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		overview := component.NewClusterOverview()
+		overview.Table = fakeTable
+		overview.Props.Namespace = "*"
+		overview.Props.Cluster = &models.Cluster{
+			Region: "global",
+			Servers: []*models.ServerMember{
+				{Name: "server-1", Status: "alive", Leader: true},
+				{Name: "server-2", Status: "alive"},
+				{Name: "server-3", Status: "left"},
+			},
+			RaftPeers: []*models.RaftPeer{
+				{Node: "server-1", Voter: true},
+				{Node: "server-2", Voter: true},
+				{Node: "server-3", Voter: true},
+				{Node: "server-4"},
+			},
+			Nodes: []*models.Node{
+				{Status: "ready", Eligibility: "eligible"},
+				{Status: "ready", Eligibility: "ineligible", Drain: true},
+				{Status: "down", Eligibility: "eligible"},
+			},
+			BlockedEvals: []*models.Evaluation{{ID: "e1"}},
+		}
+		overview.Props.Jobs = []*models.Job{
+			{Status: "running", Type: "service"},
+			{Status: "running", Type: "system"},
+			{Status: "dead", Type: "batch"},
+		}
+		overview.Props.Allocations = []*models.Alloc{
+			{Status: "running"},
+			{Status: "failed"},
+			{Status: "complete"},
+		}
+		overview.Props.Deployments = []*models.Deployment{
+			{Status: "running"},
+			{Status: "successful"},
+		}
+		overview.Props.SelectTile = func(tile *component.OverviewTile) {}
+		overview.Bind(tview.NewFlex())
+
+		r.NoError(overview.Render())
+
+		title, _ := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Cluster Overview (Region: global, Namespace: *)", title)
+		r.Equal(component.TableHeaderClusterOverview, fakeTable.RenderHeaderArgsForCall(0))
+
+		r.Equal([][]string{
+			{"Servers", "alive", "2", "leader: server-1"},
+			{"", "failed", "0", ""},
+			{"", "left", "1", ""},
+			{"Raft Peers", "voters", "3", "quorum: 2, failure tolerance: 1"},
+			{"", "non-voters", "1", ""},
+			{"Clients", "ready", "2", ""},
+			{"", "initializing", "0", ""},
+			{"", "down", "1", ""},
+			{"", "disconnected", "0", ""},
+			{"", "ineligible", "1", ""},
+			{"", "draining", "1", ""},
+			{"Jobs", "running", "2", ""},
+			{"", "pending", "0", ""},
+			{"", "dead", "1", ""},
+			{"Job Types", "batch", "1", ""},
+			{"", "service", "1", ""},
+			{"", "system", "1", ""},
+			{"Allocations", "running", "1", ""},
+			{"", "pending", "0", ""},
+			{"", "failed", "1", ""},
+			{"", "complete", "1", ""},
+			{"Deployments", "running", "1", ""},
+			{"", "failed", "0", ""},
+			{"Evaluations", "blocked", "1", ""},
+		}, rows(fakeTable))
+
+		_, _, running := fakeTable.RenderRowArgsForCall(0)
+		r.Equal(styles.TcellColorHighlighPrimary, running)
+
+		_, _, empty := fakeTable.RenderRowArgsForCall(1)
+		r.Equal(tcell.ColorGray, empty)
+
+		_, _, down := fakeTable.RenderRowArgsForCall(7)
+		r.Equal(tcell.ColorRed, down)
+	})
+
+	t.Run("It marks the parts which couldn't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		overview := component.NewClusterOverview()
+		overview.Table = fakeTable
+		overview.Props.Cluster = &models.Cluster{
+			Errors: map[string]string{
+				models.ClusterRaft: "Permission denied",
+			},
+		}
+		overview.Props.SelectTile = func(tile *component.OverviewTile) {}
+		overview.Bind(tview.NewFlex())
+
+		r.NoError(overview.Render())
+
+		row, _, c := fakeTable.RenderRowArgsForCall(2)
+		r.Equal([]string{"Raft Peers", "unavailable", "-", "Permission denied"}, row)
+		r.Equal(styles.TcellColorAttention, c)
+
+		// there is no leader
+		row, _, c = fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"Servers", "alive", "0", "no leader"}, row)
+		r.Equal(tcell.ColorRed, c)
+	})
+
+	t.Run("It takes the failure tolerance from autopilot", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		overview := component.NewClusterOverview()
+		overview.Table = fakeTable
+		overview.Props.Cluster = &models.Cluster{
+			RaftPeers: []*models.RaftPeer{
+				{Node: "server-1", Voter: true},
+				{Node: "server-2", Voter: true},
+				{Node: "server-3", Voter: true},
+			},
+			Autopilot: &models.AutopilotHealth{Healthy: false, FailureTolerance: 0},
+		}
+		overview.Props.SelectTile = func(tile *component.OverviewTile) {}
+		overview.Bind(tview.NewFlex())
+
+		r.NoError(overview.Render())
+
+		row, _, c := fakeTable.RenderRowArgsForCall(2)
+		r.Equal([]string{"Raft Peers", "voters", "3", "quorum: 2, failure tolerance: 0, autopilot: unhealthy"}, row)
+		r.Equal(tcell.ColorRed, c)
+	})
+
+	t.Run("It selects a tile", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		overview := component.NewClusterOverview()
+		overview.Table = fakeTable
+		overview.Props.Cluster = &models.Cluster{}
+
+		var selected *component.OverviewTile
+		overview.Props.SelectTile = func(tile *component.OverviewTile) {
+			selected = tile
+		}
+		overview.Bind(tview.NewFlex())
+
+		r.NoError(overview.Render())
+
+		// servers (2), raft (1), clients (6), jobs running
+		fakeTable.GetSelectionReturns(10, 0)
+		fakeTable.SetSelectedFuncArgsForCall(0)(10, 0)
+
+		r.Equal(&component.OverviewTile{Section: component.OverviewJobs, Status: models.StatusRunning}, selected)
+
+		fakeTable.GetSelectionReturns(0, 0)
+		r.Nil(overview.GetTileForSelection())
+	})
+
+	t.Run("It can't be sorted", func(t *testing.T) {
+		r := require.New(t)
+
+		overview := component.NewClusterOverview()
+		overview.Props.Cluster = &models.Cluster{}
+		overview.Props.SelectTile = func(tile *component.OverviewTile) {}
+		overview.Bind(tview.NewFlex())
+
+		r.NoError(overview.Render())
+
+		// the sort key would select the tiles of other rows
+		table := overview.Table.(*primitive.Table)
+		table.Primitive().(*tview.Table).GetInputCapture()(tcell.NewEventKey(tcell.KeyRune, primitive.KeySortColumn, tcell.ModNone))
+		column, _ := table.SortColumn()
+		r.Equal(-1, column)
+
+		table.Primitive().(*tview.Table).Select(1, 0)
+		r.Equal(component.OverviewServers, overview.GetTileForSelection().Section)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		overview := component.NewClusterOverview()
+		r.ErrorIs(overview.Render(), component.ErrComponentPropsNotSet)
+
+		overview.Props.SelectTile = func(tile *component.OverviewTile) {}
+		r.ErrorIs(overview.Render(), component.ErrComponentNotBound)
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/rivo/tview"
 
+	"github.com/hcjulz/damon/models"
 	primitive "github.com/hcjulz/damon/primitives"
 	"github.com/hcjulz/damon/styles"
 )
@@ -19,7 +20,16 @@ var (
 		fmt.Sprintf("%s<ctrl-j>%s to display Jobs", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<ctrl-d>%s to display Deployments", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<ctrl-n>%s to display Namespaces", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ctrl-v>%s to display Variables", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ctrl-r>%s to display Services", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ctrl-t>%s to display CSI Volumes", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ctrl-g>%s to display ACL Policies, Roles and Tokens", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ctrl-l>%s to display the Cluster Overview", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<ctrl-p>%s to jump to a Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s</>%s to filter the table (e.g. %sstatus!=running age<1h%s)", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.ColorLighGreyTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<o>%s/%s<O>%s to change the sort column/direction", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<b>%s/%s<B>%s to bookmark the view/list bookmarks, %s<1-9>%s to open one", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<v>%s to toggle the detail panel (%s<+>%s/%s<->%s to resize)", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<ctrl-c>%s to Quit", styles.HighlightPrimaryTag, styles.StandardColorTag),
 	}
 
@@ -29,17 +39,180 @@ var (
 		fmt.Sprintf("%s<t>%s to display TaskGroups for the selected Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<i>%s to display information for the selected Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<ctrl-s>%s start/stop the selected Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
-		fmt.Sprintf("%s</>%s apply filter", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<m>%s to tail the logs of all allocations of the selected Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<d>%s to display the spec of the selected Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	JobStatusCommands = []string{
+		fmt.Sprintf("\n%sJob Status Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Tab>%s/%s<shift-Tab>%s to focus the next/previous section", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<Enter>%s to display the tasks of the selected Allocation or the Deployment", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ctrl-s>%s start/stop the Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<V>%s to display the versions of the Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<e>%s to display the evaluations of the Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<S>%s to scale the selected TaskGroup", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	JobSpecCommands = []string{
+		fmt.Sprintf("\n%sJob Spec Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s</>%s to search the spec, %s<n>%s/%s<N>%s to go to the next/previous match", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<z>%s to fold/unfold the TaskGroup at the top", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<Z>%s to fold/unfold all TaskGroups", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<J>%s to switch between the submitted source and JSON", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ESC>%s to go back", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	JobVersionCommands = []string{
+		fmt.Sprintf("\n%sJob Version Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<ESC>%s to go back to the Job status", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	DeploymentInfoCommands = []string{
+		fmt.Sprintf("\n%sDeployment Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<i>%s to display the status of the Job", styles.HighlightPrimaryTag, styles.StandardColorTag),
 	}
 
 	AllocCommands = []string{
-		// fmt.Sprintf("\n%sAlloc Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("\n%sAlloc Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<m>%s to tail the logs of all tasks of the selected Allocation", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<M>%s to tail the logs of all Allocations", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<f>%s to browse the files of the selected Allocation", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<i>%s to show the details of the selected Allocation", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<n>%s to show the Client of the selected Allocation", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	AllocInfoCommands = []string{
+		fmt.Sprintf("\n%sAllocation Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<p>%s/%s<n>%s to show the previous/next Allocation", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<t>%s to display the tasks of the Allocation", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	VariableCommands = []string{
+		fmt.Sprintf("\n%sVariable Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to open the selected directory/Variable, %s<u>%s to go up", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<c>%s to create a Variable in $EDITOR", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<e>%s to edit the selected Variable in $EDITOR", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<d>%s to delete the selected Variable", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	VariableItemCommands = []string{
+		fmt.Sprintf("\n%sVariable Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<r>%s to reveal/mask the values", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<e>%s to edit the Variable in $EDITOR", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<d>%s to delete the Variable", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ServiceCommands = []string{
+		fmt.Sprintf("\n%sService Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the instances of the selected Service", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ServiceInstanceCommands = []string{
+		fmt.Sprintf("\n%sService Instance Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the Allocation of the selected instance", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<v>%s to show the health checks of the selected instance", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	VolumeCommands = []string{
+		fmt.Sprintf("\n%sVolume Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the claims of the selected Volume", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<p>%s to display the CSI Plugins", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	VolumeClaimCommands = []string{
+		fmt.Sprintf("\n%sClaim Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the Allocation of the selected claim", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<d>%s to detach the Volume from the node of the selected claim", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	PluginCommands = []string{
+		fmt.Sprintf("\n%sPlugin Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<ESC>%s to go back to the Volumes", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ACLPolicyCommands = []string{
+		fmt.Sprintf("\n%sACL Policy Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to show the rules of the selected Policy", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<c>%s to create a Policy in $EDITOR", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<e>%s to edit the selected Policy in $EDITOR", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<r>%s/%s<t>%s to display the ACL Roles/Tokens", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ACLPolicyRuleCommands = []string{
+		fmt.Sprintf("\n%sACL Policy Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<e>%s to edit the Policy in $EDITOR", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ACLRoleCommands = []string{
+		fmt.Sprintf("\n%sACL Role Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<p>%s/%s<t>%s to display the ACL Policies/Tokens", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ACLTokenCommands = []string{
+		fmt.Sprintf("\n%sACL Token Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<d>%s to revoke the selected Token", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<p>%s/%s<r>%s to display the ACL Policies/Roles", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	OverviewCommands = []string{
+		fmt.Sprintf("\n%sOverview Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the resources of the selected row", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<r>%s/%s<c>%s/%s<e>%s to display the Servers/Clients/Evaluations", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<p>%s to display the Node Pools", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	ServerCommands = []string{
+		fmt.Sprintf("\n%sServer Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<ESC>%s to go back", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	NodeCommands = []string{
+		fmt.Sprintf("\n%sClient Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to show the details of the selected Client", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<p>%s to display the Node Pools", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ESC>%s to go back", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	NodeInfoCommands = []string{
+		fmt.Sprintf("\n%sClient Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<a>%s to display the Allocations of the Client", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ESC>%s to go back", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	NodePoolCommands = []string{
+		fmt.Sprintf("\n%sNode Pool Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the Clients of the selected Node Pool", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<ESC>%s to go back", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	EvaluationCommands = []string{
+		fmt.Sprintf("\n%sEvaluation Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<ESC>%s to go back", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	TaskGroupCommands = []string{
+		fmt.Sprintf("\n%sTaskGroup Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<m>%s to tail the logs of all allocations of the selected TaskGroup", styles.HighlightPrimaryTag, styles.StandardColorTag),
 	}
 
 	TaskCommands = []string{
 		fmt.Sprintf("%s<e>%s to display events for a Task", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<ctrl-e>%s to display STDERR logs", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<Enter>%s to display STDOUT logs", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<m>%s to tail the logs of all Tasks", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<f>%s to browse the files of the selected Task", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<i>%s to display the details of the selected Task", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	TaskInfoCommands = []string{
+		fmt.Sprintf("\n%sTask Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<r>%s to reveal/mask secrets", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	AllocFSCommands = []string{
+		fmt.Sprintf("\n%sFile Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to open a directory or view a file", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<t>%s to follow the selected file", styles.HighlightPrimaryTag, styles.StandardColorTag),
 	}
 
 	LogCommands = []string{
@@ -47,11 +220,62 @@ var (
 		fmt.Sprintf("%s<Enter> | <ESC>%s to leave", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s</>%s apply filter", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<h>%s highlight", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<f>%s find, %s<n>%s/%s<N>%s next/previous match", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<i>%s/%s<F>%s ignore case/match literally, %s<C>%s context lines", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<s>%s stop log stream", styles.HighlightPrimaryTag, styles.StandardColorTag),
 		fmt.Sprintf("%s<r>%s resume log stream", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<o>%s start from the beginning, an offset or the last lines", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<w>%s/%s<W>%s save the shown/full log to a file", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<J>%s render JSON lines as columns/pretty/raw", styles.HighlightPrimaryTag, styles.StandardColorTag),
 	}
 
-	DeploymentCommands = []string{}
+	FileCommands = []string{
+		fmt.Sprintf("\n%sFile Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter> | <ESC>%s to leave", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<]>%s/%s<[>%s next/previous page", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<t>%s follow the file", styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s</>%s apply filter, %s<h>%s highlight", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<f>%s find, %s<n>%s/%s<N>%s next/previous match", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+		fmt.Sprintf("%s<w>%s/%s<W>%s save the page/file to a file", styles.HighlightPrimaryTag, styles.StandardColorTag, styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	DeploymentCommands = []string{
+		fmt.Sprintf("\n%sDeployment Commands:", styles.HighlightSecondaryTag),
+		fmt.Sprintf("%s<Enter>%s to display the selected Deployment", styles.HighlightPrimaryTag, styles.StandardColorTag),
+	}
+
+	// JobCapabilities, AllocCapabilities, ... map the key of a
+	// command to the ACL capability it needs.
+	JobCapabilities = map[string]string{
+		"<ctrl-s>": models.CapabilitySubmitJob,
+		"<m>":      models.CapabilityReadLogs,
+	}
+
+	JobStatusCapabilities = map[string]string{
+		"<ctrl-s>": models.CapabilitySubmitJob,
+		"<S>":      models.CapabilityScaleJob,
+	}
+
+	AllocCapabilities = map[string]string{
+		"<m>": models.CapabilityReadLogs,
+		"<M>": models.CapabilityReadLogs,
+		"<f>": models.CapabilityReadFS,
+	}
+
+	TaskGroupCapabilities = map[string]string{
+		"<m>": models.CapabilityReadLogs,
+	}
+
+	TaskCapabilities = map[string]string{
+		"<ctrl-e>": models.CapabilityReadLogs,
+		"<Enter>":  models.CapabilityReadLogs,
+		"<m>":      models.CapabilityReadLogs,
+		"<f>":      models.CapabilityReadFS,
+	}
+
+	VolumeClaimCapabilities = map[string]string{
+		"<d>": models.CapabilityCSIWriteVolume,
+	}
 
 	NoViewCommands = []string{}
 )
@@ -100,6 +324,23 @@ func (c *Commands) updateText() {
 	c.TextView.SetText(cmds)
 }
 
+// Restrict returns a copy of the commands in which every command
+// needing a capability that isn't allowed is marked as such.
+func Restrict(commands []string, capabilities map[string]string, allowed func(capability string) bool) []string {
+	restricted := make([]string, len(commands))
+	for i, cmd := range commands {
+		restricted[i] = cmd
+		for key, capability := range capabilities {
+			if strings.Contains(cmd, key) && !allowed(capability) {
+				restricted[i] = fmt.Sprintf("%s %s(not permitted: %s)%s", cmd, styles.ColorAttentionTag, capability, styles.StandardColorTag)
+				break
+			}
+		}
+	}
+
+	return restricted
+}
+
 func (c *Commands) Bind(slot *tview.Flex) {
 	c.slot = slot
 }
@@ -45,3 +45,21 @@ func TestCommands_Sad(t *testing.T) {
 	r.True(errors.Is(err, component.ErrComponentNotBound))
 	r.EqualError(err, "component not bound")
 }
+
+func TestRestrict(t *testing.T) {
+	r := require.New(t)
+
+	commands := []string{"<t> to show task groups", "<ctrl-s> start/stop", "<m> tail logs"}
+	restricted := component.Restrict(commands, map[string]string{
+		"<ctrl-s>": "submit-job",
+		"<m>":      "read-logs",
+	}, func(capability string) bool {
+		return capability == "read-logs"
+	})
+
+	r.Equal(commands[0], restricted[0])
+	r.Contains(restricted[1], "<ctrl-s> start/stop")
+	r.Contains(restricted[1], "(not permitted: submit-job)")
+	r.Equal(commands[2], restricted[2])
+	r.Equal("<ctrl-s> start/stop", commands[1])
+}
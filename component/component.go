@@ -69,6 +69,129 @@ const (
 	LabelNodeID   = "NodeID"
 	LabelNodeName = "NodeName"
 
+	LabelSize = "Size"
+	LabelMode = "Mode"
+
+	LabelResources     = "Resources"
+	LabelRequested     = "Requested"
+	LabelAllocated     = "Allocated"
+	LabelMemoryMax     = "Memory Max"
+	LabelLifecycle     = "Lifecycle"
+	LabelRestartPolicy = "Restart Policy"
+	LabelAttempts      = "Attempts"
+	LabelInterval      = "Interval"
+	LabelDelay         = "Delay"
+	LabelEnv           = "Env"
+	LabelDriverConfig  = "Driver Config"
+	LabelArtifacts     = "Artifacts"
+	LabelTemplates     = "Templates"
+	LabelVolumeMounts  = "Volume Mounts"
+	LabelSource        = "Source"
+	LabelDestination   = "Destination"
+	LabelOptions       = "Options"
+	LabelChangeMode    = "Change Mode"
+	LabelVolume        = "Volume"
+	LabelReadOnly      = "Read Only"
+
+	LabelDesiredDescription = "Desired Description"
+	LabelPreviousAlloc      = "Previous Allocation"
+	LabelNextAlloc          = "Next Allocation"
+	LabelPreviousNode       = "Previous Node"
+	LabelRescheduleTrail    = "Reschedule Trail"
+	LabelDeployment         = "Deployment"
+	LabelCanary             = "Canary"
+	LabelLabel              = "Label"
+	LabelHostAddress        = "Host Address"
+	LabelTo                 = "To"
+	LabelPlacementMetrics   = "Placement Metrics"
+	LabelNodesEvaluated     = "Nodes Evaluated"
+	LabelNodesFiltered      = "Nodes Filtered"
+	LabelNodesExhausted     = "Nodes Exhausted"
+	LabelNodesAvailable     = "Nodes Available"
+	LabelAllocationTime     = "Allocation Time"
+	LabelReason             = "Reason"
+	LabelNodes              = "Nodes"
+	LabelNode               = "Node"
+	LabelScores             = "Scores"
+	LabelFinalScore         = "Final Score"
+
+	LabelPath        = "Path"
+	LabelVariables   = "Variables"
+	LabelModifyIndex = "Modify Index"
+	LabelValue       = "Value"
+
+	LabelTags       = "Tags"
+	LabelAllocID    = "AllocID"
+	LabelAddress    = "Address"
+	LabelPort       = "Port"
+	LabelDatacenter = "Datacenter"
+	LabelChecks     = "Checks"
+	LabelTask       = "Task"
+	LabelStatusCode = "Code"
+	LabelOutput     = "Output"
+
+	LabelPlugin         = "Plugin"
+	LabelProvider       = "Provider"
+	LabelAccessMode     = "Access Mode"
+	LabelAttachmentMode = "Attachment Mode"
+	LabelSchedulable    = "Schedulable"
+	LabelReaders        = "Readers"
+	LabelWriters        = "Writers"
+	LabelControllers    = "Controllers"
+	LabelClientStatus   = "ClientStatus"
+
+	LabelKey    = "Key"
+	LabelView   = "View"
+	LabelFilter = "Filter"
+
+	LabelAccessorID = "AccessorID"
+	LabelGlobal     = "Global"
+	LabelPolicies   = "Policies"
+	LabelRoles      = "Roles"
+	LabelExpires    = "Expires"
+
+	LabelSection     = "Section"
+	LabelDetails     = "Details"
+	LabelNodePool    = "Node Pool"
+	LabelNodeClass   = "Node Class"
+	LabelEligibility = "Eligibility"
+	LabelDrain       = "Drain"
+	LabelTriggeredBy = "Triggered By"
+
+	LabelRegion      = "Region"
+	LabelLeader      = "Leader"
+	LabelVoter       = "Voter"
+	LabelLastContact = "Last Contact"
+	LabelLastIndex   = "Last Index"
+	LabelIndexLag    = "Index Lag"
+
+	LabelHTTPAddress        = "HTTP Address"
+	LabelTotal              = "Total"
+	LabelReserved           = "Reserved"
+	LabelAllocatable        = "Allocatable"
+	LabelDisk               = "Disk"
+	LabelDrivers            = "Drivers"
+	LabelDetected           = "Detected"
+	LabelUpdated            = "Updated"
+	LabelHostVolumes        = "Host Volumes"
+	LabelEvents             = "Events"
+	LabelSubsystem          = "Subsystem"
+	LabelMeta               = "Meta"
+	LabelAttributes         = "Attributes"
+	LabelSchedulerAlgorithm = "Scheduler Algorithm"
+	LabelClients            = "Clients"
+	LabelReady              = "Ready"
+	LabelEligible           = "Eligible"
+
+	LabelStable            = "Stable"
+	LabelChanges           = "Changes"
+	LabelJobVersion        = "Job Version"
+	LabelCanaries          = "Canaries"
+	LabelPromoted          = "Promoted"
+	LabelAutoRevert        = "Auto Revert"
+	LabelRequireProgressBy = "Require Progress By"
+	LabelTaskGroups        = "Task Groups"
+
 	ErrComponentNotBound    = models.Sentinel("component not bound")
 	ErrComponentPropsNotSet = models.Sentinel("component properties not set")
 )
@@ -89,7 +212,12 @@ type Table interface {
 	Clear()
 	RenderHeader(data []string)
 	RenderRow(data []string, index int, c tcell.Color)
+	Commit()
 	SetSelectedFunc(fn func(row, column int))
+	SetSelectionChangedFunc(fn func(row, column int))
+	SetRedrawFunc(fn func())
+	SetVolatileColumns(columns ...int)
+	SetKeyColumns(columns ...int)
 	SetInputCapture(capture func(event *tcell.EventKey) *tcell.EventKey)
 }
 
@@ -121,6 +249,7 @@ type InputField interface {
 	SetAutocompleteFunc(callback func(currentText string) (entries []string))
 	SetText(text string)
 	GetText() string
+	SetError(msg string)
 }
 
 //go:generate counterfeiter . DropDown
@@ -45,6 +45,11 @@ type FakeInputField struct {
 	setDoneFuncArgsForCall []struct {
 		arg1 func(k tcell.Key)
 	}
+	SetErrorStub        func(string)
+	setErrorMutex       sync.RWMutex
+	setErrorArgsForCall []struct {
+		arg1 string
+	}
 	SetTextStub        func(string)
 	setTextMutex       sync.RWMutex
 	setTextArgsForCall []struct {
@@ -256,6 +261,38 @@ func (fake *FakeInputField) SetDoneFuncArgsForCall(i int) func(k tcell.Key) {
 	return argsForCall.arg1
 }
 
+func (fake *FakeInputField) SetError(arg1 string) {
+	fake.setErrorMutex.Lock()
+	fake.setErrorArgsForCall = append(fake.setErrorArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetErrorStub
+	fake.recordInvocation("SetError", []interface{}{arg1})
+	fake.setErrorMutex.Unlock()
+	if stub != nil {
+		fake.SetErrorStub(arg1)
+	}
+}
+
+func (fake *FakeInputField) SetErrorCallCount() int {
+	fake.setErrorMutex.RLock()
+	defer fake.setErrorMutex.RUnlock()
+	return len(fake.setErrorArgsForCall)
+}
+
+func (fake *FakeInputField) SetErrorCalls(stub func(string)) {
+	fake.setErrorMutex.Lock()
+	defer fake.setErrorMutex.Unlock()
+	fake.SetErrorStub = stub
+}
+
+func (fake *FakeInputField) SetErrorArgsForCall(i int) string {
+	fake.setErrorMutex.RLock()
+	defer fake.setErrorMutex.RUnlock()
+	argsForCall := fake.setErrorArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeInputField) SetText(arg1 string) {
 	fake.setTextMutex.Lock()
 	fake.setTextArgsForCall = append(fake.setTextArgsForCall, struct {
@@ -301,6 +338,8 @@ func (fake *FakeInputField) Invocations() map[string][][]interface{} {
 	defer fake.setChangedFuncMutex.RUnlock()
 	fake.setDoneFuncMutex.RLock()
 	defer fake.setDoneFuncMutex.RUnlock()
+	fake.setErrorMutex.RLock()
+	defer fake.setErrorMutex.RUnlock()
 	fake.setTextMutex.RLock()
 	defer fake.setTextMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -14,6 +14,10 @@ type FakeTable struct {
 	clearMutex       sync.RWMutex
 	clearArgsForCall []struct {
 	}
+	CommitStub        func()
+	commitMutex       sync.RWMutex
+	commitArgsForCall []struct {
+	}
 	GetCellContentStub        func(int, int) string
 	getCellContentMutex       sync.RWMutex
 	getCellContentArgsForCall []struct {
@@ -65,17 +69,37 @@ type FakeTable struct {
 	setInputCaptureArgsForCall []struct {
 		arg1 func(event *tcell.EventKey) *tcell.EventKey
 	}
+	SetKeyColumnsStub        func(...int)
+	setKeyColumnsMutex       sync.RWMutex
+	setKeyColumnsArgsForCall []struct {
+		arg1 []int
+	}
+	SetRedrawFuncStub        func(func())
+	setRedrawFuncMutex       sync.RWMutex
+	setRedrawFuncArgsForCall []struct {
+		arg1 func()
+	}
 	SetSelectedFuncStub        func(func(row int, column int))
 	setSelectedFuncMutex       sync.RWMutex
 	setSelectedFuncArgsForCall []struct {
 		arg1 func(row int, column int)
 	}
+	SetSelectionChangedFuncStub        func(func(row int, column int))
+	setSelectionChangedFuncMutex       sync.RWMutex
+	setSelectionChangedFuncArgsForCall []struct {
+		arg1 func(row int, column int)
+	}
 	SetTitleStub        func(string, ...interface{})
 	setTitleMutex       sync.RWMutex
 	setTitleArgsForCall []struct {
 		arg1 string
 		arg2 []interface{}
 	}
+	SetVolatileColumnsStub        func(...int)
+	setVolatileColumnsMutex       sync.RWMutex
+	setVolatileColumnsArgsForCall []struct {
+		arg1 []int
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -104,6 +128,30 @@ func (fake *FakeTable) ClearCalls(stub func()) {
 	fake.ClearStub = stub
 }
 
+func (fake *FakeTable) Commit() {
+	fake.commitMutex.Lock()
+	fake.commitArgsForCall = append(fake.commitArgsForCall, struct {
+	}{})
+	stub := fake.CommitStub
+	fake.recordInvocation("Commit", []interface{}{})
+	fake.commitMutex.Unlock()
+	if stub != nil {
+		fake.CommitStub()
+	}
+}
+
+func (fake *FakeTable) CommitCallCount() int {
+	fake.commitMutex.RLock()
+	defer fake.commitMutex.RUnlock()
+	return len(fake.commitArgsForCall)
+}
+
+func (fake *FakeTable) CommitCalls(stub func()) {
+	fake.commitMutex.Lock()
+	defer fake.commitMutex.Unlock()
+	fake.CommitStub = stub
+}
+
 func (fake *FakeTable) GetCellContent(arg1 int, arg2 int) string {
 	fake.getCellContentMutex.Lock()
 	ret, specificReturn := fake.getCellContentReturnsOnCall[len(fake.getCellContentArgsForCall)]
@@ -383,6 +431,70 @@ func (fake *FakeTable) SetInputCaptureArgsForCall(i int) func(event *tcell.Event
 	return argsForCall.arg1
 }
 
+func (fake *FakeTable) SetKeyColumns(arg1 ...int) {
+	fake.setKeyColumnsMutex.Lock()
+	fake.setKeyColumnsArgsForCall = append(fake.setKeyColumnsArgsForCall, struct {
+		arg1 []int
+	}{arg1})
+	stub := fake.SetKeyColumnsStub
+	fake.recordInvocation("SetKeyColumns", []interface{}{arg1})
+	fake.setKeyColumnsMutex.Unlock()
+	if stub != nil {
+		fake.SetKeyColumnsStub(arg1...)
+	}
+}
+
+func (fake *FakeTable) SetKeyColumnsCallCount() int {
+	fake.setKeyColumnsMutex.RLock()
+	defer fake.setKeyColumnsMutex.RUnlock()
+	return len(fake.setKeyColumnsArgsForCall)
+}
+
+func (fake *FakeTable) SetKeyColumnsCalls(stub func(...int)) {
+	fake.setKeyColumnsMutex.Lock()
+	defer fake.setKeyColumnsMutex.Unlock()
+	fake.SetKeyColumnsStub = stub
+}
+
+func (fake *FakeTable) SetKeyColumnsArgsForCall(i int) []int {
+	fake.setKeyColumnsMutex.RLock()
+	defer fake.setKeyColumnsMutex.RUnlock()
+	argsForCall := fake.setKeyColumnsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTable) SetRedrawFunc(arg1 func()) {
+	fake.setRedrawFuncMutex.Lock()
+	fake.setRedrawFuncArgsForCall = append(fake.setRedrawFuncArgsForCall, struct {
+		arg1 func()
+	}{arg1})
+	stub := fake.SetRedrawFuncStub
+	fake.recordInvocation("SetRedrawFunc", []interface{}{arg1})
+	fake.setRedrawFuncMutex.Unlock()
+	if stub != nil {
+		fake.SetRedrawFuncStub(arg1)
+	}
+}
+
+func (fake *FakeTable) SetRedrawFuncCallCount() int {
+	fake.setRedrawFuncMutex.RLock()
+	defer fake.setRedrawFuncMutex.RUnlock()
+	return len(fake.setRedrawFuncArgsForCall)
+}
+
+func (fake *FakeTable) SetRedrawFuncCalls(stub func(func())) {
+	fake.setRedrawFuncMutex.Lock()
+	defer fake.setRedrawFuncMutex.Unlock()
+	fake.SetRedrawFuncStub = stub
+}
+
+func (fake *FakeTable) SetRedrawFuncArgsForCall(i int) func() {
+	fake.setRedrawFuncMutex.RLock()
+	defer fake.setRedrawFuncMutex.RUnlock()
+	argsForCall := fake.setRedrawFuncArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTable) SetSelectedFunc(arg1 func(row int, column int)) {
 	fake.setSelectedFuncMutex.Lock()
 	fake.setSelectedFuncArgsForCall = append(fake.setSelectedFuncArgsForCall, struct {
@@ -415,6 +527,38 @@ func (fake *FakeTable) SetSelectedFuncArgsForCall(i int) func(row int, column in
 	return argsForCall.arg1
 }
 
+func (fake *FakeTable) SetSelectionChangedFunc(arg1 func(row int, column int)) {
+	fake.setSelectionChangedFuncMutex.Lock()
+	fake.setSelectionChangedFuncArgsForCall = append(fake.setSelectionChangedFuncArgsForCall, struct {
+		arg1 func(row int, column int)
+	}{arg1})
+	stub := fake.SetSelectionChangedFuncStub
+	fake.recordInvocation("SetSelectionChangedFunc", []interface{}{arg1})
+	fake.setSelectionChangedFuncMutex.Unlock()
+	if stub != nil {
+		fake.SetSelectionChangedFuncStub(arg1)
+	}
+}
+
+func (fake *FakeTable) SetSelectionChangedFuncCallCount() int {
+	fake.setSelectionChangedFuncMutex.RLock()
+	defer fake.setSelectionChangedFuncMutex.RUnlock()
+	return len(fake.setSelectionChangedFuncArgsForCall)
+}
+
+func (fake *FakeTable) SetSelectionChangedFuncCalls(stub func(func(row int, column int))) {
+	fake.setSelectionChangedFuncMutex.Lock()
+	defer fake.setSelectionChangedFuncMutex.Unlock()
+	fake.SetSelectionChangedFuncStub = stub
+}
+
+func (fake *FakeTable) SetSelectionChangedFuncArgsForCall(i int) func(row int, column int) {
+	fake.setSelectionChangedFuncMutex.RLock()
+	defer fake.setSelectionChangedFuncMutex.RUnlock()
+	argsForCall := fake.setSelectionChangedFuncArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTable) SetTitle(arg1 string, arg2 ...interface{}) {
 	fake.setTitleMutex.Lock()
 	fake.setTitleArgsForCall = append(fake.setTitleArgsForCall, struct {
@@ -448,11 +592,45 @@ func (fake *FakeTable) SetTitleArgsForCall(i int) (string, []interface{}) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeTable) SetVolatileColumns(arg1 ...int) {
+	fake.setVolatileColumnsMutex.Lock()
+	fake.setVolatileColumnsArgsForCall = append(fake.setVolatileColumnsArgsForCall, struct {
+		arg1 []int
+	}{arg1})
+	stub := fake.SetVolatileColumnsStub
+	fake.recordInvocation("SetVolatileColumns", []interface{}{arg1})
+	fake.setVolatileColumnsMutex.Unlock()
+	if stub != nil {
+		fake.SetVolatileColumnsStub(arg1...)
+	}
+}
+
+func (fake *FakeTable) SetVolatileColumnsCallCount() int {
+	fake.setVolatileColumnsMutex.RLock()
+	defer fake.setVolatileColumnsMutex.RUnlock()
+	return len(fake.setVolatileColumnsArgsForCall)
+}
+
+func (fake *FakeTable) SetVolatileColumnsCalls(stub func(...int)) {
+	fake.setVolatileColumnsMutex.Lock()
+	defer fake.setVolatileColumnsMutex.Unlock()
+	fake.SetVolatileColumnsStub = stub
+}
+
+func (fake *FakeTable) SetVolatileColumnsArgsForCall(i int) []int {
+	fake.setVolatileColumnsMutex.RLock()
+	defer fake.setVolatileColumnsMutex.RUnlock()
+	argsForCall := fake.setVolatileColumnsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTable) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.clearMutex.RLock()
 	defer fake.clearMutex.RUnlock()
+	fake.commitMutex.RLock()
+	defer fake.commitMutex.RUnlock()
 	fake.getCellContentMutex.RLock()
 	defer fake.getCellContentMutex.RUnlock()
 	fake.getSelectionMutex.RLock()
@@ -465,10 +643,18 @@ func (fake *FakeTable) Invocations() map[string][][]interface{} {
 	defer fake.renderRowMutex.RUnlock()
 	fake.setInputCaptureMutex.RLock()
 	defer fake.setInputCaptureMutex.RUnlock()
+	fake.setKeyColumnsMutex.RLock()
+	defer fake.setKeyColumnsMutex.RUnlock()
+	fake.setRedrawFuncMutex.RLock()
+	defer fake.setRedrawFuncMutex.RUnlock()
 	fake.setSelectedFuncMutex.RLock()
 	defer fake.setSelectedFuncMutex.RUnlock()
+	fake.setSelectionChangedFuncMutex.RLock()
+	defer fake.setSelectionChangedFuncMutex.RUnlock()
 	fake.setTitleMutex.RLock()
 	defer fake.setTitleMutex.RUnlock()
+	fake.setVolatileColumnsMutex.RLock()
+	defer fake.setVolatileColumnsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -119,6 +119,8 @@ func (d *DeploymentTable) renderRows() {
 		c := d.getCellColor(dep.Status)
 		d.Table.RenderRow(row, index, c)
 	}
+
+	d.Table.Commit()
 }
 
 func (d *DeploymentTable) getCellColor(status string) tcell.Color {
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+)
+
+const (
+	TitleDeploymentInfo = "Deployment"
+)
+
+// DeploymentInfo shows a deployment with the progress
+// of each task group, including its canaries.
+type DeploymentInfo struct {
+	TextView TextView
+	Props    *DeploymentInfoProps
+	slot     *tview.Flex
+}
+
+type DeploymentInfoProps struct {
+	Data *models.DeploymentDetail
+}
+
+func NewDeploymentInfo() *DeploymentInfo {
+	return &DeploymentInfo{
+		TextView: primitive.NewTextView(tview.AlignLeft),
+		Props:    &DeploymentInfoProps{},
+	}
+}
+
+func (d *DeploymentInfo) Bind(slot *tview.Flex) {
+	d.slot = slot
+}
+
+func (d *DeploymentInfo) Render() error {
+	if d.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	d.slot.Clear()
+
+	dep := d.Props.Data
+	if dep == nil {
+		d.TextView.SetText("Deployment not available.")
+		d.slot.AddItem(d.TextView.Primitive(), 0, 1, true)
+		return nil
+	}
+
+	title := fmt.Sprintf("%s (%s)", TitleDeploymentInfo, dep.ID)
+	d.TextView.ModifyPrimitive(func(textView *tview.TextView) {
+		textView.SetScrollable(true)
+		textView.SetBorder(true)
+		textView.SetTitle(title)
+	})
+
+	sections := []string{
+		"\n",
+		renderKeyValues([][]string{
+			{LabelID, dep.ID},
+			{LabelJobID, dep.JobID},
+			{LabelJobVersion, fmt.Sprint(dep.JobVersion)},
+			{LabelNamespace, dep.Namespace},
+			{LabelStatus, dep.Status},
+			{LabelStatusDescriptionLong, orDash(dep.StatusDescription)},
+		}),
+		fmt.Sprintf("\n  %s\n", LabelTaskGroups),
+		d.renderTaskGroups(),
+	}
+
+	d.TextView.SetText(tview.Escape(strings.Join(sections, "")))
+	d.slot.AddItem(d.TextView.Primitive(), 0, 1, true)
+	return nil
+}
+
+func (d *DeploymentInfo) renderTaskGroups() string {
+	groups := d.Props.Data.TaskGroups
+	if len(groups) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, tg := range groups {
+		canaries := "-"
+		if tg.DesiredCanaries > 0 {
+			canaries = fmt.Sprintf("%d/%d", tg.PlacedCanaries, tg.DesiredCanaries)
+		}
+
+		rows = append(rows, []string{
+			tg.Name,
+			fmt.Sprint(tg.Desired),
+			fmt.Sprint(tg.Placed),
+			fmt.Sprint(tg.Healthy),
+			fmt.Sprint(tg.Unhealthy),
+			canaries,
+			fmt.Sprint(tg.Promoted),
+			fmt.Sprint(tg.AutoRevert),
+			fmt.Sprint(tg.ProgressDeadline),
+			formatTime(tg.RequireProgressBy),
+		})
+	}
+
+	return renderTable([]string{
+		LabelName,
+		LabelDesired,
+		LabelPlaced,
+		LabelHealthy,
+		LabelUnhealthy,
+		LabelCanaries,
+		LabelPromoted,
+		LabelAutoRevert,
+		LabelProgressDeadline,
+		LabelRequireProgressBy,
+	}, rows)
+}
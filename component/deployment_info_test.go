@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestDeploymentInfo(t *testing.T) {
+	t.Run("It renders the deployment with its task groups", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewDeploymentInfo()
+		info.TextView = textView
+		info.Props.Data = &models.DeploymentDetail{
+			ID:                "42",
+			JobID:             "web",
+			JobVersion:        3,
+			Namespace:         "default",
+			Status:            "running",
+			StatusDescription: "Deployment is running but requires manual promotion",
+			TaskGroups: []*models.DeploymentTaskGroup{
+				{
+					Name:             "frontend",
+					Desired:          3,
+					Placed:           1,
+					Healthy:          1,
+					DesiredCanaries:  1,
+					PlacedCanaries:   1,
+					AutoRevert:       true,
+					ProgressDeadline: 10 * time.Minute,
+				},
+			},
+		}
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+
+		text := textView.SetTextArgsForCall(0)
+		r.Regexp(`JobID\s+= web`, text)
+		r.Regexp(`Job Version\s+= 3`, text)
+		r.Regexp(`Status Description\s+= Deployment is running but requires manual promotion`, text)
+		r.Regexp(`Task Groups`, text)
+		r.Regexp(`frontend\s+3\s+1\s+1\s+0\s+1/1\s+false\s+true\s+10m0s\s+-`, text)
+	})
+
+	t.Run("It renders a missing deployment", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewDeploymentInfo()
+		info.TextView = textView
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+		r.Equal("Deployment not available.", textView.SetTextArgsForCall(0))
+	})
+
+	t.Run("It fails when it isn't bound", func(t *testing.T) {
+		r := require.New(t)
+
+		err := component.NewDeploymentInfo().Render()
+		r.True(errors.Is(err, component.ErrComponentNotBound))
+	})
+}
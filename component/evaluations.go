@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const TableTitleEvaluations = "Evaluations"
+
+var (
+	TableHeaderEvaluations = []string{
+		LabelID,
+		LabelJobID,
+		LabelNamespace,
+		LabelType,
+		LabelPriority,
+		LabelTriggeredBy,
+		LabelStatus,
+		LabelStatusDescription,
+		LabelCreated,
+	}
+)
+
+// EvaluationTable lists the evaluations of the scheduler.
+type EvaluationTable struct {
+	Table Table
+	Props *EvaluationTableProps
+
+	slot *tview.Flex
+}
+
+type EvaluationTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data      []*models.Evaluation
+	Namespace string
+}
+
+func NewEvaluationTable() *EvaluationTable {
+	return &EvaluationTable{
+		Table: primitive.NewTable(),
+		Props: &EvaluationTableProps{},
+	}
+}
+
+func (t *EvaluationTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *EvaluationTable) Render() error {
+	if t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno evaluations found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle(fmt.Sprintf("%s (%s)", TableTitleEvaluations, t.Props.Namespace))
+	t.Table.RenderHeader(TableHeaderEvaluations)
+
+	for i, eval := range t.Props.Data {
+		row := []string{
+			eval.ID,
+			eval.JobID,
+			eval.Namespace,
+			eval.Type,
+			fmt.Sprint(eval.Priority),
+			eval.TriggeredBy,
+			eval.Status,
+			eval.StatusDescription,
+			eval.Created.Format(time.RFC3339),
+		}
+
+		t.Table.RenderRow(row, i+1, evaluationColor(eval.Status))
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func evaluationColor(status string) tcell.Color {
+	switch status {
+	case models.EvalStatusBlocked, models.StatusFailed:
+		return tcell.ColorRed
+	case models.StatusPending:
+		return tcell.ColorYellow
+	}
+
+	return tcell.ColorWhite
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestEvaluationTable(t *testing.T) {
+	t.Run("It renders the evaluations", func(t *testing.T) {
+		r := require.New(t)
+
+		created := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+		fakeTable := &componentfakes.FakeTable{}
+		et := component.NewEvaluationTable()
+		et.Table = fakeTable
+		et.Props.Namespace = "default"
+		et.Props.Data = []*models.Evaluation{
+			{ID: "e1", JobID: "web", Namespace: "default", Type: "service", Priority: 50, TriggeredBy: "job-register", Status: "blocked", Created: created},
+			{ID: "e2", JobID: "web", Namespace: "default", Type: "service", Priority: 50, TriggeredBy: "job-register", Status: "complete", Created: created},
+		}
+		et.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		et.Bind(tview.NewFlex())
+
+		r.NoError(et.Render())
+
+		title, _ := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Evaluations (default)", title)
+		r.Equal(component.TableHeaderEvaluations, fakeTable.RenderHeaderArgsForCall(0))
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"e1", "web", "default", "service", "50", "job-register", "blocked", "", "2023-07-01T12:00:00Z"}, row)
+		r.Equal(tcell.ColorRed, c)
+
+		_, _, c = fakeTable.RenderRowArgsForCall(1)
+		r.Equal(tcell.ColorWhite, c)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		et := component.NewEvaluationTable()
+		r.ErrorIs(et.Render(), component.ErrComponentPropsNotSet)
+
+		et.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(et.Render(), component.ErrComponentNotBound)
+	})
+}
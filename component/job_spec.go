@@ -0,0 +1,491 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const TitleJobSpec = "Job Spec"
+
+var hclGroupPattern = regexp.MustCompile(`^\s*group\s+"([^"]+)"\s*\{`)
+var jsonTaskGroupsPattern = regexp.MustCompile(`^\s*"TaskGroups"\s*:\s*\[\s*$`)
+var jsonNamePattern = regexp.MustCompile(`^\s*"Name"\s*:\s*"([^"]*)"`)
+
+// JobSpec shows the definition of a job: the source it was submitted
+// with or the job rendered as JSON. Its task groups can be folded and
+// the matches of a search are highlighted.
+type JobSpec struct {
+	TextView TextView
+	Props    *JobSpecProps
+	slot     *tview.Flex
+
+	// groups are the task groups of the shown spec and
+	// rows the task group of each row, or -1 if none.
+	groups  []specGroup
+	rows    []int
+	matches []string
+	current string
+}
+
+type JobSpecProps struct {
+	Data *models.JobSpec
+
+	// ShowJSON shows the job as JSON even if its source is known.
+	ShowJSON bool
+
+	// Search is the pattern whose matches are highlighted.
+	// Task groups with a match aren't folded.
+	Search string
+
+	// Folded are the names of the folded task groups.
+	Folded map[string]bool
+}
+
+// specGroup is a task group of a spec, from
+// its first line to its last one.
+type specGroup struct {
+	name       string
+	start, end int
+}
+
+func NewJobSpec() *JobSpec {
+	return &JobSpec{
+		TextView: primitive.NewTextView(tview.AlignLeft),
+		Props:    &JobSpecProps{Folded: map[string]bool{}},
+	}
+}
+
+func (s *JobSpec) Bind(slot *tview.Flex) {
+	s.slot = slot
+}
+
+func (s *JobSpec) Render() error {
+	if s.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	s.slot.Clear()
+
+	spec := s.Props.Data
+	if spec == nil {
+		s.groups, s.rows, s.matches, s.current = nil, nil, nil, ""
+		s.TextView.SetText("Job spec not available.")
+		s.slot.AddItem(s.TextView.Primitive(), 0, 1, true)
+		return nil
+	}
+
+	s.TextView.ModifyPrimitive(func(textView *tview.TextView) {
+		textView.SetScrollable(true)
+		textView.SetBorder(true)
+		textView.SetRegions(true)
+		textView.SetWrap(false)
+	})
+
+	format, src := s.source()
+	lines := strings.Split(src, "\n")
+	highlight := highlightJSONLine
+	if format != models.JobSpecFormatJSON {
+		highlight = highlightHCLLine
+	}
+
+	search := compileLogPattern(s.Props.Search, true, false)
+	s.groups = specGroups(lines, format == models.JobSpecFormatJSON)
+	s.rows = []int{}
+	s.matches = []string{}
+
+	out := []string{}
+	for i := 0; i < len(lines); i++ {
+		group := s.groupAt(i)
+
+		if group >= 0 && s.groups[group].start == i && s.folded(group, lines, search) {
+			g := s.groups[group]
+			out = append(out, fmt.Sprintf("%s %s… %d lines …%s %s",
+				highlight(lines[i]),
+				styles.ColorLighGreyTag,
+				g.end-g.start-1,
+				styles.ColorWhiteTag,
+				highlight(strings.TrimSpace(lines[g.end])),
+			))
+			s.rows = append(s.rows, group)
+			i = g.end
+			continue
+		}
+
+		out = append(out, s.renderLine(lines[i], search, highlight))
+		s.rows = append(s.rows, group)
+	}
+
+	s.TextView.SetText(strings.Join(out, "\n"))
+
+	if s.matchIndex() < 0 {
+		s.current = ""
+		if len(s.matches) > 0 {
+			s.current = s.matches[0]
+		}
+	}
+
+	if s.current == "" {
+		s.TextView.Highlight()
+	} else {
+		s.TextView.Highlight(s.current)
+	}
+
+	s.updateTitle(format)
+	s.slot.AddItem(s.TextView.Primitive(), 0, 1, true)
+	return nil
+}
+
+// renderLine highlights the syntax of a line. Lines with matches of the
+// search are shown plain with their matches marked as regions instead.
+func (s *JobSpec) renderLine(line string, search *regexp.Regexp, highlight func(string) string) string {
+	if search == nil {
+		return highlight(line)
+	}
+
+	marks := []ansiMark{}
+	for _, loc := range search.FindAllStringIndex(line, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+
+		id := fmt.Sprintf("m%d", len(s.matches))
+		s.matches = append(s.matches, id)
+		marks = append(marks, ansiMark{
+			start: loc[0],
+			end:   loc[1],
+			open:  fmt.Sprintf(`["%s"]`, id),
+			close: `[""]`,
+		})
+	}
+
+	if len(marks) == 0 {
+		return highlight(line)
+	}
+
+	plain := &ansiLine{text: line}
+	return styles.ColorWhiteTag + plain.render(marks...)
+}
+
+// source returns the format and the text of the spec which is shown.
+func (s *JobSpec) source() (string, string) {
+	spec := s.Props.Data
+	if s.Props.ShowJSON || spec.Source == "" {
+		return models.JobSpecFormatJSON, spec.JSON
+	}
+
+	return spec.Format, spec.Source
+}
+
+// folded reports whether a task group is folded. Task
+// groups with a match of the search are always shown.
+func (s *JobSpec) folded(group int, lines []string, search *regexp.Regexp) bool {
+	g := s.groups[group]
+	if !s.Props.Folded[g.name] {
+		return false
+	}
+
+	if search == nil {
+		return true
+	}
+
+	return !search.MatchString(strings.Join(lines[g.start:g.end+1], "\n"))
+}
+
+// groupAt returns the task group of a line, or -1 if none.
+func (s *JobSpec) groupAt(line int) int {
+	for i, g := range s.groups {
+		if line >= g.start && line <= g.end {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ToggleFold folds or unfolds the task group at the top of the view,
+// or the first task group below it if the top isn't in a task group.
+func (s *JobSpec) ToggleFold() {
+	if s.Props.Data == nil || len(s.groups) == 0 {
+		return
+	}
+
+	top := 0
+	s.TextView.ModifyPrimitive(func(t *tview.TextView) {
+		top, _ = t.GetScrollOffset()
+	})
+
+	group := -1
+	for row := top; row < len(s.rows) && group < 0; row++ {
+		group = s.rows[row]
+	}
+
+	if group < 0 {
+		return
+	}
+
+	name := s.groups[group].name
+	s.Props.Folded[name] = !s.Props.Folded[name]
+	s.Render()
+
+	// keep the task group at the top of the view
+	for row, g := range s.rows {
+		if g == group {
+			s.TextView.ModifyPrimitive(func(t *tview.TextView) {
+				t.ScrollTo(row, 0)
+			})
+			break
+		}
+	}
+}
+
+// ToggleFoldAll folds all task groups, or unfolds
+// them if all of them are folded already.
+func (s *JobSpec) ToggleFoldAll() {
+	if s.Props.Data == nil || len(s.groups) == 0 {
+		return
+	}
+
+	fold := false
+	for _, g := range s.groups {
+		if !s.Props.Folded[g.name] {
+			fold = true
+		}
+	}
+
+	for _, g := range s.groups {
+		s.Props.Folded[g.name] = fold
+	}
+
+	s.Render()
+}
+
+// NextMatch highlights the next match of the search and scrolls to it.
+func (s *JobSpec) NextMatch() {
+	s.moveMatch(1)
+}
+
+// PrevMatch highlights the previous match of the search and scrolls to it.
+func (s *JobSpec) PrevMatch() {
+	s.moveMatch(-1)
+}
+
+// ScrollToMatch scrolls to the highlighted match of the search.
+func (s *JobSpec) ScrollToMatch() {
+	s.moveMatch(0)
+}
+
+func (s *JobSpec) moveMatch(step int) {
+	if len(s.matches) == 0 {
+		return
+	}
+
+	i := (s.matchIndex() + step + len(s.matches)) % len(s.matches)
+	s.current = s.matches[i]
+
+	s.TextView.Highlight(s.current)
+	s.TextView.ModifyPrimitive(func(t *tview.TextView) {
+		t.ScrollToHighlight()
+	})
+
+	format, _ := s.source()
+	s.updateTitle(format)
+}
+
+// MatchCount returns the position of the highlighted match,
+// starting at 1, and the number of matches of the search.
+func (s *JobSpec) MatchCount() (int, int) {
+	return s.matchIndex() + 1, len(s.matches)
+}
+
+func (s *JobSpec) matchIndex() int {
+	for i, id := range s.matches {
+		if id == s.current {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// updateTitle shows the job, its version, the format
+// and the position of the highlighted match in the title.
+func (s *JobSpec) updateTitle(format string) {
+	spec := s.Props.Data
+	title := fmt.Sprintf("%s (%s, version %d, %s)", TitleJobSpec, spec.ID, spec.Version, format)
+	if s.Props.Search != "" {
+		title = fmt.Sprintf("%s find %q %d/%d", title, s.Props.Search, s.matchIndex()+1, len(s.matches))
+	}
+
+	s.TextView.ModifyPrimitive(func(t *tview.TextView) {
+		t.SetTitle(title)
+	})
+}
+
+// specGroups finds the task groups of a spec: the group blocks of HCL
+// or the objects of the TaskGroups array of JSON. Task groups of JSON
+// are only found if it's indented, as the JSON Nomad renders is.
+func specGroups(lines []string, json bool) []specGroup {
+	if json {
+		return jsonSpecGroups(lines)
+	}
+
+	groups := []specGroup{}
+	for i := 0; i < len(lines); i++ {
+		m := hclGroupPattern.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+
+		depth := 0
+		for end := i; end < len(lines); end++ {
+			depth += braceDepth(lines[end])
+			if depth <= 0 {
+				if end > i {
+					groups = append(groups, specGroup{name: m[1], start: i, end: end})
+				}
+				i = end
+				break
+			}
+		}
+	}
+
+	return groups
+}
+
+func jsonSpecGroups(lines []string) []specGroup {
+	groups := []specGroup{}
+	for i := 0; i < len(lines); i++ {
+		if !jsonTaskGroupsPattern.MatchString(lines[i]) {
+			continue
+		}
+
+		// depth 1 is the array, depth 2 a task group
+		depth := 1
+		var group *specGroup
+		for j := i + 1; j < len(lines) && depth > 0; j++ {
+			if group == nil && depth == 1 && strings.HasPrefix(strings.TrimSpace(lines[j]), "{") {
+				group = &specGroup{start: j}
+			}
+
+			if m := jsonNamePattern.FindStringSubmatch(lines[j]); group != nil && depth == 2 && m != nil && group.name == "" {
+				group.name = m[1]
+			}
+
+			depth += braceDepth(lines[j])
+			if group != nil && depth == 1 {
+				group.end = j
+				if group.end > group.start {
+					groups = append(groups, *group)
+				}
+				group = nil
+			}
+
+			i = j
+		}
+	}
+
+	return groups
+}
+
+// braceDepth returns by how much a line changes the depth
+// of the braces and brackets, without those of strings
+// and comments.
+func braceDepth(line string) int {
+	depth := 0
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '#' || strings.HasPrefix(line[i:], "//"):
+			return depth
+		case c == '"':
+			for i++; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+
+	return depth
+}
+
+// highlightJSONLine colours a line of JSON for a text view with
+// dynamic colors: keys, strings and literals get their own colour.
+func highlightJSONLine(line string) string {
+	var b, plain strings.Builder
+
+	flush := func() {
+		b.WriteString(tview.Escape(plain.String()))
+		plain.Reset()
+	}
+	colored := func(tag, s string) {
+		flush()
+		b.WriteString(tag)
+		b.WriteString(tview.Escape(s))
+		b.WriteString(styles.ColorWhiteTag)
+	}
+
+	b.WriteString(styles.ColorWhiteTag)
+
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == '"':
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end < len(line) {
+				end++
+			}
+			if end > len(line) {
+				end = len(line)
+			}
+
+			tag := styles.HighlightPrimaryTag
+			if strings.HasPrefix(strings.TrimLeft(line[end:], " \t"), ":") {
+				tag = styles.ColorActiveTag
+			}
+
+			colored(tag, line[i:end])
+			i = end
+
+		case c == '-' || (c >= '0' && c <= '9') || isIdentStart(c):
+			end := i + 1
+			for end < len(line) && (isIdentPart(line[end]) || line[end] == '.' || line[end] == '+') {
+				end++
+			}
+
+			tag := styles.HighlightSecondaryTag
+			if line[i:end] == "null" {
+				tag = styles.ColorLighGreyTag
+			}
+
+			colored(tag, line[i:end])
+			i = end
+
+		default:
+			plain.WriteByte(c)
+			i++
+		}
+	}
+
+	flush()
+	return b.String()
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+const hclSpec = `job "web" {
+  group "frontend" {
+    task "server" {
+      config {
+        image = "nginx:1.25"
+      }
+    }
+  }
+
+  group "cache" {
+    count = 2
+  }
+}`
+
+const jsonSpec = `{
+  "ID": "web",
+  "TaskGroups": [
+    {
+      "Name": "frontend",
+      "Count": 1
+    },
+    {
+      "Name": "cache",
+      "Count": 2
+    }
+  ],
+  "Version": 3
+}`
+
+func TestJobSpec(t *testing.T) {
+	spec := func() *models.JobSpec {
+		return &models.JobSpec{
+			ID:      "web",
+			Version: 3,
+			Format:  models.JobSpecFormatHCL2,
+			Source:  hclSpec,
+			JSON:    jsonSpec,
+		}
+	}
+
+	// title applies the modifications of the fake to
+	// a text view and returns the title it ends up with.
+	title := func(textView *componentfakes.FakeTextView) string {
+		tv := tview.NewTextView()
+		for i := 0; i < textView.ModifyPrimitiveCallCount(); i++ {
+			textView.ModifyPrimitiveArgsForCall(i)(tv)
+		}
+
+		return tv.GetTitle()
+	}
+
+	lastText := func(textView *componentfakes.FakeTextView) string {
+		return textView.SetTextArgsForCall(textView.SetTextCallCount() - 1)
+	}
+
+	t.Run("It renders the source with HCL highlighting", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		js := component.NewJobSpec()
+		js.TextView = textView
+
+		r.ErrorIs(js.Render(), component.ErrComponentNotBound)
+
+		js.Bind(tview.NewFlex())
+		js.Props.Data = spec()
+		r.NoError(js.Render())
+
+		text := lastText(textView)
+		r.Contains(text, component.HighlightHCL(`        image = "nginx:1.25"`))
+		r.Equal("Job Spec (web, version 3, hcl2)", title(textView))
+	})
+
+	t.Run("It renders JSON if the source isn't known", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		js := component.NewJobSpec()
+		js.TextView = textView
+		js.Bind(tview.NewFlex())
+
+		data := spec()
+		data.Source = ""
+		js.Props.Data = data
+		r.NoError(js.Render())
+
+		w := styles.ColorWhiteTag
+		text := lastText(textView)
+		r.Contains(text, w+"      "+styles.ColorActiveTag+`"Name"`+w+": "+styles.HighlightPrimaryTag+`"frontend"`+w+",")
+		r.Contains(text, w+"  "+styles.ColorActiveTag+`"Version"`+w+": "+styles.HighlightSecondaryTag+"3"+w)
+		r.Equal("Job Spec (web, version 3, json)", title(textView))
+	})
+
+	t.Run("It folds task groups", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		js := component.NewJobSpec()
+		js.TextView = textView
+		js.Bind(tview.NewFlex())
+		js.Props.Data = spec()
+		js.Props.Folded["frontend"] = true
+		r.NoError(js.Render())
+
+		text := lastText(textView)
+		r.NotContains(text, "nginx")
+		r.Contains(text, "… 5 lines …")
+		r.Contains(text, "count")
+
+		// It folds all task groups
+		js.ToggleFoldAll()
+		text = lastText(textView)
+		r.NotContains(text, "count")
+		r.Equal(2, strings.Count(text, " lines …"))
+
+		// It unfolds all task groups if all are folded
+		js.ToggleFoldAll()
+		text = lastText(textView)
+		r.Contains(text, "nginx")
+		r.Contains(text, "count")
+
+		// It folds the first task group if the top isn't in one
+		js.ToggleFold()
+		r.True(js.Props.Folded["frontend"])
+		r.False(js.Props.Folded["cache"])
+	})
+
+	t.Run("It folds the task groups of JSON", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		js := component.NewJobSpec()
+		js.TextView = textView
+		js.Bind(tview.NewFlex())
+		js.Props.Data = spec()
+		js.Props.ShowJSON = true
+		js.Props.Folded["cache"] = true
+		r.NoError(js.Render())
+
+		text := lastText(textView)
+		r.Contains(text, "frontend")
+		r.NotContains(text, "cache")
+		r.Contains(text, "… 2 lines …")
+	})
+
+	t.Run("It highlights the matches of the search", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		js := component.NewJobSpec()
+		js.TextView = textView
+		js.Bind(tview.NewFlex())
+		js.Props.Data = spec()
+		js.Props.Folded["frontend"] = true
+		js.Props.Search = "NGINX|count"
+		r.NoError(js.Render())
+
+		// task groups with matches are unfolded
+		text := lastText(textView)
+		r.Contains(text, `image = "["m0"]nginx[""]:1.25"`)
+		r.Contains(text, `["m1"]count[""] = 2`)
+
+		current, total := js.MatchCount()
+		r.Equal(1, current)
+		r.Equal(2, total)
+		r.Equal([]string{"m0"}, textView.HighlightArgsForCall(textView.HighlightCallCount()-1))
+		r.Equal(`Job Spec (web, version 3, hcl2) find "NGINX|count" 1/2`, title(textView))
+
+		js.NextMatch()
+		current, _ = js.MatchCount()
+		r.Equal(2, current)
+
+		js.NextMatch()
+		current, _ = js.MatchCount()
+		r.Equal(1, current)
+
+		js.PrevMatch()
+		current, _ = js.MatchCount()
+		r.Equal(2, current)
+	})
+
+	t.Run("When there is no spec", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		js := component.NewJobSpec()
+		js.TextView = textView
+		js.Bind(tview.NewFlex())
+		r.NoError(js.Render())
+
+		r.Equal("Job spec not available.", lastText(textView))
+	})
+}
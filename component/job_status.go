@@ -8,32 +8,83 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/olekukonko/tablewriter"
 	"github.com/rivo/tview"
 
 	"github.com/hcjulz/damon/models"
 	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
 )
 
 const (
 	TitleJobStatus = "Status"
 )
 
+var (
+	TableHeaderJobSummary = []string{
+		LabelTaskGroup,
+		LabelQueued,
+		LabelStarting,
+		LabelRunning,
+		LabelFailed,
+		LabelComplete,
+		LabelLost,
+	}
+
+	TableHeaderJobDeployment = []string{
+		LabelTaskGroup,
+		LabelDesired,
+		LabelPlaced,
+		LabelHealthy,
+		LabelUnhealthy,
+		LabelProgressDeadline,
+	}
+
+	TableHeaderJobAllocations = []string{
+		LabelID,
+		LabelNodeID,
+		LabelTaskGroup,
+		LabelVersion,
+		LabelDesired,
+		LabelStatus,
+		LabelCreated,
+		LabelModified,
+	}
+)
+
+// JobStatus shows the status of a job: its info, the summary of
+// its task groups, its latest deployment and its allocations. The
+// summary, deployment and allocations are tables which can be
+// focused one after the other with NextSection.
 type JobStatus struct {
-	TextView TextView
-	Props    *JobStatusProps
-	slot     *tview.Flex
+	TextView    TextView
+	Summary     Table
+	Deployment  Table
+	Allocations Table
+	Props       *JobStatusProps
+
+	slot    *tview.Flex
+	section Table
 }
 
 type JobStatusProps struct {
+	// SelectAllocation and SelectDeployment are
+	// optional, e.g. in the detail panel.
+	SelectAllocation SelectAllocationFunc
+	SelectDeployment SelectFunc
+
 	Data *models.JobStatus
 }
 
 func NewJobStatus() *JobStatus {
 	textView := primitive.NewTextView(tview.AlignLeft)
 	return &JobStatus{
-		TextView: textView,
-		Props:    &JobStatusProps{},
+		TextView:    textView,
+		Summary:     primitive.NewTable(),
+		Deployment:  primitive.NewTable(),
+		Allocations: primitive.NewTable(),
+		Props:       &JobStatusProps{},
 	}
 }
 
@@ -49,8 +100,9 @@ func (jobStatus *JobStatus) Render() error {
 	jobStatus.slot.Clear()
 	jobStatus.slot.SetDirection(tview.FlexRow)
 
-	if jobStatus.Props.Data.ID == "" {
+	if jobStatus.Props.Data == nil || jobStatus.Props.Data.ID == "" {
 		jobStatus.TextView.SetText("Status not available.")
+		jobStatus.slot.AddItem(jobStatus.TextView.Primitive(), 0, 1, true)
 		return nil
 	}
 
@@ -61,21 +113,154 @@ func (jobStatus *JobStatus) Render() error {
 	var jobStatusText []string
 	jobStatusText = append(jobStatusText,
 		"\n",
-		jobStatus.renderInfoData(),
-		fmt.Sprintf("\n  %s\n", LabelStatusSummary),
-		jobStatus.renderSummary(),
-		fmt.Sprintf("\n  %s\n", LabelLatestDeployment),
-		jobStatus.renderLatestDeployment(),
-		fmt.Sprintf("\n  %s\n", LabelDeployed),
-		jobStatus.renderDeployments(),
-		fmt.Sprintf("\n  %s\n", LabelAllocations),
-		jobStatus.renderAllocs())
-
-	jobStatus.TextView.SetText(strings.Join(jobStatusText, ""))
-	jobStatus.slot.AddItem(jobStatus.TextView.Primitive(), 0, 1, true)
+		jobStatus.renderInfoData())
+
+	if len(jobStatus.Props.Data.TaskGroupStatus) > 0 {
+		jobStatusText = append(jobStatusText,
+			fmt.Sprintf("\n  %s\n", LabelLatestDeployment),
+			jobStatus.renderLatestDeployment())
+	}
+
+	text := strings.Join(jobStatusText, "")
+	jobStatus.TextView.SetText(text)
+
+	// the info has a fixed height, the allocations take the rest
+	jobStatus.slot.AddItem(jobStatus.TextView.Primitive(), strings.Count(text, "\n")+2, 0, false)
+
+	jobStatus.renderSummary()
+	jobStatus.slot.AddItem(jobStatus.Summary.Primitive(), len(jobStatus.Props.Data.TaskGroups)+3, 0, false)
+
+	if len(jobStatus.Props.Data.TaskGroupStatus) > 0 {
+		jobStatus.renderDeployment()
+		jobStatus.slot.AddItem(jobStatus.Deployment.Primitive(), len(jobStatus.Props.Data.TaskGroupStatus)+3, 0, false)
+	}
+
+	jobStatus.renderAllocs()
+	jobStatus.slot.AddItem(jobStatus.Allocations.Primitive(), 0, 1, false)
+
+	return nil
+}
+
+// Sections returns the tables of the status which can be focused.
+// The deployment is left out if the job has none.
+func (jobStatus *JobStatus) Sections() []Table {
+	sections := []Table{jobStatus.Summary}
+	if jobStatus.Props.Data != nil && len(jobStatus.Props.Data.TaskGroupStatus) > 0 {
+		sections = append(sections, jobStatus.Deployment)
+	}
+
+	return append(sections, jobStatus.Allocations)
+}
+
+// Section returns the focused table. If the focused table
+// isn't shown anymore, the allocations are focused.
+func (jobStatus *JobStatus) Section() Table {
+	for _, table := range jobStatus.Sections() {
+		if table == jobStatus.section {
+			return table
+		}
+	}
+
+	jobStatus.section = jobStatus.Allocations
+	return jobStatus.section
+}
+
+// NextSection moves the focus to the next table, or to
+// the previous one if back is set, and returns it.
+func (jobStatus *JobStatus) NextSection(back bool) Table {
+	sections := jobStatus.Sections()
+	current := jobStatus.Section()
+
+	step := 1
+	if back {
+		step = len(sections) - 1
+	}
+
+	for i, table := range sections {
+		if table == current {
+			jobStatus.section = sections[(i+step)%len(sections)]
+			break
+		}
+	}
+
+	return jobStatus.section
+}
+
+// ResetSection focuses the allocations, the section
+// which is of most interest when the status is opened.
+func (jobStatus *JobStatus) ResetSection() Table {
+	jobStatus.section = jobStatus.Allocations
+	return jobStatus.section
+}
+
+// GetTaskGroupForSelection returns the task group of the selected row
+// in the focused table, or an empty string if no row is selected.
+func (jobStatus *JobStatus) GetTaskGroupForSelection() string {
+	table := jobStatus.Section()
+	row, _ := table.GetSelection()
+	if row < 1 {
+		return ""
+	}
+
+	if table == jobStatus.Allocations {
+		if alloc := jobStatus.getAllocForSelection(); alloc != nil {
+			return alloc.TaskGroup
+		}
+
+		return ""
+	}
+
+	// the summary and the deployment start with the task group
+	return table.GetCellContent(row, 0)
+}
+
+// GetAllocIDForSelection returns the full ID of the selected allocation.
+func (jobStatus *JobStatus) GetAllocIDForSelection() string {
+	if alloc := jobStatus.getAllocForSelection(); alloc != nil {
+		return alloc.ID
+	}
+
+	return ""
+}
+
+func (jobStatus *JobStatus) getAllocForSelection() *models.Alloc {
+	row, _ := jobStatus.Allocations.GetSelection()
+	if row < 1 || jobStatus.Props.Data == nil {
+		return nil
+	}
+
+	// the table shows the short IDs
+	id := jobStatus.Allocations.GetCellContent(row, 0)
+	for _, alloc := range jobStatus.Props.Data.Allocations {
+		if id != "" && strings.HasPrefix(alloc.ID, id) {
+			return alloc
+		}
+	}
+
 	return nil
 }
 
+func (jobStatus *JobStatus) allocationSelected(row, column int) {
+	if jobStatus.Props.SelectAllocation == nil {
+		return
+	}
+
+	if id := jobStatus.GetAllocIDForSelection(); id != "" {
+		jobStatus.Props.SelectAllocation(id)
+	}
+}
+
+func (jobStatus *JobStatus) deploymentSelected(row, column int) {
+	if jobStatus.Props.SelectDeployment == nil || jobStatus.Props.Data == nil {
+		return
+	}
+
+	// all task groups are part of the same deployment
+	if tgs := jobStatus.Props.Data.TaskGroupStatus; len(tgs) > 0 && tgs[0].DeploymentID != "" {
+		jobStatus.Props.SelectDeployment(tgs[0].DeploymentID)
+	}
+}
+
 func (jobStatus *JobStatus) renderInfoData() string {
 	j := jobStatus.Props.Data
 
@@ -104,15 +289,34 @@ func (jobStatus *JobStatus) renderInfoData() string {
 	return tableString.String()
 }
 
-func (jobStatus *JobStatus) renderSummary() string {
-	tasks := jobStatus.Props.Data.TaskGroups
+func (jobStatus *JobStatus) renderLatestDeployment() string {
+	ts := jobStatus.Props.Data.TaskGroupStatus[0]
 
 	tableString := &strings.Builder{}
 	tableWriter := tablewriter.NewWriter(tableString)
 	format(tableWriter)
-	tableWriter.SetHeader([]string{LabelTaskGroup, LabelQueued, LabelStarting, LabelRunning, LabelFailed, LabelComplete, LabelLost})
 
-	for _, tg := range tasks {
+	frmt := func(value interface{}) string {
+		return fmt.Sprintf("= %s", value)
+	}
+
+	infoData := [][]string{
+		{LabelID, frmt(ts.DeploymentID)},
+		{LabelStatus, frmt(ts.Status)},
+		{LabelStatusDescriptionLong, frmt(ts.StatusDescription)},
+	}
+	tableWriter.AppendBulk(infoData)
+	tableWriter.Render()
+	return tableString.String()
+}
+
+func (jobStatus *JobStatus) renderSummary() {
+	table := jobStatus.Summary
+	table.Clear()
+	table.SetTitle(LabelStatusSummary)
+	table.RenderHeader(TableHeaderJobSummary)
+
+	for i, tg := range jobStatus.Props.Data.TaskGroups {
 		row := []string{
 			tg.Name,
 			fmt.Sprint(tg.Queued),
@@ -122,75 +326,65 @@ func (jobStatus *JobStatus) renderSummary() string {
 			fmt.Sprint(tg.Complete),
 			fmt.Sprint(tg.Lost),
 		}
-		tableWriter.Append(row)
-	}
-	tableWriter.Render()
-	return tableString.String()
-}
-
-func (jobStatus *JobStatus) renderLatestDeployment() string {
-	tgs := jobStatus.Props.Data.TaskGroupStatus
-
-	if len(tgs) <= 0 {
-		return ""
-	}
 
-	ts := tgs[0]
-	tableString := &strings.Builder{}
-	tableWriter := tablewriter.NewWriter(tableString)
-	format(tableWriter)
+		c := tcell.ColorWhite
+		switch {
+		case tg.Failed > 0 || tg.Lost > 0:
+			c = tcell.ColorRed
+		case tg.Queued > 0 || tg.Starting > 0:
+			c = tcell.ColorYellow
+		}
 
-	frmt := func(value interface{}) string {
-		return fmt.Sprintf("= %s", value)
+		table.RenderRow(row, i+1, c)
 	}
 
-	infoData := [][]string{
-		{LabelID, frmt(ts.ID)},
-		{LabelStatus, frmt(ts.Status)},
-		{LabelStatusDescriptionLong, frmt(ts.StatusDescription)},
-	}
-	tableWriter.AppendBulk(infoData)
-	tableWriter.Render()
-	return tableString.String()
+	table.Commit()
 }
 
-func (jobStatus *JobStatus) renderDeployments() string {
+func (jobStatus *JobStatus) renderDeployment() {
 	tgs := jobStatus.Props.Data.TaskGroupStatus
 
-	tableString := &strings.Builder{}
-	tableWriter := tablewriter.NewWriter(tableString)
-	format(tableWriter)
-
-	tableWriter.SetHeader([]string{LabelTaskGroup, LabelDesired, LabelPlaced, LabelHealthy, LabelUnhealthy, LabelProgressDeadline})
+	table := jobStatus.Deployment
+	table.Clear()
+	table.SetSelectedFunc(jobStatus.deploymentSelected)
+	table.SetTitle(LabelDeployed)
+	table.RenderHeader(TableHeaderJobDeployment)
 
-	for _, t := range tgs {
+	for i, t := range tgs {
 		row := []string{
-			fmt.Sprint(t.ID),
+			t.TaskGroup,
 			fmt.Sprint(t.Desired),
 			fmt.Sprint(t.Placed),
 			fmt.Sprint(t.Healthy),
 			fmt.Sprint(t.Unhealthy),
 			fmt.Sprint(t.ProgressDeadline),
 		}
-		tableWriter.Append(row)
-	}
-	tableWriter.Render()
-	return tableString.String()
-}
 
-func (jobStatus *JobStatus) renderAllocs() string {
-	tgs := jobStatus.Props.Data.Allocations
+		c := tcell.ColorWhite
+		switch {
+		case t.Unhealthy > 0:
+			c = tcell.ColorRed
+		case t.Healthy < t.Desired:
+			c = tcell.ColorYellow
+		}
 
-	tableString := &strings.Builder{}
-	tableWriter := tablewriter.NewWriter(tableString)
-	format(tableWriter)
+		table.RenderRow(row, i+1, c)
+	}
+
+	table.Commit()
+}
 
-	tableWriter.SetHeader([]string{LabelID, LabelNodeID, LabelTaskGroup, LabelVersion, LabelDesired, LabelStatus, LabelCreated, LabelModified})
+func (jobStatus *JobStatus) renderAllocs() {
+	table := jobStatus.Allocations
+	table.Clear()
+	table.SetSelectedFunc(jobStatus.allocationSelected)
+	table.SetTitle(LabelAllocations)
+	table.RenderHeader(TableHeaderJobAllocations)
 
-	for _, t := range tgs {
+	for i, t := range jobStatus.Props.Data.Allocations {
 		row := []string{
-			t.ID[0:8],
-			t.NodeID[0:8],
+			shortID(t.ID),
+			shortID(t.NodeID),
 			t.TaskGroup,
 			fmt.Sprint(t.Version),
 			t.DesiredStatus,
@@ -198,10 +392,34 @@ func (jobStatus *JobStatus) renderAllocs() string {
 			fmt.Sprintf("%s ago", time.Since(t.Created).Round(time.Second)),
 			fmt.Sprintf("%s ago", time.Since(t.Modified).Round(time.Second)),
 		}
-		tableWriter.Append(row)
+
+		table.RenderRow(row, i+1, allocStatusColor(t))
 	}
-	tableWriter.Render()
-	return tableString.String()
+
+	table.Commit()
+}
+
+func allocStatusColor(alloc *models.Alloc) tcell.Color {
+	switch {
+	case alloc.DesiredStatus == models.DesiredStatusStop:
+		return tcell.ColorDarkGray
+	case alloc.Status == models.StatusRunning:
+		return styles.TcellColorHighlighPrimary
+	case alloc.Status == models.StatusPending:
+		return tcell.ColorYellow
+	case alloc.Status == models.StatusFailed || alloc.Status == models.StatusLost:
+		return tcell.ColorRed
+	}
+
+	return tcell.ColorWhite
+}
+
+func shortID(id string) string {
+	if len(id) < 8 {
+		return id
+	}
+
+	return id[0:8]
 }
 
 func format(tw *tablewriter.Table) {
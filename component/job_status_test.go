@@ -22,8 +22,14 @@ func TestJobStatus_Happy(t *testing.T) {
 
 	t.Run("When there is data to render", func(t *testing.T) {
 		textView := &componentfakes.FakeTextView{}
+		summary := &componentfakes.FakeTable{}
+		deployment := &componentfakes.FakeTable{}
+		allocations := &componentfakes.FakeTable{}
 		jobStatus := component.NewJobStatus()
 		jobStatus.TextView = textView
+		jobStatus.Summary = summary
+		jobStatus.Deployment = deployment
+		jobStatus.Allocations = allocations
 		jobStatus.Props.Data = &models.JobStatus{
 			ID:                "fakeID",
 			Name:              "fakeName",
@@ -51,6 +57,8 @@ func TestJobStatus_Happy(t *testing.T) {
 			TaskGroupStatus: []*models.TaskGroupStatus{
 				{
 					ID:                "fakeGroupStatus",
+					DeploymentID:      "fakeDeploymentID",
+					TaskGroup:         "fakeGroup",
 					Desired:           70,
 					Placed:            80,
 					Healthy:           90,
@@ -98,23 +106,95 @@ Status=fakeStatus
 Periodic=false
 Parameterized=false
 
-Summary
-TaskGroupQueuedStartingRunningFailedCompleteLost
-fakeGroup105040302060
-
 LatestDeployment
-ID=fakeGroupStatus
+ID=fakeDeploymentID
 Status=fakeGroupStatus
 StatusDescription=Somefakegroupdescription
+`)
 
-Deployed
-TaskGroupDesiredPlacedHealthyUnhealthyProgressDeadline
-fakeGroupStatus708090100200ns
+		title, _ := summary.SetTitleArgsForCall(0)
+		r.Equal(component.LabelStatusSummary, title)
+		r.Equal(component.TableHeaderJobSummary, summary.RenderHeaderArgsForCall(0))
+		row, index, _ := summary.RenderRowArgsForCall(0)
+		r.Equal([]string{"fakeGroup", "10", "50", "40", "30", "20", "60"}, row)
+		r.Equal(1, index)
 
-Allocations
-IDNodeIDTaskGroupVersionDesiredStatusCreatedModified
-1234567812345678fakeAllocGroup100fakeDesiredStatusfakeAllocStatus0sago0sago
-`)
+		r.Equal(component.TableHeaderJobDeployment, deployment.RenderHeaderArgsForCall(0))
+		row, _, _ = deployment.RenderRowArgsForCall(0)
+		r.Equal([]string{"fakeGroup", "70", "80", "90", "100", "200ns"}, row)
+
+		r.Equal(component.TableHeaderJobAllocations, allocations.RenderHeaderArgsForCall(0))
+		row, _, _ = allocations.RenderRowArgsForCall(0)
+		r.Equal([]string{"12345678", "12345678", "fakeAllocGroup", "100", "fake Desired Status", "fake Alloc Status", "0s ago", "0s ago"}, row)
+	})
+
+	t.Run("Selecting an allocation passes its full ID", func(t *testing.T) {
+		allocations := &componentfakes.FakeTable{}
+		jobStatus := component.NewJobStatus()
+		jobStatus.TextView = &componentfakes.FakeTextView{}
+		jobStatus.Allocations = allocations
+		jobStatus.Props.Data = &models.JobStatus{
+			ID:          "fakeID",
+			Allocations: []*models.Alloc{{ID: "1234567890", TaskGroup: "web"}},
+		}
+
+		var selected string
+		jobStatus.Props.SelectAllocation = func(allocID string) {
+			selected = allocID
+		}
+
+		jobStatus.Bind(tview.NewFlex())
+		r.NoError(jobStatus.Render())
+
+		allocations.GetSelectionReturns(1, 0)
+		allocations.GetCellContentReturns("12345678")
+
+		selectFn := allocations.SetSelectedFuncArgsForCall(0)
+		selectFn(1, 0)
+
+		r.Equal("1234567890", selected)
+		r.Equal("web", jobStatus.GetTaskGroupForSelection())
+	})
+
+	t.Run("Selecting the deployment passes its ID", func(t *testing.T) {
+		deployment := &componentfakes.FakeTable{}
+		jobStatus := component.NewJobStatus()
+		jobStatus.TextView = &componentfakes.FakeTextView{}
+		jobStatus.Deployment = deployment
+		jobStatus.Props.Data = &models.JobStatus{
+			ID: "fakeID",
+			TaskGroupStatus: []*models.TaskGroupStatus{
+				{DeploymentID: "fakeDeploymentID", TaskGroup: "web"},
+			},
+		}
+
+		var selected string
+		jobStatus.Props.SelectDeployment = func(id string) {
+			selected = id
+		}
+
+		jobStatus.Bind(tview.NewFlex())
+		r.NoError(jobStatus.Render())
+
+		selectFn := deployment.SetSelectedFuncArgsForCall(0)
+		selectFn(1, 0)
+
+		r.Equal("fakeDeploymentID", selected)
+	})
+
+	t.Run("The sections are cycled, leaving out a missing deployment", func(t *testing.T) {
+		jobStatus := component.NewJobStatus()
+		jobStatus.Props.Data = &models.JobStatus{ID: "fakeID"}
+
+		r.Equal(jobStatus.Allocations, jobStatus.ResetSection())
+		r.Equal(jobStatus.Summary, jobStatus.NextSection(false))
+		r.Equal(jobStatus.Allocations, jobStatus.NextSection(true))
+
+		jobStatus.Props.Data.TaskGroupStatus = []*models.TaskGroupStatus{{TaskGroup: "web"}}
+
+		r.Equal(jobStatus.Deployment, jobStatus.NextSection(true))
+		r.Equal(jobStatus.Summary, jobStatus.NextSection(true))
+		r.Equal(jobStatus.Allocations, jobStatus.NextSection(true))
 	})
 
 	t.Run("When there is no data to render", func(t *testing.T) {
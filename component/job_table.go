@@ -44,6 +44,7 @@ type JobTable struct {
 
 type JobTableProps struct {
 	SelectJob         SelectJobFunc
+	HighlightJob      SelectJobFunc
 	HandleNoResources models.HandlerFunc
 
 	Data      []*models.Job
@@ -85,7 +86,9 @@ func (j *JobTable) Render() error {
 	}
 
 	j.Table.SetSelectedFunc(j.jobSelected)
+	j.Table.SetSelectionChangedFunc(j.jobHighlighted)
 	j.Table.RenderHeader(TableHeaderJobs)
+	j.Table.SetKeyColumns(0, 3)
 	j.renderRows()
 
 	j.slot.AddItem(j.Table.Primitive(), 0, 1, false)
@@ -97,6 +100,12 @@ func (j *JobTable) GetIDForSelection() string {
 	return j.Table.GetCellContent(row, 0)
 }
 
+// GetNamespaceForSelection returns the namespace of the selected job.
+func (j *JobTable) GetNamespaceForSelection() string {
+	row, _ := j.Table.GetSelection()
+	return j.Table.GetCellContent(row, 3)
+}
+
 func (j *JobTable) validate() error {
 	if j.Props.SelectJob == nil || j.Props.HandleNoResources == nil {
 		return ErrComponentPropsNotSet
@@ -119,6 +128,15 @@ func (j *JobTable) jobSelected(row, _ int) {
 	j.Props.SelectJob(jobID)
 }
 
+func (j *JobTable) jobHighlighted(row, _ int) {
+	if j.Props.HighlightJob == nil || row < 1 {
+		return
+	}
+
+	jobID := j.Table.GetCellContent(row, 0)
+	j.Props.HighlightJob(jobID)
+}
+
 func (j *JobTable) renderRows() {
 	for i, job := range j.Props.Data {
 		row := []string{
@@ -138,6 +156,8 @@ func (j *JobTable) renderRows() {
 
 		j.Table.RenderRow(row, index, c)
 	}
+
+	j.Table.Commit()
 }
 
 func (j *JobTable) cellColor(status, typ string, summary models.Summary) tcell.Color {
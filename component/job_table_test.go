@@ -104,6 +104,9 @@ func TestJobTable_Happy(t *testing.T) {
 		renderRowCallCount := fakeTable.RenderRowCallCount()
 		r.Equal(renderRowCallCount, 5)
 
+		// It commits the rows once all are rendered
+		r.Equal(1, fakeTable.CommitCallCount())
+
 		row1, index1, c1 := fakeTable.RenderRowArgsForCall(0)
 		row2, index2, c2 := fakeTable.RenderRowArgsForCall(1)
 		row3, index3, c3 := fakeTable.RenderRowArgsForCall(2)
@@ -176,6 +179,55 @@ func TestJobTable_Happy(t *testing.T) {
 		r.Equal(fakeTable.ClearCallCount(), 2)
 	})
 
+	t.Run("When a job gets highlighted", func(t *testing.T) {
+		fakeTable := &componentfakes.FakeTable{}
+		jt := component.NewJobsTable()
+
+		jt.Table = fakeTable
+		jt.Props.Data = []*models.Job{{ID: "ichi"}}
+		jt.Props.SelectJob = func(id string) {}
+		jt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+		var highlighted string
+		jt.Props.HighlightJob = func(id string) {
+			highlighted = id
+		}
+
+		jt.Bind(tview.NewFlex())
+
+		err := jt.Render()
+		r.NoError(err)
+
+		fakeTable.GetCellContentReturns("ichi")
+
+		// It registers the selection changed func
+		r.Equal(fakeTable.SetSelectionChangedFuncCallCount(), 1)
+		changed := fakeTable.SetSelectionChangedFuncArgsForCall(0)
+
+		// It ignores the header row
+		changed(0, 0)
+		r.Empty(highlighted)
+
+		// It calls HighlightJob with the job ID of the row
+		changed(1, 0)
+		r.Equal("ichi", highlighted)
+	})
+
+	t.Run("It returns the namespace of the selected job", func(t *testing.T) {
+		fakeTable := &componentfakes.FakeTable{}
+		jt := component.NewJobsTable()
+		jt.Table = fakeTable
+
+		fakeTable.GetSelectionReturns(2, 0)
+		fakeTable.GetCellContentReturns("prod")
+
+		r.Equal("prod", jt.GetNamespaceForSelection())
+
+		row, column := fakeTable.GetCellContentArgsForCall(0)
+		r.Equal(2, row)
+		r.Equal(3, column)
+	})
+
 	t.Run("When there is no data to render", func(t *testing.T) {
 		fakeTable := &componentfakes.FakeTable{}
 		jt := component.NewJobsTable()
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleJobVersions = "Versions"
+)
+
+var (
+	TableHeaderJobVersions = []string{
+		LabelVersion,
+		LabelStable,
+		LabelSubmitTime,
+		LabelChanges,
+	}
+)
+
+// JobVersionTable lists the versions of a job with what changed
+// in each version. The running version is highlighted.
+type JobVersionTable struct {
+	Table Table
+	Props *JobVersionTableProps
+
+	slot *tview.Flex
+}
+
+type JobVersionTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	JobID string
+	Data  []*models.JobVersion
+}
+
+func NewJobVersionTable() *JobVersionTable {
+	return &JobVersionTable{
+		Table: primitive.NewTable(),
+		Props: &JobVersionTableProps{},
+	}
+}
+
+func (t *JobVersionTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *JobVersionTable) Render() error {
+	if t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno versions found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle(fmt.Sprintf("%s (Job: %s)", TableTitleJobVersions, t.Props.JobID))
+	t.Table.RenderHeader(TableHeaderJobVersions)
+
+	for i, v := range t.Props.Data {
+		changes := "-"
+		if len(v.Changes) > 0 {
+			changes = strings.Join(v.Changes, ", ")
+		}
+
+		row := []string{
+			fmt.Sprint(v.Version),
+			fmt.Sprint(v.Stable),
+			formatTime(v.SubmitTime),
+			changes,
+		}
+
+		// the versions are sorted, the latest first
+		c := tcell.ColorWhite
+		if i == 0 {
+			c = styles.TcellColorHighlighPrimary
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
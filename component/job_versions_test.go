@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestJobVersionTable(t *testing.T) {
+	t.Run("It renders the versions with their changes", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		vt := component.NewJobVersionTable()
+		vt.Table = fakeTable
+		vt.Props.JobID = "web"
+		vt.Props.Data = []*models.JobVersion{
+			{
+				Version: 1,
+				Changes: []string{"web.Count: 1 → 2", "web.server.Config.image: nginx:1.24 → nginx:1.25"},
+			},
+			{
+				Version:    0,
+				Stable:     true,
+				SubmitTime: time.Now().Add(-time.Hour),
+			},
+		}
+		vt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		vt.Bind(tview.NewFlex())
+
+		r.NoError(vt.Render())
+
+		title, _ := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Versions (Job: web)", title)
+		r.Equal(component.TableHeaderJobVersions, fakeTable.RenderHeaderArgsForCall(0))
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"1", "false", "-", "web.Count: 1 → 2, web.server.Config.image: nginx:1.24 → nginx:1.25"}, row)
+		r.Equal(styles.TcellColorHighlighPrimary, c)
+
+		row, _, c = fakeTable.RenderRowArgsForCall(1)
+		r.Equal("0", row[0])
+		r.Equal("true", row[1])
+		r.Regexp(`\(1h ago\)$`, row[2])
+		r.Equal("-", row[3])
+		r.Equal(tcell.ColorWhite, c)
+	})
+
+	t.Run("It handles a job without versions", func(t *testing.T) {
+		r := require.New(t)
+
+		vt := component.NewJobVersionTable()
+		vt.Table = &componentfakes.FakeTable{}
+
+		var called bool
+		vt.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		vt.Bind(tview.NewFlex())
+
+		r.NoError(vt.Render())
+		r.True(called)
+	})
+
+	t.Run("It fails when the props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		vt := component.NewJobVersionTable()
+		vt.Bind(tview.NewFlex())
+
+		err := vt.Render()
+		r.True(errors.Is(err, component.ErrComponentPropsNotSet))
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+const ansiEscape = '\x1b'
+
+// ansiLine is a log line whose ANSI escape sequences are separated
+// from its visible text, such that filters and highlights match the
+// text which is shown rather than the escape sequences.
+type ansiLine struct {
+	text  string
+	codes []ansiCode
+}
+
+// ansiCode is a color tag translated from an SGR sequence,
+// placed in front of the visible text at pos.
+type ansiCode struct {
+	pos int
+	tag string
+}
+
+// parseANSI separates the escape sequences of a line from its visible
+// text. SGR sequences are translated into color tags, all other escape
+// sequences are dropped.
+func parseANSI(raw string) *ansiLine {
+	if !strings.ContainsRune(raw, ansiEscape) {
+		return &ansiLine{text: raw}
+	}
+
+	// the writer keeps the attributes (e.g. bold) across
+	// sequences, so the whole line goes through one writer.
+	out := bytes.Buffer{}
+	translator := tview.ANSIWriter(&out)
+
+	line := &ansiLine{}
+	text := strings.Builder{}
+	for i := 0; i < len(raw); {
+		if raw[i] != ansiEscape {
+			end := strings.IndexByte(raw[i:], ansiEscape)
+			if end < 0 {
+				end = len(raw) - i
+			}
+
+			text.WriteString(raw[i : i+end])
+			i += end
+			continue
+		}
+
+		end := ansiSequenceEnd(raw, i)
+		if seq := raw[i:end]; strings.HasPrefix(seq, "\x1b[") && strings.HasSuffix(seq, "m") {
+			translator.Write([]byte(seq))
+			if out.Len() > 0 {
+				line.codes = append(line.codes, ansiCode{pos: text.Len(), tag: out.String()})
+				out.Reset()
+			}
+		}
+
+		i = end
+	}
+
+	line.text = text.String()
+	return line
+}
+
+// ansiSequenceEnd returns the end of the escape sequence at start.
+func ansiSequenceEnd(s string, start int) int {
+	if start+1 >= len(s) {
+		return len(s)
+	}
+
+	switch s[start+1] {
+	case '[':
+		// control sequences end with a byte in the range @ to ~
+		for i := start + 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+
+		return len(s)
+
+	case ']', 'P', 'X', '^', '_':
+		// strings end with BEL or ESC \
+		for i := start + 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+
+			if s[i] == ansiEscape && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+
+		return len(s)
+	}
+
+	return start + 2
+}
+
+// ansiMark wraps the visible text between start and end in open and
+// close, e.g. to color the match of a filter. A mark which colors the
+// text keeps its color: colors of the line inside the mark are skipped
+// and restored after it.
+type ansiMark struct {
+	start, end  int
+	open, close string
+	color       bool
+}
+
+// render returns the escaped visible text with its colors and
+// marks. Marks must be ordered and must not overlap.
+func (a *ansiLine) render(marks ...ansiMark) string {
+	cuts := []int{}
+	for _, c := range a.codes {
+		cuts = append(cuts, c.pos)
+	}
+
+	valid := []ansiMark{}
+	for _, m := range marks {
+		if m.start < m.end {
+			valid = append(valid, m)
+			cuts = append(cuts, m.start, m.end)
+		}
+	}
+
+	sort.Ints(cuts)
+
+	b := strings.Builder{}
+	prev, next, nextMark := 0, 0, 0
+	current := ""
+	open := -1
+	for i, cut := range cuts {
+		if i > 0 && cut == cuts[i-1] {
+			continue
+		}
+
+		b.WriteString(tview.Escape(a.text[prev:cut]))
+		prev = cut
+
+		for next < len(a.codes) && a.codes[next].pos == cut {
+			current = a.codes[next].tag
+			if open < 0 || !valid[open].color {
+				b.WriteString(current)
+			}
+			next++
+		}
+
+		if open >= 0 && valid[open].end == cut {
+			b.WriteString(valid[open].close)
+			if valid[open].color {
+				b.WriteString(current)
+			}
+			open = -1
+		}
+
+		if nextMark < len(valid) && valid[nextMark].start == cut {
+			b.WriteString(valid[nextMark].open)
+			open = nextMark
+			nextMark++
+		}
+	}
+
+	b.WriteString(tview.Escape(a.text[prev:]))
+
+	// don't let the colors of the line leak into the next one
+	if len(a.codes) > 0 {
+		b.WriteString("[-:-:-]")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/filter"
+	"github.com/hcjulz/damon/styles"
+)
+
+// LogMode is how the Logger renders log lines.
+type LogMode int
+
+const (
+	// LogModeRaw renders lines as they are.
+	LogModeRaw LogMode = iota
+
+	// LogModeColumns renders JSON lines as columns of the timestamp,
+	// level and message, followed by the other fields.
+	LogModeColumns
+
+	// LogModePretty renders JSON lines as indented JSON.
+	LogModePretty
+)
+
+func (m LogMode) String() string {
+	switch m {
+	case LogModeColumns:
+		return "json columns"
+	case LogModePretty:
+		return "json pretty"
+	}
+
+	return "raw"
+}
+
+// Next returns the mode which follows when cycling through the modes.
+func (m LogMode) Next() LogMode {
+	return (m + 1) % (LogModePretty + 1)
+}
+
+// The keys of the fields which are shown as columns, in order of
+// precedence. Nested fields are joined with a dot.
+var (
+	logTimeKeys    = []string{"ts", "time", "timestamp", "@timestamp", "t"}
+	logLevelKeys   = []string{"level", "lvl", "severity", "@level", "log.level"}
+	logMessageKeys = []string{"msg", "message", "@message"}
+)
+
+// rxLogPredicate matches the field predicates of a log filter,
+// such as level=error or -user_id=42.
+var rxLogPredicate = regexp.MustCompile(`^-?[A-Za-z_][A-Za-z0-9_.]*(!=|>=|<=|=|>|<)\S+$`)
+
+// jsonLog is a log line which holds a JSON object.
+type jsonLog struct {
+	object map[string]interface{}
+
+	// fields holds the values of the object by their lower case
+	// key. Keys of nested objects are joined with a dot.
+	fields filter.Fields
+}
+
+func parseJSONLog(text string) (*jsonLog, bool) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "{") {
+		return nil, false
+	}
+
+	object := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(text), &object); err != nil {
+		return nil, false
+	}
+
+	fields := filter.Fields{}
+	flattenJSON("", object, fields)
+
+	return &jsonLog{object: object, fields: fields}, true
+}
+
+func flattenJSON(prefix string, object map[string]interface{}, fields filter.Fields) {
+	for k, v := range object {
+		key := strings.ToLower(k)
+		if prefix != "" {
+			key = fmt.Sprintf("%s.%s", prefix, key)
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenJSON(key, nested, fields)
+			continue
+		}
+
+		fields[key] = v
+	}
+}
+
+// lookup returns the first of the keys which is
+// part of the line and its value.
+func (j *jsonLog) lookup(keys []string) (string, string) {
+	for _, key := range keys {
+		if value, ok := j.fields[key]; ok {
+			return key, formatJSONValue(value)
+		}
+	}
+
+	return "", ""
+}
+
+// columns renders the timestamp, the level and the message
+// of the line, followed by the other fields as key=value.
+func (j *jsonLog) columns() string {
+	tsKey, ts := j.lookup(logTimeKeys)
+	levelKey, level := j.lookup(logLevelKeys)
+	msgKey, msg := j.lookup(logMessageKeys)
+
+	columns := []string{}
+	if tsKey != "" {
+		columns = append(columns, fmt.Sprintf("%s%s", styles.ColorLighGreyTag, tview.Escape(ts)))
+	}
+
+	if levelKey != "" {
+		columns = append(columns, fmt.Sprintf("[%s]%s",
+			logLevelColor(level),
+			tview.Escape(fmt.Sprintf("%-5s", strings.ToUpper(level))),
+		))
+	}
+
+	if msgKey != "" {
+		columns = append(columns, fmt.Sprintf("%s%s", styles.ColorWhiteTag, tview.Escape(msg)))
+	}
+
+	keys := []string{}
+	for key := range j.fields {
+		if key != tsKey && key != levelKey && key != msgKey {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		columns = append(columns, fmt.Sprintf("%s%s=%s%s",
+			styles.ColorLighGreyTag,
+			tview.Escape(key),
+			styles.ColorWhiteTag,
+			tview.Escape(formatJSONValue(j.fields[key])),
+		))
+	}
+
+	return strings.Join(columns, " ")
+}
+
+// pretty renders the line as indented JSON in the color of its level.
+func (j *jsonLog) pretty() string {
+	data, _ := json.MarshalIndent(j.object, "", "  ")
+
+	color := styles.ColorWhiteHex
+	if key, level := j.lookup(logLevelKeys); key != "" {
+		color = logLevelColor(level)
+	}
+
+	return fmt.Sprintf("[%s]%s%s", color, tview.Escape(string(data)), styles.ColorWhiteTag)
+}
+
+func formatJSONValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// logLevelColor returns the color of a level. Numeric
+// levels (e.g. 50 for error) are supported as well.
+func logLevelColor(level string) string {
+	if n, err := strconv.Atoi(level); err == nil {
+		switch {
+		case n >= 50:
+			level = "error"
+		case n >= 40:
+			level = "warn"
+		case n >= 30:
+			level = "info"
+		default:
+			level = "debug"
+		}
+	}
+
+	switch strings.ToLower(level) {
+	case "error", "err", "fatal", "panic", "critical", "crit", "alert", "emerg", "emergency":
+		return styles.ColorAttentionHex
+	case "warn", "warning":
+		return styles.ColorWarningHex
+	case "debug", "trace":
+		return styles.ColorLightGreyHex
+	}
+
+	return styles.StandardColorHex
+}
+
+// rxLogWord matches the words of a log filter.
+var rxLogWord = regexp.MustCompile(`\S+`)
+
+// splitLogFilter splits a log filter into field predicates,
+// which match fields of JSON lines, and a pattern, which
+// matches the text of a line. The pattern is the filter
+// without the predicates, its other words are kept as
+// they were typed.
+func splitLogFilter(text string) (string, string) {
+	predicates := []string{}
+	pattern := ""
+	start := 0
+
+	words := rxLogWord.FindAllStringIndex(text, -1)
+	for i, loc := range words {
+		word := text[loc[0]:loc[1]]
+		if !rxLogPredicate.MatchString(word) {
+			continue
+		}
+
+		predicates = append(predicates, word)
+
+		// the predicate is removed with the whitespace which
+		// follows it, or precedes it if it is the last word.
+		if i+1 < len(words) {
+			pattern += text[start:loc[0]]
+			start = words[i+1][0]
+		} else {
+			pattern += strings.TrimRight(text[start:loc[0]], " \t\n\f\r")
+			start = len(text)
+		}
+	}
+
+	if len(predicates) == 0 {
+		return "", text
+	}
+
+	pattern += text[start:]
+
+	return strings.Join(predicates, " "), pattern
+}
+
+// compileLogFilter compiles the field predicates and the pattern of a
+// log filter. Lines which don't hold JSON have no fields to match the
+// predicates, they are matched by the fallback, which is the whole
+// filter. If the predicates are invalid, the whole filter is used as
+// the pattern.
+func compileLogFilter(text string, compile func(string) *regexp.Regexp) (*filter.Query, *regexp.Regexp, *regexp.Regexp) {
+	predicates, pattern := splitLogFilter(text)
+
+	query, err := filter.Parse(predicates, nil)
+	if err != nil || query == nil {
+		return nil, compile(text), nil
+	}
+
+	return query, compile(pattern), compile(text)
+}
+
+// ValidateLogFilter returns an error if the field
+// predicates of a log filter are invalid.
+func ValidateLogFilter(text string) error {
+	predicates, _ := splitLogFilter(text)
+
+	_, err := filter.Parse(predicates, nil)
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+// logPrefixColors are the colors of the alloc/task prefixes
+// in merged logs. A task keeps its color across refreshes.
+var logPrefixColors = []string{
+	"#26ffe6",
+	"#baff26",
+	"#ffd126",
+	"#ff8f26",
+	"#26a8ff",
+	"#c792ea",
+	"#ff5c8a",
+	"#7fdbca",
+}
+
+// formatLogLine renders a line of a merged log stream. Each line
+// gets a colored alloc/task prefix and is labelled with its source.
+// Lines of a single stream are returned as they are.
+func formatLogLine(l *models.LogLine, text string) string {
+	if l.AllocID == "" {
+		return text
+	}
+
+	prefix := fmt.Sprintf("%s/%s", shortID(l.AllocID), l.Task)
+
+	source := fmt.Sprintf("%sout", styles.ColorLighGreyTag)
+	if l.Source == "stderr" {
+		source = fmt.Sprintf("[%s]err", styles.ColorAttentionHex)
+	}
+
+	return fmt.Sprintf("[%s]%s %s%s %s",
+		logPrefixColor(prefix),
+		tview.Escape(prefix),
+		source,
+		styles.ColorWhiteTag,
+		text,
+	)
+}
+
+func logPrefixColor(prefix string) string {
+	h := fnv.New32a()
+	h.Write([]byte(prefix))
+
+	return logPrefixColors[h.Sum32()%uint32(len(logPrefixColors))]
+}
@@ -7,11 +7,12 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
-	"strings"
 	"sync"
 
 	"github.com/rivo/tview"
 
+	"github.com/hcjulz/damon/filter"
+	"github.com/hcjulz/damon/logbuffer"
 	"github.com/hcjulz/damon/models"
 	primitive "github.com/hcjulz/damon/primitives"
 	"github.com/hcjulz/damon/styles"
@@ -23,18 +24,65 @@ type Logger struct {
 	TextView TextView
 	Props    *LogStreamProps
 	slot     *tview.Flex
-	buf      strings.Builder
 	mutex    sync.Mutex
+
+	// rendered describes what the text view shows, such that
+	// new lines can be appended instead of rendering all lines.
+	rendered *renderedLogs
+
+	// matches are the matches of the search in the text view,
+	// in order, and current is the ID of the highlighted one.
+	matches []logMatch
+	current string
 }
 
 type LogStreamProps struct {
 	HandleNoResources models.HandlerFunc
 	Filter            string
 	Highlight         string
-	Data              []byte
+	Buffer            *logbuffer.Buffer
+	Mode              LogMode
 	ChangedFunc       func()
 	TaskName          string
 	App               *tview.Application
+
+	// Search is the pattern whose matches can be
+	// navigated with NextMatch and PrevMatch.
+	Search string
+
+	// IgnoreCase and Literal apply to the patterns of the
+	// filter, the highlight and the search.
+	IgnoreCase bool
+	Literal    bool
+
+	// Context is the number of lines shown around
+	// the lines which match the filter.
+	Context int
+}
+
+// logOptions are the props which affect how lines are rendered.
+type logOptions struct {
+	filter     string
+	highlight  string
+	search     string
+	mode       LogMode
+	ignoreCase bool
+	literal    bool
+	context    int
+}
+
+type renderedLogs struct {
+	buffer  *logbuffer.Buffer
+	seq     uint64
+	options logOptions
+}
+
+// logMatch is a match of the search. The id is the region
+// of the match in the text view and seq the sequence number
+// of its line.
+type logMatch struct {
+	id  string
+	seq uint64
 }
 
 func NewLogger() *Logger {
@@ -43,7 +91,6 @@ func NewLogger() *Logger {
 	l := &Logger{
 		TextView: t,
 		Props:    &LogStreamProps{},
-		buf:      strings.Builder{},
 	}
 
 	t.ModifyPrimitive(l.applyLogModifiers)
@@ -55,6 +102,10 @@ func (l *Logger) Bind(slot *tview.Flex) {
 	l.slot = slot
 }
 
+// Render shows the lines of the log buffer. Lines which were appended
+// since the last render are written to the text view, while all lines
+// are rendered again when the props changed, when lines were dropped
+// before they were rendered, or when context lines are shown.
 func (l *Logger) Render() error {
 	if l.slot == nil {
 		return ErrComponentNotBound
@@ -64,9 +115,15 @@ func (l *Logger) Render() error {
 		return ErrComponentPropsNotSet
 	}
 
-	l.ClearDisplay()
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
 
-	if len(l.Props.Data) == 0 {
+	buffer := l.Props.Buffer
+	if buffer == nil || buffer.Len() == 0 {
+		l.rendered = nil
+		l.matches = nil
+		l.current = ""
+		l.ClearDisplay()
 		l.Props.HandleNoResources(
 			"%sWHOOOPS, no Logs found",
 			styles.HighlightSecondaryTag,
@@ -74,28 +131,179 @@ func (l *Logger) Render() error {
 		return nil
 	}
 
-	lines := bytes.Split(l.Props.Data, []byte("\n"))
-	if len(lines) > 1000 {
-		rem := len(lines) % 1000
-		lines = lines[rem:]
-		l.Props.Data = bytes.Join(lines, []byte("\n"))
+	options := l.options()
+	if r := l.rendered; r != nil &&
+		r.buffer == buffer &&
+		r.options == options &&
+		(options.context == 0 || options.filter == "") {
+		lines, seq, ok := buffer.Since(r.seq)
+		if ok {
+			if len(lines) > 0 {
+				text, matches := l.format(lines, r.seq+1)
+				l.TextView.Write(text)
+				l.setMatches(append(l.matches, matches...), seq, buffer.Len(), false)
+			}
+
+			r.seq = seq
+			return nil
+		}
 	}
 
-	display := filter(l.Props.Data, l.Props.Filter)
+	searchChanged := l.rendered == nil ||
+		l.rendered.options.search != options.search ||
+		l.rendered.options.ignoreCase != options.ignoreCase ||
+		l.rendered.options.literal != options.literal
 
-	if l.Props.Filter == "" {
-		display = highlight(display, l.Props.Highlight)
-	}
+	lines, seq := buffer.Lines()
+	text, matches := l.format(lines, seq-uint64(len(lines))+1)
 
-	l.Clear()
+	l.ClearDisplay()
+	l.TextView.ModifyPrimitive(func(t *tview.TextView) {
+		t.SetMaxLines(buffer.Limit())
+	})
+	l.TextView.SetText(string(text))
+	l.Display()
 
-	l.SetText(string(display))
+	l.rendered = &renderedLogs{
+		buffer:  buffer,
+		seq:     seq,
+		options: options,
+	}
 
-	l.Display()
+	l.setMatches(matches, seq, buffer.Len(), searchChanged)
 
 	return nil
 }
 
+func (l *Logger) options() logOptions {
+	return logOptions{
+		filter:     l.Props.Filter,
+		highlight:  l.Props.Highlight,
+		search:     l.Props.Search,
+		mode:       l.Props.Mode,
+		ignoreCase: l.Props.IgnoreCase,
+		literal:    l.Props.Literal,
+		context:    l.Props.Context,
+	}
+}
+
+// setMatches sets the matches of the search, without the matches of
+// lines which were dropped from the buffer. When the search changed,
+// the last match is highlighted, as the view follows the end of the
+// logs.
+func (l *Logger) setMatches(matches []logMatch, seq uint64, size int, searchChanged bool) {
+	oldest := seq - uint64(size) + 1
+
+	l.matches = l.matches[:0]
+	for _, m := range matches {
+		if m.seq >= oldest {
+			l.matches = append(l.matches, m)
+		}
+	}
+
+	switch {
+	case len(l.matches) == 0:
+		l.current = ""
+	case searchChanged:
+		l.current = l.matches[len(l.matches)-1].id
+	case l.matchIndex() < 0:
+		l.current = l.matches[0].id
+	}
+
+	if l.current == "" {
+		l.TextView.Highlight()
+	} else {
+		l.TextView.Highlight(l.current)
+	}
+
+	if searchChanged && l.current != "" {
+		l.scrollToMatch()
+	}
+
+	l.updateTitle()
+}
+
+// NextMatch highlights the next match of the search and scrolls to it.
+func (l *Logger) NextMatch() {
+	l.moveMatch(1)
+}
+
+// PrevMatch highlights the previous match of the search and scrolls to it.
+func (l *Logger) PrevMatch() {
+	l.moveMatch(-1)
+}
+
+func (l *Logger) moveMatch(step int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if len(l.matches) == 0 {
+		return
+	}
+
+	i := (l.matchIndex() + step + len(l.matches)) % len(l.matches)
+	l.current = l.matches[i].id
+
+	l.TextView.Highlight(l.current)
+	l.scrollToMatch()
+	l.updateTitle()
+}
+
+// MatchCount returns the position of the highlighted match,
+// starting at 1, and the number of matches of the search.
+func (l *Logger) MatchCount() (int, int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.matchIndex() + 1, len(l.matches)
+}
+
+func (l *Logger) matchIndex() int {
+	for i, m := range l.matches {
+		if m.id == l.current {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (l *Logger) scrollToMatch() {
+	l.TextView.ModifyPrimitive(func(t *tview.TextView) {
+		t.ScrollToHighlight()
+	})
+}
+
+// updateTitle shows the mode, the position of the highlighted
+// match and the search options in the title.
+func (l *Logger) updateTitle() {
+	title := "Logs"
+	if l.Props.Mode != LogModeRaw {
+		title = fmt.Sprintf("%s (%s)", title, l.Props.Mode)
+	}
+
+	if l.Props.Search != "" {
+		title = fmt.Sprintf("%s find %q %d/%d", title, l.Props.Search, l.matchIndex()+1, len(l.matches))
+	}
+
+	if l.Props.IgnoreCase {
+		title += " -i"
+	}
+
+	if l.Props.Literal {
+		title += " -F"
+	}
+
+	if l.Props.Context > 0 {
+		title = fmt.Sprintf("%s -C%d", title, l.Props.Context)
+	}
+
+	title = tview.Escape(title)
+	l.TextView.ModifyPrimitive(func(t *tview.TextView) {
+		t.SetTitle(title)
+	})
+}
+
 func (l *Logger) ClearDisplay() {
 	l.slot.Clear()
 }
@@ -104,90 +312,246 @@ func (l *Logger) Display() {
 	l.slot.AddItem(l.TextView.Primitive(), 0, 1, true)
 }
 
+// Clear empties the text view. The next
+// render shows all lines of the buffer.
 func (l *Logger) Clear() {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 
-	// l.Props.App.QueueUpdate(func() {
+	l.rendered = nil
 	l.TextView.Clear()
-	// })
 }
 
 func (l *Logger) SetText(log string) {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 
-	// l.Props.App.QueueUpdateDraw(func() {
+	l.rendered = nil
 	l.TextView.SetText(log)
-	// })
 }
 
-func filter(logs []byte, filter string) []byte {
-	if filter == "" {
-		return logs
-	}
+// format renders the lines which pass the filter, the first of
+// which has the sequence number first. With context lines, the
+// lines around the matching lines are rendered as well.
+func (l *Logger) format(lines []*models.LogLine, first uint64) ([]byte, []logMatch) {
+	r := l.renderer()
 
 	buf := bytes.Buffer{}
-	defer buf.Reset()
+	matches := []logMatch{}
+	write := func(line *models.LogLine, seq uint64, text string, ids []string) {
+		fmt.Fprintf(&buf, "%s\n", formatLogLine(line, text))
+		for _, id := range ids {
+			matches = append(matches, logMatch{id: id, seq: seq})
+		}
+	}
+
+	if r.context == 0 || !r.filtered() {
+		for i, line := range lines {
+			seq := first + uint64(i)
+			if text, ids, ok := r.render(line.Text, seq, true); ok {
+				write(line, seq, text, ids)
+			}
+		}
 
-	rx := regexp.MustCompile(filter)
+		return buf.Bytes(), matches
+	}
 
-	logLines := bytes.Split(logs, []byte("\n"))
-	var result []byte
-	for _, log := range logLines {
-		if rx.Match(log) {
-			idx := rx.FindIndex([]byte(log))
-			fmt.Fprintf(
-				&buf,
-				"%s%s%s%s%s%s\n",
-				[]byte(styles.ColorLighGreyTag),
-				log[:idx[0]],
-				[]byte(styles.HighlightSecondaryTag),
-				log[idx[0]:idx[1]],
-				[]byte(styles.ColorLighGreyTag),
-				log[idx[1]:],
-			)
+	shown := make([]bool, len(lines))
+	for i, line := range lines {
+		if _, _, ok := r.render(line.Text, first+uint64(i), true); !ok {
+			continue
+		}
 
-			result = append(result, buf.Bytes()...)
-			buf.Reset()
+		for j := i - r.context; j <= i+r.context; j++ {
+			if j >= 0 && j < len(lines) {
+				shown[j] = true
+			}
 		}
 	}
-	return result
+
+	last := -1
+	for i, line := range lines {
+		if !shown[i] {
+			continue
+		}
+
+		// separate groups of lines like grep -C
+		if last >= 0 && i > last+1 {
+			fmt.Fprintf(&buf, "%s--\n", styles.ColorLighGreyTag)
+		}
+
+		seq := first + uint64(i)
+		text, ids, ok := r.render(line.Text, seq, true)
+		if !ok {
+			text, ids, _ = r.render(line.Text, seq, false)
+		}
+
+		write(line, seq, text, ids)
+		last = i
+	}
+
+	return buf.Bytes(), matches
 }
 
-func highlight(logs []byte, highlight string) []byte {
-	if highlight == "" {
-		return logs
+// logRenderer renders lines with the compiled patterns of the props.
+type logRenderer struct {
+	mode      LogMode
+	query     *filter.Query
+	pattern   *regexp.Regexp
+	fallback  *regexp.Regexp
+	highlight *regexp.Regexp
+	search    *regexp.Regexp
+	context   int
+}
+
+func (l *Logger) renderer() *logRenderer {
+	compile := func(pattern string) *regexp.Regexp {
+		return compileLogPattern(pattern, l.Props.IgnoreCase, l.Props.Literal)
 	}
 
-	buf := bytes.Buffer{}
-	defer buf.Reset()
+	query, pattern, fallback := compileLogFilter(l.Props.Filter, compile)
+
+	return &logRenderer{
+		mode:      l.Props.Mode,
+		query:     query,
+		pattern:   pattern,
+		fallback:  fallback,
+		highlight: compile(l.Props.Highlight),
+		search:    compile(l.Props.Search),
+		context:   l.Props.Context,
+	}
+}
+
+func (r *logRenderer) filtered() bool {
+	return r.query != nil || r.pattern != nil
+}
+
+// render renders the text of a line according to the mode. Lines
+// which hold JSON are rendered as columns or pretty printed, all other
+// lines as they are, with their ANSI colors. Matches of the search are
+// marked as regions and their IDs are returned. If filtered is set, it
+// reports false if the visible text doesn't pass the field predicates
+// and the pattern of the filter. Lines which don't hold JSON are
+// matched by the whole filter instead.
+func (r *logRenderer) render(raw string, seq uint64, filtered bool) (string, []string, bool) {
+	line := parseANSI(raw)
+	text := line.text
+
+	var j *jsonLog
+	if (filtered && r.query != nil) || r.mode != LogModeRaw {
+		j, _ = parseJSONLog(text)
+	}
+
+	pattern := r.pattern
+	if filtered && r.query != nil {
+		if j == nil {
+			pattern = r.fallback
+		} else if !r.query.Match(j.fields) {
+			return "", nil, false
+		}
+	}
+
+	var match []int
+	if filtered && pattern != nil {
+		if match = pattern.FindStringIndex(text); match == nil {
+			return "", nil, false
+		}
+	}
+
+	ids := []string{}
+	marks := []ansiMark{}
+	if r.search != nil {
+		for k, loc := range r.search.FindAllStringIndex(text, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+
+			id := fmt.Sprintf("m%d_%d", seq, k)
+			ids = append(ids, id)
+			marks = append(marks, ansiMark{
+				start: loc[0],
+				end:   loc[1],
+				open:  fmt.Sprintf(`["%s"]`, id),
+				close: `[""]`,
+			})
+		}
+	}
+
+	if j != nil && r.mode != LogModeRaw {
+		rendered := j.columns()
+		if r.mode == LogModePretty {
+			rendered = j.pretty()
+		}
+
+		// the line is already colored by its level,
+		// so highlighted lines are shown reversed.
+		if match == nil && r.highlight != nil && r.highlight.MatchString(text) {
+			rendered = fmt.Sprintf("[::r]%s[::-]", rendered)
+		}
 
-	rx, _ := regexp.Compile(highlight)
-	logLines := bytes.Split(logs, []byte("\n"))
-	var result []byte
-	for _, log := range logLines {
-		if rx.Match(log) {
-			fmt.Fprintf(&buf, "%s%s%s\n",
-				[]byte(styles.HighlightSecondaryTag),
-				log,
-				[]byte(styles.ColorWhiteTag),
-			)
-		} else {
-			fmt.Fprintf(&buf, "%s\n", log)
+		// matches can't be placed in the rendered JSON,
+		// so the whole line is a single match.
+		if len(ids) > 0 {
+			ids = ids[:1]
+			rendered = fmt.Sprintf(`["%s"]%s[""]`, ids[0], rendered)
 		}
 
-		result = append(result, buf.Bytes()...)
-		buf.Reset()
+		return rendered, ids, true
+	}
+
+	switch {
+	case match != nil:
+		// matches of the search are marked instead
+		// if both are shown, as marks can't overlap.
+		if len(marks) == 0 {
+			marks = append(marks, ansiMark{
+				start: match[0],
+				end:   match[1],
+				open:  styles.HighlightSecondaryTag,
+				close: styles.ColorLighGreyTag,
+				color: true,
+			})
+		}
+
+		return styles.ColorLighGreyTag + line.render(marks...), ids, true
+
+	case r.highlight != nil && r.highlight.MatchString(text):
+		// highlighted lines drop their own colors,
+		// such that the highlight stands out.
+		plain := &ansiLine{text: text}
+		return styles.HighlightSecondaryTag + plain.render(marks...) + styles.ColorWhiteTag, ids, true
+	}
+
+	return line.render(marks...), ids, true
+}
+
+// compileLogPattern compiles the pattern of a filter, highlight or
+// search. A pattern which isn't a valid regular expression matches
+// literally.
+func compileLogPattern(pattern string, ignoreCase, literal bool) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+
+	flags := ""
+	if ignoreCase {
+		flags = "(?i)"
+	}
+
+	if !literal {
+		if rx, err := regexp.Compile(flags + pattern); err == nil {
+			return rx
+		}
 	}
 
-	return result
+	return regexp.MustCompile(flags + regexp.QuoteMeta(pattern))
 }
 
 func (l *Logger) applyLogModifiers(t *tview.TextView) {
 	t.SetScrollable(true)
 	t.SetBorder(true)
 	t.ScrollToEnd()
+	t.SetRegions(true)
 	t.SetTitle("Logs")
-	// t.SetMaxLines(1000)
+	t.SetMaxLines(logbuffer.DefaultLimit)
 }
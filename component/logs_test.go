@@ -5,6 +5,9 @@ package component_test
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/rivo/tview"
@@ -12,6 +15,8 @@ import (
 
 	"github.com/hcjulz/damon/component"
 	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/logbuffer"
+	"github.com/hcjulz/damon/models"
 	"github.com/hcjulz/damon/styles"
 )
 
@@ -23,7 +28,8 @@ func TestLogs_Happy(t *testing.T) {
 		logs := component.NewLogger()
 		logs.TextView = textView
 		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
-		logs.Props.Data = []byte("logs")
+		logs.Props.Buffer = logbuffer.New(10)
+		logs.Props.Buffer.Append(&models.LogLine{Text: "logs"})
 
 		logs.Bind(tview.NewFlex())
 
@@ -31,7 +37,104 @@ func TestLogs_Happy(t *testing.T) {
 		r.NoError(err)
 
 		text := textView.SetTextArgsForCall(0)
-		r.Equal(string(text), "logs")
+		r.Equal(string(text), "logs\n")
+	})
+
+	t.Run("When new lines are appended", func(t *testing.T) {
+		textView := &componentfakes.FakeTextView{}
+		logs := component.NewLogger()
+		logs.TextView = textView
+		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		logs.Props.Buffer = logbuffer.New(2)
+		logs.Props.Buffer.Append(&models.LogLine{Text: "first"})
+
+		logs.Bind(tview.NewFlex())
+		r.NoError(logs.Render())
+
+		// It writes the new lines only
+		logs.Props.Buffer.Append(&models.LogLine{Text: "second"})
+		r.NoError(logs.Render())
+
+		r.Equal(1, textView.SetTextCallCount())
+		r.Equal(1, textView.WriteCallCount())
+		r.Equal("second\n", string(textView.WriteArgsForCall(0)))
+
+		// It renders all lines when the filter changes
+		logs.Props.Filter = "sec"
+		r.NoError(logs.Render())
+
+		r.Equal(2, textView.SetTextCallCount())
+		r.Equal(
+			fmt.Sprintf("%s%ssec%sond\n", styles.ColorLighGreyTag, styles.HighlightSecondaryTag, styles.ColorLighGreyTag),
+			textView.SetTextArgsForCall(1),
+		)
+
+		// It renders all lines when lines were dropped before they were rendered
+		logs.Props.Filter = ""
+		r.NoError(logs.Render())
+		logs.Props.Buffer.Append(
+			&models.LogLine{Text: "third"},
+			&models.LogLine{Text: "fourth"},
+			&models.LogLine{Text: "fifth"},
+		)
+		r.NoError(logs.Render())
+
+		r.Equal(4, textView.SetTextCallCount())
+		r.Equal("fourth\nfifth\n", textView.SetTextArgsForCall(3))
+	})
+
+	t.Run("When the lines come from a merged log stream", func(t *testing.T) {
+		textView := &componentfakes.FakeTextView{}
+		logs := component.NewLogger()
+		logs.TextView = textView
+		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		logs.Props.Buffer = logbuffer.New(10)
+		logs.Props.Buffer.Append(
+			&models.LogLine{AllocID: "0123456789abcdef", Task: "web", Source: "stdout", Text: "listening on :8080"},
+			&models.LogLine{AllocID: "0123456789abcdef", Task: "web", Source: "stderr", Text: "[warn] slow request"},
+			&models.LogLine{AllocID: "fedcba9876543210", Task: "web", Source: "stdout", Text: "ready"},
+		)
+
+		logs.Bind(tview.NewFlex())
+		r.NoError(logs.Render())
+
+		text := textView.SetTextArgsForCall(0)
+		lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+		r.Len(lines, 3)
+
+		// It prefixes lines with the short alloc ID and the task
+		r.Contains(lines[0], "01234567/web")
+		r.Contains(lines[2], "fedcba98/web")
+
+		// It labels the source
+		r.Contains(lines[0], "]out")
+		r.Contains(lines[1], "]err")
+
+		// It escapes the log line, such that it isn't treated as a color tag
+		r.Contains(lines[1], "[warn[] slow request")
+		r.True(strings.HasSuffix(lines[0], " listening on :8080"))
+
+		// The same alloc/task gets the same color
+		r.Equal(lines[0][:9], lines[1][:9])
+	})
+
+	t.Run("When the filter isn't a valid regular expression", func(t *testing.T) {
+		textView := &componentfakes.FakeTextView{}
+		logs := component.NewLogger()
+		logs.TextView = textView
+		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		logs.Props.Buffer = logbuffer.New(10)
+		logs.Props.Buffer.Append(&models.LogLine{Text: "a (b"}, &models.LogLine{Text: "c"})
+		logs.Props.Filter = "("
+
+		logs.Bind(tview.NewFlex())
+		r.NoError(logs.Render())
+
+		// It matches the filter literally
+		r.Equal(
+			fmt.Sprintf("%sa %s(%sb\n", styles.ColorLighGreyTag, styles.HighlightSecondaryTag, styles.ColorLighGreyTag),
+			textView.SetTextArgsForCall(0),
+		)
 	})
 
 	t.Run("When there is no data to render", func(t *testing.T) {
@@ -57,6 +160,309 @@ func TestLogs_Happy(t *testing.T) {
 	})
 }
 
+func TestLogs_JSON(t *testing.T) {
+	lines := []*models.LogLine{
+		{Text: `{"ts":"2023-01-02T15:04:05Z","level":"error","msg":"request failed","user_id":42,"http":{"status":500}}`},
+		{Text: `{"ts":"2023-01-02T15:04:06Z","level":"info","msg":"request served","user_id":7}`},
+		{Text: "plain [text] line"},
+		{Text: "status=500 GET /health"},
+	}
+
+	render := func(mode component.LogMode, filter string) string {
+		textView := &componentfakes.FakeTextView{}
+		logs := component.NewLogger()
+		logs.TextView = textView
+		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		logs.Props.Buffer = logbuffer.New(10)
+		logs.Props.Buffer.Append(lines...)
+		logs.Props.Mode = mode
+		logs.Props.Filter = filter
+
+		logs.Bind(tview.NewFlex())
+		require.NoError(t, logs.Render())
+
+		return textView.SetTextArgsForCall(0)
+	}
+
+	t.Run("It renders JSON lines as columns", func(t *testing.T) {
+		r := require.New(t)
+
+		out := strings.Split(stripTags(render(component.LogModeColumns, "")), "\n")
+		r.Equal("2023-01-02T15:04:05Z ERROR request failed http.status=500 user_id=42", out[0])
+		r.Equal("2023-01-02T15:04:06Z INFO  request served user_id=7", out[1])
+
+		// Lines which don't hold JSON are rendered as they are
+		r.Equal("plain [text] line", out[2])
+	})
+
+	t.Run("It colors lines by level", func(t *testing.T) {
+		r := require.New(t)
+
+		out := strings.Split(render(component.LogModeColumns, ""), "\n")
+		r.Contains(out[0], fmt.Sprintf("[%s]ERROR", styles.ColorAttentionHex))
+		r.Contains(out[1], fmt.Sprintf("[%s]INFO", styles.StandardColorHex))
+	})
+
+	t.Run("It pretty prints JSON lines", func(t *testing.T) {
+		r := require.New(t)
+
+		out := stripTags(render(component.LogModePretty, ""))
+		r.Contains(out, "{\n  \"http\": {\n    \"status\": 500\n  },")
+	})
+
+	t.Run("It filters by field predicates", func(t *testing.T) {
+		r := require.New(t)
+
+		out := strings.Split(strings.TrimSuffix(stripTags(render(component.LogModeColumns, "level=error")), "\n"), "\n")
+		r.Len(out, 1)
+		r.Contains(out[0], "request failed")
+
+		out = strings.Split(strings.TrimSuffix(stripTags(render(component.LogModeRaw, "user_id<10 served")), "\n"), "\n")
+		r.Len(out, 1)
+		r.Contains(out[0], "request served")
+
+		out = strings.Split(strings.TrimSuffix(stripTags(render(component.LogModeColumns, "http.status>=500")), "\n"), "\n")
+		r.Len(out, 1)
+		r.Contains(out[0], "request failed")
+	})
+
+	t.Run("It matches lines without JSON by the whole filter", func(t *testing.T) {
+		r := require.New(t)
+
+		out := strings.Split(strings.TrimSuffix(stripTags(render(component.LogModeRaw, "status=500")), "\n"), "\n")
+		r.Len(out, 1)
+		r.Equal("status=500 GET /health", out[0])
+
+		out = strings.Split(strings.TrimSuffix(stripTags(render(component.LogModeRaw, "status=500 GET")), "\n"), "\n")
+		r.Len(out, 1)
+		r.Equal("status=500 GET /health", out[0])
+	})
+
+	t.Run("It keeps the pattern as it was typed", func(t *testing.T) {
+		r := require.New(t)
+
+		out := stripTags(render(component.LogModeRaw, "level=error request failed"))
+		r.Contains(out, "request failed")
+
+		out = stripTags(render(component.LogModeRaw, "request  failed level=error"))
+		r.NotContains(out, "request failed")
+	})
+
+	t.Run("It validates field predicates", func(t *testing.T) {
+		r := require.New(t)
+
+		r.NoError(component.ValidateLogFilter("level=error timeout"))
+		r.Error(component.ValidateLogFilter("user_id>many"))
+	})
+}
+
+func TestLogs_ANSI(t *testing.T) {
+	render := func(text, filter, highlight string) string {
+		textView := &componentfakes.FakeTextView{}
+		logs := component.NewLogger()
+		logs.TextView = textView
+		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		logs.Props.Buffer = logbuffer.New(10)
+		logs.Props.Buffer.Append(&models.LogLine{Text: text})
+		logs.Props.Filter = filter
+		logs.Props.Highlight = highlight
+
+		logs.Bind(tview.NewFlex())
+		require.NoError(t, logs.Render())
+
+		if textView.SetTextCallCount() == 0 {
+			return ""
+		}
+
+		return textView.SetTextArgsForCall(0)
+	}
+
+	t.Run("It translates SGR sequences into color tags", func(t *testing.T) {
+		r := require.New(t)
+
+		out := render("\x1b[31mfailed\x1b[0m to [connect]\x1b[K", "", "")
+		r.Equal("[maroon:]failed[-:-:-] to [connect[][-:-:-]\n", out)
+	})
+
+	t.Run("It keeps attributes across sequences", func(t *testing.T) {
+		r := require.New(t)
+
+		out := render("\x1b[1m\x1b[32mok\x1b[0m", "", "")
+		r.Equal("[::b][green::b]ok[-:-:-][-:-:-]\n", out)
+	})
+
+	t.Run("It filters on the visible text", func(t *testing.T) {
+		r := require.New(t)
+
+		r.Equal("", render("\x1b[31mfailed\x1b[0m", "31m", ""))
+
+		out := render("\x1b[31mfailed\x1b[0m to connect", "ed to", "")
+		r.Equal(fmt.Sprintf("%s[maroon:]fail%sed to%s[-:-:-] connect[-:-:-]\n",
+			styles.ColorLighGreyTag,
+			styles.HighlightSecondaryTag,
+			styles.ColorLighGreyTag,
+		), out)
+	})
+
+	t.Run("It highlights on the visible text", func(t *testing.T) {
+		r := require.New(t)
+
+		out := render("\x1b[31mfailed\x1b[0m to connect", "", "failed to")
+		r.Equal(fmt.Sprintf("%sfailed to connect%s\n", styles.HighlightSecondaryTag, styles.ColorWhiteTag), out)
+	})
+
+	t.Run("It detects JSON behind escape sequences", func(t *testing.T) {
+		r := require.New(t)
+
+		out := render("\x1b[2m{\"level\":\"error\",\"msg\":\"boom\"}\x1b[0m", "level=error", "")
+		r.Contains(out, "boom")
+	})
+}
+
+func TestLogs_Search(t *testing.T) {
+	newLogs := func(texts ...string) (*component.Logger, *componentfakes.FakeTextView) {
+		textView := &componentfakes.FakeTextView{}
+		logs := component.NewLogger()
+		logs.TextView = textView
+		logs.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		logs.Props.Buffer = logbuffer.New(10)
+		for _, text := range texts {
+			logs.Props.Buffer.Append(&models.LogLine{Text: text})
+		}
+
+		logs.Bind(tview.NewFlex())
+		return logs, textView
+	}
+
+	t.Run("It marks the matches as regions", func(t *testing.T) {
+		r := require.New(t)
+
+		logs, textView := newLogs("error one", "fine", "error two and error three")
+		logs.Props.Search = "error"
+		r.NoError(logs.Render())
+
+		r.Equal(`["m1_0"]error[""] one`+"\nfine\n"+`["m3_0"]error[""] two and ["m3_1"]error[""] three`+"\n", textView.SetTextArgsForCall(0))
+
+		// It highlights the last match first
+		current, total := logs.MatchCount()
+		r.Equal(3, current)
+		r.Equal(3, total)
+		r.Equal([]string{"m3_1"}, textView.HighlightArgsForCall(textView.HighlightCallCount()-1))
+	})
+
+	t.Run("It navigates between matches", func(t *testing.T) {
+		r := require.New(t)
+
+		logs, textView := newLogs("error one", "error two", "error three")
+		logs.Props.Search = "error"
+		r.NoError(logs.Render())
+
+		// It wraps around at the end
+		logs.NextMatch()
+		current, _ := logs.MatchCount()
+		r.Equal(1, current)
+		r.Equal([]string{"m1_0"}, textView.HighlightArgsForCall(textView.HighlightCallCount()-1))
+
+		logs.PrevMatch()
+		logs.PrevMatch()
+		current, _ = logs.MatchCount()
+		r.Equal(2, current)
+
+		// It keeps the match when new lines arrive
+		logs.Props.Buffer.Append(&models.LogLine{Text: "error four"})
+		r.NoError(logs.Render())
+
+		current, total := logs.MatchCount()
+		r.Equal(2, current)
+		r.Equal(4, total)
+		r.Equal(`["m4_0"]error[""] four`+"\n", string(textView.WriteArgsForCall(0)))
+	})
+
+	t.Run("It forgets the matches of dropped lines", func(t *testing.T) {
+		r := require.New(t)
+
+		logs, _ := newLogs("error", "fine")
+		logs.Props.Buffer = logbuffer.New(2)
+		logs.Props.Buffer.Append(&models.LogLine{Text: "error"}, &models.LogLine{Text: "fine"})
+		logs.Props.Search = "error"
+		r.NoError(logs.Render())
+
+		logs.Props.Buffer.Append(&models.LogLine{Text: "fine"})
+		r.NoError(logs.Render())
+
+		current, total := logs.MatchCount()
+		r.Equal(0, current)
+		r.Equal(0, total)
+	})
+
+	t.Run("It ignores case and matches literally", func(t *testing.T) {
+		r := require.New(t)
+
+		logs, textView := newLogs("ERROR: a.b", "error: axb")
+		logs.Props.Search = "error: a.b"
+		r.NoError(logs.Render())
+
+		_, total := logs.MatchCount()
+		r.Equal(1, total)
+
+		logs.Props.IgnoreCase = true
+		r.NoError(logs.Render())
+
+		_, total = logs.MatchCount()
+		r.Equal(2, total)
+
+		logs.Props.Literal = true
+		r.NoError(logs.Render())
+
+		_, total = logs.MatchCount()
+		r.Equal(1, total)
+
+		// The options apply to the filter as well
+		logs.Props.Search = ""
+		logs.Props.Filter = "a.b"
+		r.NoError(logs.Render())
+
+		r.Equal(
+			fmt.Sprintf("%sERROR: %sa.b%s\n", styles.ColorLighGreyTag, styles.HighlightSecondaryTag, styles.ColorLighGreyTag),
+			textView.SetTextArgsForCall(textView.SetTextCallCount()-1),
+		)
+	})
+
+	t.Run("It shows context lines around filtered lines", func(t *testing.T) {
+		r := require.New(t)
+
+		logs, textView := newLogs("a", "b", "match", "c", "d", "e", "match", "f")
+		logs.Props.Filter = "match"
+		logs.Props.Context = 1
+		r.NoError(logs.Render())
+
+		out := strings.Split(strings.TrimSuffix(stripTags(textView.SetTextArgsForCall(0)), "\n"), "\n")
+		r.Equal([]string{"b", "match", "c", "--", "e", "match", "f"}, out)
+
+		// New lines render all lines, as they can be context lines
+		logs.Props.Buffer.Append(&models.LogLine{Text: "g"})
+		r.NoError(logs.Render())
+
+		r.Equal(0, textView.WriteCallCount())
+		r.Equal(2, textView.SetTextCallCount())
+	})
+}
+
+// stripTags removes the color tags of rendered log lines and
+// unescapes them, such that the visible text can be compared.
+func stripTags(text string) string {
+	text = regexp.MustCompile(`\[(#[0-9a-f]{6}|::[a-z-]+)\]`).ReplaceAllString(text, "")
+	return strings.ReplaceAll(text, "[]", "]")
+}
+
+func TestLogMode_Next(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(component.LogModeColumns, component.LogModeRaw.Next())
+	r.Equal(component.LogModePretty, component.LogModeColumns.Next())
+	r.Equal(component.LogModeRaw, component.LogModePretty.Next())
+}
+
 func TestLogs_Sad(t *testing.T) {
 	r := require.New(t)
 
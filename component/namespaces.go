@@ -92,4 +92,6 @@ func (n *NamespaceTable) renderRows() {
 		index := i + 1
 		n.Table.RenderRow(row, index, tcell.ColorWhite)
 	}
+
+	n.Table.Commit()
 }
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+)
+
+const (
+	TitleNodeInfo = "Client"
+)
+
+// NodeInfo shows the details of a client: its drivers, host
+// volumes and resources, what happened to it and which
+// allocations it runs.
+type NodeInfo struct {
+	TextView TextView
+	Props    *NodeInfoProps
+	slot     *tview.Flex
+}
+
+type NodeInfoProps struct {
+	Data *models.NodeDetail
+}
+
+func NewNodeInfo() *NodeInfo {
+	return &NodeInfo{
+		TextView: primitive.NewTextView(tview.AlignLeft),
+		Props:    &NodeInfoProps{},
+	}
+}
+
+func (n *NodeInfo) Bind(slot *tview.Flex) {
+	n.slot = slot
+}
+
+func (n *NodeInfo) Render() error {
+	if n.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	n.slot.Clear()
+
+	node := n.Props.Data
+	if node == nil {
+		n.TextView.SetText("Client not available.")
+		n.slot.AddItem(n.TextView.Primitive(), 0, 1, true)
+		return nil
+	}
+
+	title := fmt.Sprintf("%s (%s)", TitleNodeInfo, node.Name)
+	n.TextView.ModifyPrimitive(func(textView *tview.TextView) {
+		textView.SetScrollable(true)
+		textView.SetBorder(true)
+		textView.SetTitle(title)
+	})
+
+	sections := []string{
+		"\n",
+		n.renderInfo(),
+		fmt.Sprintf("\n  %s\n", LabelResources),
+		n.renderResources(),
+		fmt.Sprintf("\n  %s\n", LabelDrivers),
+		n.renderDrivers(),
+		fmt.Sprintf("\n  %s\n", LabelHostVolumes),
+		n.renderHostVolumes(),
+		fmt.Sprintf("\n  %s (%d)\n", LabelAllocations, len(node.Allocations)),
+		n.renderAllocations(),
+		fmt.Sprintf("\n  %s\n", LabelEvents),
+		n.renderEvents(),
+		fmt.Sprintf("\n  %s\n", LabelMeta),
+		renderMap(node.Meta),
+		fmt.Sprintf("\n  %s\n", LabelAttributes),
+		renderMap(node.Attributes),
+	}
+
+	n.TextView.SetText(tview.Escape(strings.Join(sections, "")))
+	n.slot.AddItem(n.TextView.Primitive(), 0, 1, true)
+	return nil
+}
+
+func (n *NodeInfo) renderInfo() string {
+	node := n.Props.Data
+
+	return renderKeyValues([][]string{
+		{LabelID, node.ID},
+		{LabelName, node.Name},
+		{LabelAddress, orDash(node.Address)},
+		{LabelHTTPAddress, orDash(node.HTTPAddress)},
+		{LabelDatacenter, node.Datacenter},
+		{LabelNodePool, orDash(node.NodePool)},
+		{LabelNodeClass, orDash(node.NodeClass)},
+		{LabelVersion, orDash(node.Version)},
+		{LabelStatus, node.Status},
+		{LabelStatusDescriptionLong, orDash(node.StatusDescription)},
+		{LabelEligibility, node.Eligibility},
+		{LabelDrain, fmt.Sprint(node.Drain)},
+	})
+}
+
+// renderResources compares the resources of the client with the
+// part which is reserved and the part given to running allocations.
+func (n *NodeInfo) renderResources() string {
+	node := n.Props.Data
+	total, reserved := node.Total, node.Reserved
+
+	var cpu, memory int
+	for _, a := range node.Allocations {
+		if a.Status != models.StatusRunning && a.Status != models.StatusPending {
+			continue
+		}
+
+		for _, t := range a.TaskList {
+			if t.Allocated != nil {
+				cpu += int(t.Allocated.CPU)
+				memory += int(t.Allocated.MemoryMB)
+			}
+		}
+	}
+
+	return renderTable([]string{"", LabelTotal, LabelReserved, LabelAllocatable, LabelAllocated}, [][]string{
+		{LabelCPU, fmt.Sprintf("%d MHz", total.CPU), fmt.Sprintf("%d MHz", reserved.CPU), fmt.Sprintf("%d MHz", total.CPU-reserved.CPU), fmt.Sprintf("%d MHz", cpu)},
+		{LabelMemory, fmt.Sprintf("%d MB", total.MemoryMB), fmt.Sprintf("%d MB", reserved.MemoryMB), fmt.Sprintf("%d MB", total.MemoryMB-reserved.MemoryMB), fmt.Sprintf("%d MB", memory)},
+		{LabelDisk, fmt.Sprintf("%d MB", total.DiskMB), fmt.Sprintf("%d MB", reserved.DiskMB), fmt.Sprintf("%d MB", total.DiskMB-reserved.DiskMB), "-"},
+	})
+}
+
+func (n *NodeInfo) renderDrivers() string {
+	drivers := n.Props.Data.Drivers
+	if len(drivers) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, d := range drivers {
+		rows = append(rows, []string{
+			d.Name,
+			fmt.Sprint(d.Detected),
+			fmt.Sprint(d.Healthy),
+			orDash(d.HealthDescription),
+			formatTime(d.Updated),
+		})
+	}
+
+	return renderTable([]string{LabelDriver, LabelDetected, LabelHealthy, LabelDescription, LabelUpdated}, rows)
+}
+
+func (n *NodeInfo) renderHostVolumes() string {
+	volumes := n.Props.Data.HostVolumes
+	if len(volumes) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, v := range volumes {
+		rows = append(rows, []string{v.Name, v.Path, fmt.Sprint(v.ReadOnly)})
+	}
+
+	return renderTable([]string{LabelName, LabelPath, LabelReadOnly}, rows)
+}
+
+func (n *NodeInfo) renderAllocations() string {
+	allocs := n.Props.Data.Allocations
+	if len(allocs) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, a := range allocs {
+		rows = append(rows, []string{
+			shortID(a.ID),
+			a.JobID,
+			a.TaskGroup,
+			a.Namespace,
+			a.DesiredStatus,
+			a.Status,
+			formatTime(a.Created),
+		})
+	}
+
+	return renderTable([]string{LabelID, LabelJobID, LabelTaskGroup, LabelNamespace, LabelDesiredStatus, LabelStatus, LabelCreated}, rows)
+}
+
+// renderEvents renders the events of the client, the most recent first.
+func (n *NodeInfo) renderEvents() string {
+	events := n.Props.Data.Events
+	if len(events) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+		if e == nil {
+			continue
+		}
+
+		rows = append(rows, []string{formatTime(e.Timestamp), e.Subsystem, e.Message})
+	}
+
+	return renderTable([]string{LabelTime, LabelSubsystem, LabelMessage}, rows)
+}
+
+func renderMap(m map[string]string) string {
+	if len(m) == 0 {
+		return "  -\n"
+	}
+
+	rows := [][]string{}
+	for _, k := range sortedKeys(m) {
+		rows = append(rows, []string{k, m[k]})
+	}
+
+	return renderKeyValues(rows)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestNodeInfo(t *testing.T) {
+	now := time.Now()
+	node := &models.NodeDetail{
+		Node: models.Node{
+			ID:          "9f8e7d6c-5b4a",
+			Name:        "worker-1",
+			Address:     "10.0.0.1",
+			Datacenter:  "dc1",
+			NodePool:    "default",
+			Version:     "1.6.1",
+			Status:      "ready",
+			Eligibility: "eligible",
+		},
+		HTTPAddress: "10.0.0.1:4646",
+		Attributes:  map[string]string{"kernel.name": "linux", "cpu.arch": "amd64"},
+		Meta:        map[string]string{"rack": "r1"},
+		Drivers: []*models.NodeDriver{
+			{Name: "docker", Detected: true, Healthy: true, HealthDescription: "Healthy", Updated: now.Add(-time.Hour)},
+			{Name: "java"},
+		},
+		HostVolumes: []*models.NodeHostVolume{
+			{Name: "certs", Path: "/etc/certs", ReadOnly: true},
+		},
+		Events: []*api.NodeEvent{
+			{Message: "Node registered", Subsystem: "Cluster", Timestamp: now.Add(-2 * time.Hour)},
+			{Message: "Node heartbeat missed", Subsystem: "Cluster", Timestamp: now.Add(-time.Hour)},
+		},
+		Total:    models.NodeResources{CPU: 4000, MemoryMB: 8192, DiskMB: 50000},
+		Reserved: models.NodeResources{CPU: 500, MemoryMB: 512},
+		Allocations: []*models.Alloc{
+			{
+				ID: "a1b2c3d4-e5f6", JobID: "web", TaskGroup: "app", Namespace: "default", DesiredStatus: "run", Status: "running",
+				TaskList: []*models.Task{{Name: "app", Allocated: &models.TaskResources{CPU: 500, MemoryMB: 256}}},
+			},
+			{
+				ID: "b1b2c3d4-e5f6", JobID: "web", TaskGroup: "app", Namespace: "default", DesiredStatus: "stop", Status: "complete",
+				TaskList: []*models.Task{{Name: "app", Allocated: &models.TaskResources{CPU: 500, MemoryMB: 256}}},
+			},
+		},
+	}
+
+	t.Run("It renders the details of the client", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewNodeInfo()
+		info.TextView = textView
+		info.Props.Data = node
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+		text := textView.SetTextArgsForCall(0)
+
+		r.Regexp(`HTTP Address\s+= 10.0.0.1:4646`, text)
+		r.Regexp(`Node Pool\s+= default`, text)
+		r.Regexp(`Node Class\s+= -`, text)
+
+		// the resources, only the running allocation counts
+		r.Regexp(`CPU\s+4000 MHz\s+500 MHz\s+3500 MHz\s+500 MHz`, text)
+		r.Regexp(`Memory\s+8192 MB\s+512 MB\s+7680 MB\s+256 MB`, text)
+		r.Regexp(`Disk\s+50000 MB\s+0 MB\s+50000 MB\s+-`, text)
+
+		// the drivers and host volumes
+		r.Regexp(`docker\s+true\s+true\s+Healthy\s+\S+ \S+ \(1h ago\)`, text)
+		r.Regexp(`java\s+false\s+false\s+-\s+-`, text)
+		r.Regexp(`certs\s+/etc/certs\s+true`, text)
+
+		// the allocations
+		r.Contains(text, "Allocations (2)")
+		r.Regexp(`a1b2c3d4\s+web\s+app\s+default\s+run\s+running`, text)
+
+		// the events, the most recent first
+		r.Regexp(`(?s)Node heartbeat missed.*Node registered`, text)
+
+		// the meta and the attributes
+		r.Regexp(`rack\s+= r1`, text)
+		r.Regexp(`(?s)cpu.arch\s+= amd64.*kernel.name\s+= linux`, text)
+	})
+
+	t.Run("When the client doesn't exist", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewNodeInfo()
+		info.TextView = textView
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+		r.Equal("Client not available.", textView.SetTextArgsForCall(0))
+	})
+
+	t.Run("When the component isn't bound", func(t *testing.T) {
+		r := require.New(t)
+
+		info := component.NewNodeInfo()
+		r.ErrorIs(info.Render(), component.ErrComponentNotBound)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleNodePools = "Node Pools"
+
+	// NodePoolAll is the built-in pool every client belongs to.
+	NodePoolAll = "all"
+)
+
+var (
+	TableHeaderNodePools = []string{
+		LabelName,
+		LabelDescription,
+		LabelSchedulerAlgorithm,
+		LabelClients,
+		LabelReady,
+		LabelEligible,
+	}
+)
+
+type SelectNodePoolFunc func(name string)
+
+// NodePoolTable lists the node pools with the
+// number of clients which are ready and eligible.
+type NodePoolTable struct {
+	Table Table
+	Props *NodePoolTableProps
+
+	slot *tview.Flex
+}
+
+type NodePoolTableProps struct {
+	SelectPool        SelectNodePoolFunc
+	HandleNoResources models.HandlerFunc
+
+	Data  []*models.NodePool
+	Nodes []*models.Node
+}
+
+func NewNodePoolTable() *NodePoolTable {
+	return &NodePoolTable{
+		Table: primitive.NewTable(),
+		Props: &NodePoolTableProps{},
+	}
+}
+
+func (t *NodePoolTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *NodePoolTable) Render() error {
+	if t.Props.SelectPool == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno node pools found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.poolSelected)
+
+	t.Table.SetTitle(TableTitleNodePools)
+	t.Table.RenderHeader(TableHeaderNodePools)
+
+	for i, pool := range t.Props.Data {
+		clients, ready, eligible := 0, 0, 0
+		for _, node := range t.Props.Nodes {
+			if pool.Name != NodePoolAll && node.NodePool != pool.Name {
+				continue
+			}
+
+			clients++
+			if node.Status == models.NodeStatusReady {
+				ready++
+			}
+			if node.Eligibility == models.NodeEligible {
+				eligible++
+			}
+		}
+
+		row := []string{
+			pool.Name,
+			pool.Description,
+			orDash(pool.SchedulerAlgorithm),
+			fmt.Sprint(clients),
+			fmt.Sprint(ready),
+			fmt.Sprint(eligible),
+		}
+
+		c := tcell.ColorWhite
+		switch {
+		case clients == 0:
+			c = tcell.ColorGray
+		case ready < clients:
+			c = tcell.ColorYellow
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// GetNameForSelection returns the name of the selected pool.
+func (t *NodePoolTable) GetNameForSelection() string {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return ""
+	}
+
+	return t.Table.GetCellContent(row, 0)
+}
+
+func (t *NodePoolTable) poolSelected(row, column int) {
+	if name := t.GetNameForSelection(); name != "" {
+		t.Props.SelectPool(name)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestNodePoolTable(t *testing.T) {
+	t.Run("It renders the pools with the number of clients", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		pt := component.NewNodePoolTable()
+		pt.Table = fakeTable
+		pt.Props.Data = []*models.NodePool{
+			{Name: "all", Description: "Node pool with all nodes in the cluster."},
+			{Name: "default"},
+			{Name: "gpu", SchedulerAlgorithm: "spread"},
+			{Name: "empty"},
+		}
+		pt.Props.Nodes = []*models.Node{
+			{NodePool: "default", Status: "ready", Eligibility: "eligible"},
+			{NodePool: "default", Status: "ready", Eligibility: "ineligible"},
+			{NodePool: "gpu", Status: "down", Eligibility: "eligible"},
+		}
+		pt.Props.SelectPool = func(name string) {}
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		pt.Bind(tview.NewFlex())
+
+		r.NoError(pt.Render())
+
+		r.Equal(component.TableHeaderNodePools, fakeTable.RenderHeaderArgsForCall(0))
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"all", "Node pool with all nodes in the cluster.", "-", "3", "2", "2"}, row)
+		r.Equal(tcell.ColorYellow, c)
+
+		row, _, c = fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"default", "", "-", "2", "2", "1"}, row)
+		r.Equal(tcell.ColorWhite, c)
+
+		row, _, _ = fakeTable.RenderRowArgsForCall(2)
+		r.Equal([]string{"gpu", "", "spread", "1", "0", "1"}, row)
+
+		_, _, c = fakeTable.RenderRowArgsForCall(3)
+		r.Equal(tcell.ColorGray, c)
+	})
+
+	t.Run("It selects a pool", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		pt := component.NewNodePoolTable()
+		pt.Table = fakeTable
+		pt.Props.Data = []*models.NodePool{{Name: "default"}}
+
+		var selected string
+		pt.Props.SelectPool = func(name string) {
+			selected = name
+		}
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		pt.Bind(tview.NewFlex())
+
+		r.NoError(pt.Render())
+
+		fakeTable.GetSelectionReturns(1, 0)
+		fakeTable.GetCellContentReturns("default")
+		fakeTable.SetSelectedFuncArgsForCall(0)(1, 0)
+
+		r.Equal("default", selected)
+	})
+
+	t.Run("When there are no pools", func(t *testing.T) {
+		r := require.New(t)
+
+		pt := component.NewNodePoolTable()
+		pt.Props.SelectPool = func(name string) {}
+
+		var called bool
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		pt.Bind(tview.NewFlex())
+
+		r.NoError(pt.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		pt := component.NewNodePoolTable()
+		r.ErrorIs(pt.Render(), component.ErrComponentPropsNotSet)
+
+		pt.Props.SelectPool = func(name string) {}
+		pt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(pt.Render(), component.ErrComponentNotBound)
+	})
+}
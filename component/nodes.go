@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const TableTitleNodes = "Clients"
+
+var (
+	TableHeaderNodes = []string{
+		LabelID,
+		LabelName,
+		LabelAddress,
+		LabelDatacenter,
+		LabelNodePool,
+		LabelNodeClass,
+		LabelVersion,
+		LabelStatus,
+		LabelEligibility,
+		LabelDrain,
+	}
+)
+
+type SelectNodeFunc func(nodeID string)
+
+// NodeTable lists the clients of the cluster.
+type NodeTable struct {
+	Table Table
+	Props *NodeTableProps
+
+	slot *tview.Flex
+}
+
+type NodeTableProps struct {
+	SelectNode        SelectNodeFunc
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.Node
+}
+
+func NewNodeTable() *NodeTable {
+	return &NodeTable{
+		Table: primitive.NewTable(),
+		Props: &NodeTableProps{},
+	}
+}
+
+func (t *NodeTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *NodeTable) Render() error {
+	if t.Props.SelectNode == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno clients found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.nodeSelected)
+
+	t.Table.SetTitle(TableTitleNodes)
+	t.Table.RenderHeader(TableHeaderNodes)
+
+	for i, node := range t.Props.Data {
+		row := []string{
+			node.ID,
+			node.Name,
+			node.Address,
+			node.Datacenter,
+			node.NodePool,
+			node.NodeClass,
+			node.Version,
+			node.Status,
+			node.Eligibility,
+			fmt.Sprint(node.Drain),
+		}
+
+		t.Table.RenderRow(row, i+1, nodeColor(node))
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// GetIDForSelection returns the ID of the selected client.
+func (t *NodeTable) GetIDForSelection() string {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return ""
+	}
+
+	return t.Table.GetCellContent(row, 0)
+}
+
+func (t *NodeTable) nodeSelected(row, column int) {
+	if nodeID := t.GetIDForSelection(); nodeID != "" {
+		t.Props.SelectNode(nodeID)
+	}
+}
+
+func nodeColor(node *models.Node) tcell.Color {
+	switch {
+	case node.Status == models.NodeStatusDown || node.Status == models.NodeStatusDisconnected:
+		return tcell.ColorRed
+	case node.Status != models.NodeStatusReady || node.Drain || node.Eligibility == models.NodeIneligible:
+		return tcell.ColorYellow
+	}
+
+	return tcell.ColorWhite
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestNodeTable(t *testing.T) {
+	t.Run("It renders the nodes", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		nt := component.NewNodeTable()
+		nt.Table = fakeTable
+		nt.Props.Data = []*models.Node{
+			{ID: "1", Name: "client-1", Address: "10.0.0.1", Datacenter: "dc1", NodePool: "default", Version: "1.6.1", Status: "ready", Eligibility: "eligible"},
+			{ID: "2", Name: "client-2", Status: "ready", Eligibility: "ineligible", Drain: true},
+			{ID: "3", Name: "client-3", Status: "down", Eligibility: "eligible"},
+		}
+		nt.Props.SelectNode = func(nodeID string) {}
+		nt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		nt.Bind(tview.NewFlex())
+
+		r.NoError(nt.Render())
+
+		r.Equal(component.TableHeaderNodes, fakeTable.RenderHeaderArgsForCall(0))
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"1", "client-1", "10.0.0.1", "dc1", "default", "", "1.6.1", "ready", "eligible", "false"}, row)
+		r.Equal(tcell.ColorWhite, c)
+
+		_, _, c = fakeTable.RenderRowArgsForCall(1)
+		r.Equal(tcell.ColorYellow, c)
+
+		_, _, c = fakeTable.RenderRowArgsForCall(2)
+		r.Equal(tcell.ColorRed, c)
+	})
+
+	t.Run("It selects a node", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		nt := component.NewNodeTable()
+		nt.Table = fakeTable
+		nt.Props.Data = []*models.Node{{ID: "1", Name: "client-1"}}
+
+		var selected string
+		nt.Props.SelectNode = func(nodeID string) {
+			selected = nodeID
+		}
+		nt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		nt.Bind(tview.NewFlex())
+
+		r.NoError(nt.Render())
+
+		fakeTable.GetSelectionReturns(1, 0)
+		fakeTable.GetCellContentReturns("1")
+		fakeTable.SetSelectedFuncArgsForCall(0)(1, 0)
+
+		r.Equal("1", selected)
+	})
+
+	t.Run("When there are no nodes", func(t *testing.T) {
+		r := require.New(t)
+
+		nt := component.NewNodeTable()
+		nt.Props.SelectNode = func(nodeID string) {}
+
+		var called bool
+		nt.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		nt.Bind(tview.NewFlex())
+
+		r.NoError(nt.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		nt := component.NewNodeTable()
+		r.ErrorIs(nt.Render(), component.ErrComponentPropsNotSet)
+
+		nt.Props.SelectNode = func(nodeID string) {}
+		nt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(nt.Render(), component.ErrComponentNotBound)
+	})
+}
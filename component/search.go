@@ -42,12 +42,32 @@ func (s *SearchField) Render() error {
 	}
 
 	s.InputField.SetDoneFunc(s.Props.DoneFunc)
-	s.InputField.SetChangedFunc(s.Props.ChangedFunc)
+	s.InputField.SetChangedFunc(s.changed)
 	s.slot.AddItem(s.InputField.Primitive(), 0, 2, false)
 
 	return nil
 }
 
+// changed calls the current ChangedFunc, such that views
+// can replace it while the search field is rendered.
+func (s *SearchField) changed(text string) {
+	if s.Props.ChangedFunc != nil {
+		s.Props.ChangedFunc(text)
+	}
+}
+
+// SetError shows the error inline in the search field,
+// e.g. when the search query is invalid. A nil error
+// removes it.
+func (s *SearchField) SetError(err error) {
+	if err == nil {
+		s.InputField.SetError("")
+		return
+	}
+
+	s.InputField.SetError(fmt.Sprintf("invalid filter: %s", err))
+}
+
 func (s *SearchField) Bind(slot *tview.Flex) {
 	s.slot = slot
 }
@@ -46,6 +46,20 @@ func TestSearch_Happy(t *testing.T) {
 	r.True(doneCalled)
 }
 
+func TestSearch_SetError(t *testing.T) {
+	r := require.New(t)
+
+	input := &componentfakes.FakeInputField{}
+	search := component.NewSearchField("test")
+	search.InputField = input
+
+	search.SetError(errors.New(`unknown field "foo"`))
+	r.Equal(`invalid filter: unknown field "foo"`, input.SetErrorArgsForCall(0))
+
+	search.SetError(nil)
+	r.Equal("", input.SetErrorArgsForCall(1))
+}
+
 func TestSearch_Sad(t *testing.T) {
 	r := require.New(t)
 
@@ -58,6 +58,8 @@ func (s *SelectorModal) Render() error {
 		table.RenderRow([]string{v}, i, tcell.ColorWhite)
 	}
 
+	table.Commit()
+
 	s.Modal.GetTable().SetTitle("Select a Task (alloc: %s)", s.Props.AllocationID)
 
 	s.pages.AddPage(pageNameSelector, s.Modal.Container(), true, true)
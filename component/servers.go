@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleServers = "Servers"
+
+	// ServerIndexLagWarning is the number of Raft entries a
+	// server may lag behind the leader before it is marked.
+	ServerIndexLagWarning = 100
+)
+
+var (
+	TableHeaderServers = []string{
+		LabelName,
+		LabelAddress,
+		LabelPort,
+		LabelStatus,
+		LabelVersion,
+		LabelRegion,
+		LabelDatacenter,
+		LabelLeader,
+		LabelVoter,
+		LabelHealthy,
+		LabelLastContact,
+		LabelLastIndex,
+		LabelIndexLag,
+	}
+)
+
+// ServerTable lists the servers of the cluster. It joins the server
+// members with the Raft peers and the autopilot health, such that
+// the quorum can be watched while the servers are upgraded. Raft
+// peers which aren't members anymore are listed as well.
+type ServerTable struct {
+	Table Table
+	Props *ServerTableProps
+
+	slot *tview.Flex
+}
+
+type ServerTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data *models.Cluster
+}
+
+// serverRow is a server as it is known
+// to serf, to Raft and to autopilot.
+type serverRow struct {
+	name   string
+	member *models.ServerMember
+	peer   *models.RaftPeer
+	health *models.ServerHealth
+}
+
+func NewServerTable() *ServerTable {
+	return &ServerTable{
+		Table: primitive.NewTable(),
+		Props: &ServerTableProps{},
+	}
+}
+
+func (t *ServerTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ServerTable) Render() error {
+	if t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	rows := serverRows(t.Props.Data)
+	if len(rows) == 0 {
+		t.Props.HandleNoResources(
+			"%sno servers found\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle(serverTitle(t.Props.Data))
+	t.Table.RenderHeader(TableHeaderServers)
+
+	var leaderIndex uint64
+	for _, s := range rows {
+		if s.health != nil && s.health.Leader {
+			leaderIndex = s.health.LastIndex
+		}
+	}
+
+	for i, s := range rows {
+		row := []string{
+			s.name,
+			"-",
+			"-",
+			"-",
+			"-",
+			"-",
+			"-",
+			fmt.Sprint(s.leader()),
+			"-",
+			"-",
+			"-",
+			"-",
+			"-",
+		}
+
+		if m := s.member; m != nil {
+			row[1] = m.Address
+			row[2] = fmt.Sprint(m.Port)
+			row[3] = m.Status
+			row[4] = m.Version
+			row[5] = m.Region
+			row[6] = m.Datacenter
+		} else if s.peer != nil {
+			row[1] = s.peer.Address
+		}
+
+		if s.peer != nil {
+			row[8] = fmt.Sprint(s.peer.Voter)
+		} else if s.health != nil {
+			row[8] = fmt.Sprint(s.health.Voter)
+		}
+
+		lag := uint64(0)
+		if h := s.health; h != nil {
+			row[9] = fmt.Sprint(h.Healthy)
+			row[10] = lastContact(h)
+			row[11] = fmt.Sprint(h.LastIndex)
+			if leaderIndex > h.LastIndex {
+				lag = leaderIndex - h.LastIndex
+			}
+			row[12] = fmt.Sprint(lag)
+		}
+
+		t.Table.RenderRow(row, i+1, serverColor(s, lag))
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// serverRows joins the members, Raft peers and autopilot health by
+// the name of the server. The members come first, in their order,
+// followed by the peers and servers only known to Raft or autopilot.
+func serverRows(cluster *models.Cluster) []*serverRow {
+	if cluster == nil {
+		return nil
+	}
+
+	rows := []*serverRow{}
+	byName := map[string]*serverRow{}
+	row := func(name string) *serverRow {
+		if r, ok := byName[name]; ok {
+			return r
+		}
+
+		r := &serverRow{name: name}
+		byName[name] = r
+		rows = append(rows, r)
+		return r
+	}
+
+	for _, m := range cluster.Servers {
+		row(m.Name).member = m
+	}
+
+	for _, p := range cluster.RaftPeers {
+		row(p.Node).peer = p
+	}
+
+	if cluster.Autopilot != nil {
+		for _, h := range cluster.Autopilot.Servers {
+			row(h.Name).health = h
+		}
+	}
+
+	return rows
+}
+
+func serverTitle(cluster *models.Cluster) string {
+	region := "-"
+	if cluster.Region != "" {
+		region = cluster.Region
+	}
+
+	autopilot := "unavailable"
+	if a := cluster.Autopilot; a != nil {
+		autopilot = "unhealthy"
+		if a.Healthy {
+			autopilot = "healthy"
+		}
+
+		autopilot = fmt.Sprintf("%s, Failure Tolerance: %d", autopilot, a.FailureTolerance)
+	}
+
+	return fmt.Sprintf("%s (Region: %s, Autopilot: %s)", TableTitleServers, region, autopilot)
+}
+
+func (s *serverRow) leader() bool {
+	return (s.member != nil && s.member.Leader) ||
+		(s.peer != nil && s.peer.Leader) ||
+		(s.health != nil && s.health.Leader)
+}
+
+func lastContact(h *models.ServerHealth) string {
+	if h.Leader {
+		return "-"
+	}
+
+	return h.LastContact.Round(time.Millisecond).String()
+}
+
+func serverColor(s *serverRow, lag uint64) tcell.Color {
+	switch {
+	case s.member == nil,
+		s.member.Status == models.MemberStatusFailed,
+		s.member.Status == models.MemberStatusLeft,
+		s.health != nil && !s.health.Healthy:
+		return tcell.ColorRed
+	case lag > ServerIndexLagWarning:
+		return tcell.ColorYellow
+	case s.leader():
+		return styles.TcellColorHighlighPrimary
+	}
+
+	return tcell.ColorWhite
+}
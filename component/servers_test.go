@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestServerTable(t *testing.T) {
+	t.Run("It joins the members, the Raft peers and the autopilot health", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		st := component.NewServerTable()
+		st.Table = fakeTable
+		st.Props.Data = &models.Cluster{
+			Region: "global",
+			Servers: []*models.ServerMember{
+				{Name: "server-1", Address: "10.0.0.1", Port: 4648, Status: "alive", Version: "1.6.1", Region: "global", Datacenter: "dc1", Leader: true},
+				{Name: "server-2", Address: "10.0.0.2", Port: 4648, Status: "alive", Version: "1.6.0", Region: "global", Datacenter: "dc1"},
+				{Name: "server-3", Address: "10.0.0.3", Port: 4648, Status: "failed", Version: "1.6.0", Region: "global", Datacenter: "dc2"},
+			},
+			RaftPeers: []*models.RaftPeer{
+				{Node: "server-1", Address: "10.0.0.1:4647", Leader: true, Voter: true},
+				{Node: "server-2", Address: "10.0.0.2:4647", Voter: true},
+				{Node: "server-3", Address: "10.0.0.3:4647", Voter: true},
+				{Node: "server-4", Address: "10.0.0.4:4647"},
+			},
+			Autopilot: &models.AutopilotHealth{
+				Healthy:          false,
+				FailureTolerance: 0,
+				Servers: []*models.ServerHealth{
+					{Name: "server-1", Leader: true, Voter: true, Healthy: true, LastIndex: 1000},
+					{Name: "server-2", Voter: true, Healthy: true, LastContact: 25 * time.Millisecond, LastIndex: 800},
+					{Name: "server-3", Voter: true, LastContact: 10 * time.Second, LastIndex: 990},
+				},
+			},
+		}
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		st.Bind(tview.NewFlex())
+
+		r.NoError(st.Render())
+
+		title, _ := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Servers (Region: global, Autopilot: unhealthy, Failure Tolerance: 0)", title)
+		r.Equal(component.TableHeaderServers, fakeTable.RenderHeaderArgsForCall(0))
+		r.Equal(4, fakeTable.RenderRowCallCount())
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"server-1", "10.0.0.1", "4648", "alive", "1.6.1", "global", "dc1", "true", "true", "true", "-", "1000", "0"}, row)
+		r.Equal(styles.TcellColorHighlighPrimary, c)
+
+		row, _, c = fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"server-2", "10.0.0.2", "4648", "alive", "1.6.0", "global", "dc1", "false", "true", "true", "25ms", "800", "200"}, row)
+		r.Equal(tcell.ColorYellow, c)
+
+		row, _, c = fakeTable.RenderRowArgsForCall(2)
+		r.Equal([]string{"server-3", "10.0.0.3", "4648", "failed", "1.6.0", "global", "dc2", "false", "true", "false", "10s", "990", "10"}, row)
+		r.Equal(tcell.ColorRed, c)
+
+		row, _, c = fakeTable.RenderRowArgsForCall(3)
+		r.Equal([]string{"server-4", "10.0.0.4:4647", "-", "-", "-", "-", "-", "false", "false", "-", "-", "-", "-"}, row)
+		r.Equal(tcell.ColorRed, c)
+	})
+
+	t.Run("It renders the servers when the autopilot health is unavailable", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		st := component.NewServerTable()
+		st.Table = fakeTable
+		st.Props.Data = &models.Cluster{
+			Servers: []*models.ServerMember{
+				{Name: "server-1", Status: "alive", Leader: true},
+			},
+		}
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		st.Bind(tview.NewFlex())
+
+		r.NoError(st.Render())
+
+		title, _ := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Servers (Region: -, Autopilot: unavailable)", title)
+
+		row, _, _ := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"server-1", "", "0", "alive", "", "", "", "true", "-", "-", "-", "-", "-"}, row)
+	})
+
+	t.Run("When there are no servers", func(t *testing.T) {
+		r := require.New(t)
+
+		st := component.NewServerTable()
+
+		var called bool
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		st.Bind(tview.NewFlex())
+
+		r.NoError(st.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		st := component.NewServerTable()
+		r.ErrorIs(st.Render(), component.ErrComponentPropsNotSet)
+
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(st.Render(), component.ErrComponentNotBound)
+	})
+}
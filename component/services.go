@@ -0,0 +1,415 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleServices         = "Services"
+	TableTitleServiceInstances = "Instances"
+	TableTitleServiceChecks    = "Checks"
+)
+
+var (
+	TableHeaderServices = []string{
+		LabelName,
+		LabelNamespace,
+		LabelTags,
+	}
+
+	TableHeaderServiceInstances = []string{
+		LabelAllocID,
+		LabelAddress,
+		LabelPort,
+		LabelNode,
+		LabelDatacenter,
+		LabelJobID,
+		LabelTags,
+		LabelChecks,
+	}
+
+	TableHeaderServiceChecks = []string{
+		LabelName,
+		LabelTask,
+		LabelMode,
+		LabelStatus,
+		LabelStatusCode,
+		LabelTime,
+		LabelOutput,
+	}
+)
+
+type SelectServiceFunc func(namespace, name string)
+
+// ServiceTable lists the services registered
+// with the Nomad service provider.
+type ServiceTable struct {
+	Table Table
+	Props *ServiceTableProps
+
+	slot *tview.Flex
+}
+
+type ServiceTableProps struct {
+	SelectService     SelectServiceFunc
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.Service
+}
+
+func NewServiceTable() *ServiceTable {
+	return &ServiceTable{
+		Table: primitive.NewTable(),
+		Props: &ServiceTableProps{},
+	}
+}
+
+func (t *ServiceTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ServiceTable) Render() error {
+	if t.Props.SelectService == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno services registered with Nomad\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.serviceSelected)
+
+	t.Table.SetTitle(TableTitleServices)
+	t.Table.RenderHeader(TableHeaderServices)
+	t.Table.SetKeyColumns(0, 1)
+
+	for i, s := range t.Props.Data {
+		row := []string{
+			s.Name,
+			s.Namespace,
+			strings.Join(s.Tags, ","),
+		}
+
+		t.Table.RenderRow(row, i+1, tcell.ColorWhite)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *ServiceTable) serviceSelected(row, column int) {
+	if row < 1 {
+		return
+	}
+
+	name := t.Table.GetCellContent(row, 0)
+	namespace := t.Table.GetCellContent(row, 1)
+	t.Props.SelectService(namespace, name)
+}
+
+type SelectServiceInstanceFunc func(instance *models.ServiceInstance)
+
+// ServiceInstanceTable lists the instances of a service
+// with the status of their health checks.
+type ServiceInstanceTable struct {
+	Table Table
+	Props *ServiceInstanceTableProps
+
+	slot *tview.Flex
+}
+
+type ServiceInstanceTableProps struct {
+	SelectInstance    SelectServiceInstanceFunc
+	HighlightInstance SelectServiceInstanceFunc
+	HandleNoResources models.HandlerFunc
+
+	Namespace   string
+	ServiceName string
+
+	// NodeNames maps node IDs to node names.
+	// Nodes without a name are shown by ID.
+	NodeNames map[string]string
+
+	// Data is nil while the instances are read.
+	Data []*models.ServiceInstance
+}
+
+func NewServiceInstanceTable() *ServiceInstanceTable {
+	return &ServiceInstanceTable{
+		Table: primitive.NewTable(),
+		Props: &ServiceInstanceTableProps{},
+	}
+}
+
+func (t *ServiceInstanceTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ServiceInstanceTable) Render() error {
+	if t.Props.SelectInstance == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if t.Props.Data == nil {
+		t.Props.HandleNoResources(
+			"%sreading the instances of %s...",
+			styles.HighlightPrimaryTag,
+			t.Props.ServiceName,
+		)
+
+		return nil
+	}
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno instances of %s\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			t.Props.ServiceName,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.instanceSelected)
+	t.Table.SetSelectionChangedFunc(t.instanceHighlighted)
+
+	t.Table.SetTitle("%s (%s:%s)", TableTitleServiceInstances, t.Props.Namespace, t.Props.ServiceName)
+	t.Table.RenderHeader(TableHeaderServiceInstances)
+	t.Table.SetKeyColumns(0, 1, 2)
+	t.renderRows()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *ServiceInstanceTable) renderRows() {
+	for i, s := range t.Props.Data {
+		node := s.NodeID
+		if name, ok := t.Props.NodeNames[s.NodeID]; ok && name != "" {
+			node = name
+		}
+
+		health, c := ServiceHealth(s)
+		row := []string{
+			s.AllocID,
+			s.Address,
+			fmt.Sprint(s.Port),
+			node,
+			s.Datacenter,
+			s.JobID,
+			strings.Join(s.Tags, ","),
+			health,
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+}
+
+func (t *ServiceInstanceTable) instanceSelected(row, column int) {
+	if instance := t.GetInstanceForSelection(); instance != nil {
+		t.Props.SelectInstance(instance)
+	}
+}
+
+func (t *ServiceInstanceTable) instanceHighlighted(row, column int) {
+	if t.Props.HighlightInstance == nil {
+		return
+	}
+
+	if instance := t.GetInstanceForSelection(); instance != nil {
+		t.Props.HighlightInstance(instance)
+	}
+}
+
+// GetInstanceForSelection returns the selected instance or nil.
+// The instance is looked up by its allocation and port, as the
+// rows may be sorted.
+func (t *ServiceInstanceTable) GetInstanceForSelection() *models.ServiceInstance {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return nil
+	}
+
+	allocID := t.Table.GetCellContent(row, 0)
+	port := t.Table.GetCellContent(row, 2)
+	for _, s := range t.Props.Data {
+		if s.AllocID == allocID && fmt.Sprint(s.Port) == port {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// ServiceHealth summarises the checks of a service instance,
+// e.g. "failing (1/2)", and returns the color to show it in.
+func ServiceHealth(s *models.ServiceInstance) (string, tcell.Color) {
+	if s.CheckError != "" {
+		return "unknown", tcell.ColorDarkGray
+	}
+
+	if len(s.Checks) == 0 {
+		return "-", tcell.ColorWhite
+	}
+
+	var passing, failing, pending int
+	for _, c := range s.Checks {
+		switch c.Status {
+		case models.CheckStatusSuccess:
+			passing++
+		case models.CheckStatusFailure:
+			failing++
+		default:
+			pending++
+		}
+	}
+
+	total := len(s.Checks)
+	switch {
+	case failing > 0:
+		return fmt.Sprintf("failing (%d/%d)", failing, total), styles.TcellColorAttention
+	case pending > 0:
+		return fmt.Sprintf("pending (%d/%d)", pending, total), tcell.GetColor(styles.ColorWarningHex)
+	}
+
+	return fmt.Sprintf("passing (%d/%d)", passing, total), tcell.ColorWhite
+}
+
+// ServiceCheckTable shows the latest results of the
+// health checks of a service instance.
+type ServiceCheckTable struct {
+	Table Table
+	Props *ServiceCheckTableProps
+
+	slot *tview.Flex
+}
+
+type ServiceCheckTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data *models.ServiceInstance
+}
+
+func NewServiceCheckTable() *ServiceCheckTable {
+	return &ServiceCheckTable{
+		Table: primitive.NewTable(),
+		Props: &ServiceCheckTableProps{},
+	}
+}
+
+func (t *ServiceCheckTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *ServiceCheckTable) Render() error {
+	if t.Props.Data == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	s := t.Props.Data
+	switch {
+	case s.CheckError != "":
+		t.Props.HandleNoResources(
+			"%sfailed to read the checks of %s:\n%s%s",
+			styles.HighlightPrimaryTag,
+			s.AllocID,
+			styles.HighlightSecondaryTag,
+			s.CheckError,
+		)
+
+		return nil
+
+	case len(s.Checks) == 0:
+		t.Props.HandleNoResources(
+			"%sno checks for %s\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			s.ServiceName,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle("%s (%s:%d)", TableTitleServiceChecks, s.Address, s.Port)
+	t.Table.RenderHeader(TableHeaderServiceChecks)
+	t.Table.SetKeyColumns(0, 1)
+
+	for i, c := range s.Checks {
+		code := "-"
+		if c.StatusCode != 0 {
+			code = fmt.Sprint(c.StatusCode)
+		}
+
+		row := []string{
+			c.Name,
+			c.Task,
+			c.Mode,
+			c.Status,
+			code,
+			c.Time.Format(time.RFC3339),
+			strings.Join(strings.Fields(c.Output), " "),
+		}
+
+		t.Table.RenderRow(row, i+1, t.checkColor(c.Status))
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *ServiceCheckTable) checkColor(status string) tcell.Color {
+	switch status {
+	case models.CheckStatusFailure:
+		return styles.TcellColorAttention
+	case models.CheckStatusPending:
+		return tcell.GetColor(styles.ColorWarningHex)
+	}
+
+	return tcell.ColorWhite
+}
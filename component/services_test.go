@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestServiceTable(t *testing.T) {
+	t.Run("It renders the services", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		st := component.NewServiceTable()
+		st.Table = fakeTable
+		st.Props.Data = []*models.Service{
+			{Name: "web", Namespace: "default", Tags: []string{"http", "public"}},
+		}
+
+		var namespace, name string
+		st.Props.SelectService = func(ns, n string) {
+			namespace, name = ns, n
+		}
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		st.Bind(tview.NewFlex())
+
+		r.NoError(st.Render())
+
+		r.Equal(component.TableHeaderServices, fakeTable.RenderHeaderArgsForCall(0))
+		row, index, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"web", "default", "http,public"}, row)
+		r.Equal(1, index)
+		r.Equal(tcell.ColorWhite, c)
+
+		fakeTable.GetCellContentStub = func(row, column int) string {
+			return []string{"web", "default"}[column]
+		}
+		selectFn := fakeTable.SetSelectedFuncArgsForCall(0)
+		selectFn(1, 0)
+		r.Equal("default", namespace)
+		r.Equal("web", name)
+	})
+
+	t.Run("When there are no services", func(t *testing.T) {
+		r := require.New(t)
+
+		st := component.NewServiceTable()
+		st.Table = &componentfakes.FakeTable{}
+		st.Props.SelectService = func(ns, n string) {}
+
+		var called bool
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		st.Bind(tview.NewFlex())
+
+		r.NoError(st.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		st := component.NewServiceTable()
+		r.ErrorIs(st.Render(), component.ErrComponentPropsNotSet)
+
+		st.Props.SelectService = func(ns, n string) {}
+		st.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(st.Render(), component.ErrComponentNotBound)
+	})
+}
+
+func TestServiceInstanceTable(t *testing.T) {
+	instances := []*models.ServiceInstance{
+		{
+			ServiceName: "web",
+			AllocID:     "alloc-1",
+			NodeID:      "node-1",
+			Datacenter:  "dc1",
+			JobID:       "shop",
+			Address:     "10.0.0.1",
+			Port:        8080,
+			Tags:        []string{"http"},
+			Checks: []*models.ServiceCheck{
+				{Name: "alive", Status: models.CheckStatusSuccess},
+				{Name: "ready", Status: models.CheckStatusFailure},
+			},
+		},
+		{
+			ServiceName: "web",
+			AllocID:     "alloc-2",
+			NodeID:      "node-2",
+			Address:     "10.0.0.2",
+			Port:        8080,
+			CheckError:  "no route to host",
+		},
+	}
+
+	t.Run("It renders the instances with their health", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		it := component.NewServiceInstanceTable()
+		it.Table = fakeTable
+		it.Props.Namespace = "default"
+		it.Props.ServiceName = "web"
+		it.Props.NodeNames = map[string]string{"node-1": "moon"}
+		it.Props.Data = instances
+
+		var selected, highlighted *models.ServiceInstance
+		it.Props.SelectInstance = func(instance *models.ServiceInstance) {
+			selected = instance
+		}
+		it.Props.HighlightInstance = func(instance *models.ServiceInstance) {
+			highlighted = instance
+		}
+		it.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		it.Bind(tview.NewFlex())
+
+		r.NoError(it.Render())
+
+		format, args := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Instances (default:web)", fmt.Sprintf(format, args...))
+		r.Equal(component.TableHeaderServiceInstances, fakeTable.RenderHeaderArgsForCall(0))
+
+		// It identifies the rows by the alloc ID, address and port
+		r.Equal([]int{0, 1, 2}, fakeTable.SetKeyColumnsArgsForCall(0))
+
+		row1, _, c1 := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"alloc-1", "10.0.0.1", "8080", "moon", "dc1", "shop", "http", "failing (1/2)"}, row1)
+		r.Equal(styles.TcellColorAttention, c1)
+
+		row2, _, c2 := fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"alloc-2", "10.0.0.2", "8080", "node-2", "", "", "", "unknown"}, row2)
+		r.Equal(tcell.ColorDarkGray, c2)
+
+		// It selects and highlights the instance of the row
+		fakeTable.GetSelectionReturns(2, 0)
+		fakeTable.GetCellContentStub = func(row, column int) string {
+			return row2[column]
+		}
+
+		fakeTable.SetSelectedFuncArgsForCall(0)(2, 0)
+		r.Equal(instances[1], selected)
+
+		fakeTable.SetSelectionChangedFuncArgsForCall(0)(2, 0)
+		r.Equal(instances[1], highlighted)
+	})
+
+	t.Run("While the instances are read", func(t *testing.T) {
+		r := require.New(t)
+
+		it := component.NewServiceInstanceTable()
+		it.Table = &componentfakes.FakeTable{}
+		it.Props.ServiceName = "web"
+		it.Props.SelectInstance = func(instance *models.ServiceInstance) {}
+
+		var text string
+		it.Props.HandleNoResources = func(format string, args ...interface{}) {
+			text = fmt.Sprintf(format, args...)
+		}
+		it.Bind(tview.NewFlex())
+
+		r.NoError(it.Render())
+		r.Contains(text, "reading the instances of web")
+
+		it.Props.Data = []*models.ServiceInstance{}
+		r.NoError(it.Render())
+		r.Contains(text, "no instances of web")
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		it := component.NewServiceInstanceTable()
+		r.ErrorIs(it.Render(), component.ErrComponentPropsNotSet)
+
+		it.Props.SelectInstance = func(instance *models.ServiceInstance) {}
+		it.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(it.Render(), component.ErrComponentNotBound)
+	})
+}
+
+func TestServiceHealth(t *testing.T) {
+	r := require.New(t)
+
+	check := func(status string) *models.ServiceCheck {
+		return &models.ServiceCheck{Status: status}
+	}
+
+	health, c := component.ServiceHealth(&models.ServiceInstance{})
+	r.Equal("-", health)
+	r.Equal(tcell.ColorWhite, c)
+
+	health, _ = component.ServiceHealth(&models.ServiceInstance{
+		Checks: []*models.ServiceCheck{check(models.CheckStatusSuccess), check(models.CheckStatusSuccess)},
+	})
+	r.Equal("passing (2/2)", health)
+
+	health, c = component.ServiceHealth(&models.ServiceInstance{
+		Checks: []*models.ServiceCheck{check(models.CheckStatusSuccess), check(models.CheckStatusPending)},
+	})
+	r.Equal("pending (1/2)", health)
+	r.Equal(tcell.GetColor(styles.ColorWarningHex), c)
+}
+
+func TestServiceCheckTable(t *testing.T) {
+	t.Run("It renders the checks", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		ct := component.NewServiceCheckTable()
+		ct.Table = fakeTable
+		ct.Props.Data = &models.ServiceInstance{
+			ServiceName: "web",
+			Address:     "10.0.0.1",
+			Port:        8080,
+			Checks: []*models.ServiceCheck{
+				{
+					Name:       "alive",
+					Task:       "server",
+					Mode:       "healthiness",
+					Status:     models.CheckStatusFailure,
+					StatusCode: 503,
+					Output:     "service\n  unavailable",
+					Time:       time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+				},
+			},
+		}
+		ct.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		ct.Bind(tview.NewFlex())
+
+		r.NoError(ct.Render())
+
+		format, args := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Checks (10.0.0.1:8080)", fmt.Sprintf(format, args...))
+
+		row, _, c := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"alive", "server", "healthiness", "failure", "503", "2023-01-02T15:04:05Z", "service unavailable"}, row)
+		r.Equal(styles.TcellColorAttention, c)
+	})
+
+	t.Run("When the checks couldn't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		ct := component.NewServiceCheckTable()
+		ct.Table = &componentfakes.FakeTable{}
+		ct.Props.Data = &models.ServiceInstance{AllocID: "alloc-1", CheckError: "no route to host"}
+
+		var text string
+		ct.Props.HandleNoResources = func(format string, args ...interface{}) {
+			text = fmt.Sprintf(format, args...)
+		}
+		ct.Bind(tview.NewFlex())
+
+		r.NoError(ct.Render())
+		r.Contains(text, "no route to host")
+	})
+}
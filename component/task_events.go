@@ -78,6 +78,7 @@ func (t *TaskEventsTable) Render() error {
 	t.Table.SetTitle(fmt.Sprintf("%s (%s)", TableTitleTaskEvents, t.Props.AllocID))
 
 	t.Table.RenderHeader(TableHeaderTaskEvents)
+	t.Table.SetKeyColumns(0, 1, 2)
 	t.renderRows()
 
 	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
@@ -96,6 +97,8 @@ func (t *TaskEventsTable) renderRows() {
 
 		t.Table.RenderRow(row, index, tcell.ColorWhite)
 	}
+
+	t.Table.Commit()
 }
 
 func (t *TaskEventsTable) GetIDForSelection() string {
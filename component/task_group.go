@@ -109,6 +109,13 @@ func (t *TaskGroupTable) renderRows() {
 
 		t.Table.RenderRow(row, index, tcell.ColorWhite)
 	}
+
+	t.Table.Commit()
+}
+
+func (t *TaskGroupTable) GetNameForSelection() string {
+	row, _ := t.Table.GetSelection()
+	return t.Table.GetCellContent(row, 0)
 }
 
 func (t *TaskGroupTable) taskGroupSelected(row, column int) {
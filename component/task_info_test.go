@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestTaskInfo(t *testing.T) {
+	str := func(s string) *string { return &s }
+	attempts, interval, readOnly := 2, 30*time.Minute, true
+
+	task := &models.Task{
+		Name:     "web",
+		Driver:   "docker",
+		State:    "running",
+		CPU:      500,
+		MemoryMB: 256,
+		Config: map[string]interface{}{
+			"image": "nginx:1.23",
+			"auth": []interface{}{
+				map[string]interface{}{"username": "deploy", "password": "hunter2"},
+			},
+			"args": []interface{}{"--port", "8080"},
+		},
+		Env: map[string]string{
+			"PORT":         "8080",
+			"DB_PASSWORD":  "hunter2",
+			"DATABASE_URL": "postgres://app:hunter2@db:5432/app",
+			"VAULT_ADDR":   "https://vault:8200",
+			"UPSTREAM":     "hvs.CAESIJlU0FmZ2hpamtsbW5vcHFyc3R1dnd4eXo",
+		},
+		Lifecycle: &api.TaskLifecycle{Hook: "prestart", Sidecar: true},
+		RestartPolicy: &api.RestartPolicy{
+			Attempts: &attempts,
+			Interval: &interval,
+			Mode:     str("fail"),
+		},
+		Artifacts: []*api.TaskArtifact{
+			{
+				GetterSource:  str("https://releases.example.com/app.tar.gz"),
+				RelativeDest:  str("local/app"),
+				GetterMode:    str("any"),
+				GetterOptions: map[string]string{"checksum": "sha256:abc", "token": "s3cr3t"},
+			},
+		},
+		Templates: []*api.Template{
+			{EmbeddedTmpl: str("PORT={{ env \"NOMAD_PORT_http\" }}\nHOST=web\n"), DestPath: str("local/app.env"), ChangeMode: str("signal"), ChangeSignal: str("SIGHUP")},
+			{SourcePath: str("local/nginx.conf.tpl"), DestPath: str("local/nginx.conf"), ChangeMode: str("restart")},
+		},
+		VolumeMounts: []*api.VolumeMount{
+			{Volume: str("data"), Destination: str("/srv/data"), ReadOnly: &readOnly},
+		},
+		Allocated: &models.TaskResources{CPU: 500, MemoryMB: 256},
+	}
+
+	render := func(reveal bool) (string, *componentfakes.FakeTextView) {
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewTaskInfo()
+		info.TextView = textView
+		info.Props.AllocID = "a1b2c3d4-e5f6"
+		info.Props.Data = task
+		info.Props.Reveal = reveal
+		info.Bind(tview.NewFlex())
+
+		require.NoError(t, info.Render())
+		return textView.SetTextArgsForCall(0), textView
+	}
+
+	t.Run("It renders the details of the task", func(t *testing.T) {
+		r := require.New(t)
+
+		text, _ := render(false)
+
+		r.Contains(text, "nginx:1.23")
+		r.Contains(text, "prestart (sidecar)")
+		r.Regexp(`CPU\s+500 MHz\s+500 MHz`, text)
+		r.Regexp(`Memory\s+256 MB\s+256 MB`, text)
+		r.Regexp(`Attempts\s+= 2`, text)
+		r.Regexp(`Interval\s+= 30m0s`, text)
+		r.Regexp(`Mode\s+= fail`, text)
+		r.Regexp(`PORT\s+= 8080`, text)
+		r.Contains(text, `"--port"`)
+		r.Regexp(`https://releases.example.com/app.tar.gz\s+local/app\s+any\s+checksum=sha256:abc token=\*{8}`, text)
+		r.Regexp(`\(inline, 2 lines\)\s+local/app.env\s+signal \(SIGHUP\)\s+false`, text)
+		r.Regexp(`local/nginx.conf.tpl\s+local/nginx.conf\s+restart`, text)
+		r.Regexp(`data\s+/srv/data\s+true`, text)
+	})
+
+	t.Run("It masks values which look like secrets", func(t *testing.T) {
+		r := require.New(t)
+
+		text, _ := render(false)
+
+		r.NotContains(text, "hunter2")
+		r.NotContains(text, "s3cr3t")
+		r.NotContains(text, "hvs.")
+		r.Regexp(`DB_PASSWORD\s+= \*{8}`, text)
+		r.Contains(text, "postgres://app:********@db:5432/app")
+		r.Contains(text, `"password": "********"`)
+		r.Contains(text, `"username": "deploy"`)
+		r.Regexp(`VAULT_ADDR\s+= https://vault:8200`, text)
+	})
+
+	t.Run("It reveals secrets", func(t *testing.T) {
+		r := require.New(t)
+
+		text, _ := render(true)
+
+		r.Regexp(`DB_PASSWORD\s+= hunter2`, text)
+		r.Contains(text, "postgres://app:hunter2@db:5432/app")
+		r.Contains(text, "token=s3cr3t")
+	})
+
+	t.Run("When the task doesn't exist", func(t *testing.T) {
+		r := require.New(t)
+
+		textView := &componentfakes.FakeTextView{}
+		info := component.NewTaskInfo()
+		info.TextView = textView
+		info.Bind(tview.NewFlex())
+
+		r.NoError(info.Render())
+		r.Equal("Task not available.", textView.SetTextArgsForCall(0))
+	})
+
+	t.Run("When the component isn't bound", func(t *testing.T) {
+		r := require.New(t)
+
+		info := component.NewTaskInfo()
+		r.ErrorIs(info.Render(), component.ErrComponentNotBound)
+	})
+}
@@ -25,7 +25,12 @@ var (
 		LabelDriver,
 		LabelImage,
 		LabelLastEvent,
+		LabelCPU,
+		LabelMemory,
 	}
+
+	// the usage columns are volatile, as they change with every sample
+	taskUsageColumns = []int{5, 6}
 )
 
 type SelectTaskFunc func(allocID, taskID string)
@@ -40,11 +45,16 @@ type TaskTable struct {
 
 type TaskTableProps struct {
 	SelectTask        SelectTaskFunc
+	HighlightTask     SelectTaskFunc
 	HandleNoResources models.HandlerFunc
 
 	AllocationID string
 
 	Data []*models.Task
+
+	// Stats holds the recent resource usage of
+	// the allocation, if available.
+	Stats *models.AllocStats
 }
 
 func NewTaskTable() *TaskTable {
@@ -97,8 +107,10 @@ func (t *TaskTable) Render() error {
 	}
 
 	t.Table.SetSelectedFunc(t.taskSelected)
+	t.Table.SetSelectionChangedFunc(t.taskHighlighted)
 
 	t.Table.RenderHeader(TableHeaderTasks)
+	t.Table.SetVolatileColumns(taskUsageColumns...)
 	t.renderRows()
 
 	t.Table.SetTitle(fmt.Sprintf("%s (Allocation: %s)", TableTitleTasks, t.Props.AllocationID))
@@ -120,19 +132,39 @@ func (t *TaskTable) renderRows() {
 			task.Driver,
 		}
 
-		if image, ok := task.Config["image"]; ok {
-			row = append(row, image.(string))
+		image, _ := task.Config["image"].(string)
+		row = append(row, image)
+
+		lastEvent := ""
+		if len(task.Events) > 0 {
+			lastEvent = task.Events[len(task.Events)-1].DisplayMessage
 		}
 
-		row = append(row, task.Events[len(task.Events)-1].DisplayMessage)
-		// row = append(row, strconv.Itoa(task.CPU))
-		// row = append(row, strconv.Itoa(task.MemoryMB))
+		row = append(row, lastEvent)
+		row = append(row, t.renderUsage(task)...)
 
 		index := i + 1
 
 		c := t.getCellColor(task.State)
 		t.Table.RenderRow(row, index, c)
 	}
+
+	t.Table.Commit()
+}
+
+// renderUsage renders the CPU and memory usage of
+// the task compared to the resources allocated to it.
+func (t *TaskTable) renderUsage(task *models.Task) []string {
+	if t.Props.Stats == nil {
+		return []string{"-", "-"}
+	}
+
+	samples := t.Props.Stats.Tasks[task.Name]
+	cpu, memory := taskLimits(task)
+	return []string{
+		renderCPU(samples, cpu),
+		renderMemory(samples, memory),
+	}
 }
 
 func (t *TaskTable) getCellColor(status string) tcell.Color {
@@ -155,6 +187,15 @@ func (t *TaskTable) taskSelected(row, column int) {
 	t.Props.SelectTask(taskName, t.Props.AllocationID)
 }
 
+func (t *TaskTable) taskHighlighted(row, column int) {
+	if t.Props.HighlightTask == nil || row < 1 {
+		return
+	}
+
+	taskName := t.Table.GetCellContent(row, 0)
+	t.Props.HighlightTask(taskName, t.Props.AllocationID)
+}
+
 func (t *TaskTable) GetNameForSelection() string {
 	row, _ := t.Table.GetSelection()
 	return t.Table.GetCellContent(row, 0)
@@ -146,10 +146,10 @@ func TestTasks_Happy(t *testing.T) {
 		row3, index3, c3 := fakeTable.RenderRowArgsForCall(2)
 		row4, index4, c4 := fakeTable.RenderRowArgsForCall(3)
 
-		expectedRow1 := []string{"task-1", "running", "docker", "docker-image", "msg"}
-		expectedRow2 := []string{"task-2", "failed", "docker", "docker-image", "msg"}
-		expectedRow3 := []string{"task-3", "dead", "docker", "docker-image", "msg"}
-		expectedRow4 := []string{"task-4", "pending", "docker", "docker-image", "msg"}
+		expectedRow1 := []string{"task-1", "running", "docker", "docker-image", "msg", "-", "-"}
+		expectedRow2 := []string{"task-2", "failed", "docker", "docker-image", "msg", "-", "-"}
+		expectedRow3 := []string{"task-3", "dead", "docker", "docker-image", "msg", "-", "-"}
+		expectedRow4 := []string{"task-4", "pending", "docker", "docker-image", "msg", "-", "-"}
 
 		// It render the correct data for the rows
 		r.Equal(expectedRow1, row1)
@@ -170,6 +170,52 @@ func TestTasks_Happy(t *testing.T) {
 		r.Equal(c4, tcell.ColorYellow)
 	})
 
+	t.Run("When there are stats to render", func(t *testing.T) {
+		fakeTable := &componentfakes.FakeTable{}
+		at := component.NewTaskTable()
+
+		at.Table = fakeTable
+		at.Props.AllocationID = "japan"
+		at.Props.Data = []*models.Task{
+			{
+				Name:      "task-1",
+				State:     "running",
+				Allocated: &models.TaskResources{CPU: 100, MemoryMB: 100},
+			},
+			{
+				Name:  "task-2",
+				State: "running",
+			},
+		}
+		at.Props.Stats = &models.AllocStats{
+			Tasks: map[string][]models.ResourceUsage{
+				"task-1": {{CPU: 50, MemoryBytes: 80 << 20}},
+			},
+		}
+
+		at.Props.SelectTask = func(allocID, taskName string) {}
+		at.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+		slot := tview.NewFlex()
+		at.Bind(slot)
+
+		err := at.Render()
+		r.NoError(err)
+
+		// It marks the usage columns as volatile
+		r.Equal([]int{5, 6}, fakeTable.SetVolatileColumnsArgsForCall(0))
+
+		// It renders the usage compared to the allocated resources
+		row1, _, _ := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"task-1", "running", "", "", ""}, row1[:5])
+		r.Equal("         ▅ 50MHz/100MHz", row1[5])
+		r.Equal("[#ffd126]         ▇ 80.0MiB/100.0MiB 80%", row1[6])
+
+		// It renders no usage for tasks without samples
+		row2, _, _ := fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"-", "-"}, row2[5:])
+	})
+
 	t.Run("When render called again", func(t *testing.T) {
 		fakeTable := &componentfakes.FakeTable{}
 		at := component.NewTaskTable()
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+// SparklineWidth is the number of samples shown in the usage columns.
+const SparklineWidth = 10
+
+// The shares of the memory limit above which the memory
+// usage is highlighted, as tasks get OOM killed at the limit.
+const (
+	memoryWarning   = 0.75
+	memoryAttention = 0.9
+)
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders the last width values as bars scaled to max. If
+// max isn't set, the bars are scaled to the largest value. Values
+// above max get the highest bar. The line is padded on the left,
+// such that columns line up while samples come in.
+func Sparkline(values []float64, max float64, width int) string {
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	if max <= 0 {
+		for _, v := range values {
+			max = math.Max(max, v)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(values)))
+
+	for _, v := range values {
+		tick := 0
+		if max > 0 {
+			tick = int(math.Round(v / max * float64(len(sparkTicks)-1)))
+		}
+
+		if tick < 0 {
+			tick = 0
+		}
+
+		if tick >= len(sparkTicks) {
+			tick = len(sparkTicks) - 1
+		}
+
+		b.WriteRune(sparkTicks[tick])
+	}
+
+	return b.String()
+}
+
+// FormatMHz formats a CPU frequency, e.g. 250MHz or 1.5GHz.
+func FormatMHz(mhz float64) string {
+	if mhz < 1000 {
+		return fmt.Sprintf("%.0fMHz", mhz)
+	}
+
+	return fmt.Sprintf("%.1fGHz", mhz/1000)
+}
+
+// taskLimits returns the CPU (in MHz) and the memory (in bytes)
+// allocated to the task. Memory oversubscription raises the limit
+// a task is killed at to its memory max.
+func taskLimits(task *models.Task) (float64, uint64) {
+	if task.Allocated == nil {
+		return 0, 0
+	}
+
+	memoryMB := task.Allocated.MemoryMB
+	if task.Allocated.MemoryMaxMB > memoryMB {
+		memoryMB = task.Allocated.MemoryMaxMB
+	}
+
+	return float64(task.Allocated.CPU), uint64(memoryMB) << 20
+}
+
+// allocLimits returns the resources allocated to all tasks of an allocation.
+func allocLimits(alloc *models.Alloc) (float64, uint64) {
+	var cpu float64
+	var memory uint64
+	for _, task := range alloc.TaskList {
+		c, m := taskLimits(task)
+		cpu += c
+		memory += m
+	}
+
+	return cpu, memory
+}
+
+// renderCPU renders the CPU usage of the samples as a sparkline
+// followed by the current usage and the limit if it is known.
+func renderCPU(samples []models.ResourceUsage, limit float64) string {
+	if len(samples) == 0 {
+		return "-"
+	}
+
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = s.CPU
+	}
+
+	text := fmt.Sprintf("%s %s", Sparkline(values, limit, SparklineWidth), FormatMHz(values[len(values)-1]))
+	if limit > 0 {
+		text = fmt.Sprintf("%s/%s", text, FormatMHz(limit))
+	}
+
+	return text
+}
+
+// renderMemory renders the memory usage of the samples like renderCPU.
+// If the limit is known, the share of the limit in use is added and
+// highlighted when the usage gets close to the limit.
+func renderMemory(samples []models.ResourceUsage, limit uint64) string {
+	if len(samples) == 0 {
+		return "-"
+	}
+
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = float64(s.MemoryBytes)
+	}
+
+	current := samples[len(samples)-1].MemoryBytes
+	text := fmt.Sprintf("%s %s", Sparkline(values, float64(limit), SparklineWidth), FormatBytes(int64(current)))
+	if limit == 0 {
+		return text
+	}
+
+	share := float64(current) / float64(limit)
+	text = fmt.Sprintf("%s/%s %.0f%%", text, FormatBytes(int64(limit)), share*100)
+
+	switch {
+	case share >= memoryAttention:
+		text = fmt.Sprintf("[%s]%s", styles.ColorAttentionHex, text)
+	case share >= memoryWarning:
+		text = fmt.Sprintf("[%s]%s", styles.ColorWarningHex, text)
+	}
+
+	return text
+}
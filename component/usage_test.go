@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+)
+
+func TestSparkline(t *testing.T) {
+	r := require.New(t)
+
+	// It scales the values to the max
+	r.Equal("▁▅█", component.Sparkline([]float64{0, 50, 100}, 100, 3))
+
+	// It scales the values to the largest value without a max
+	r.Equal("▁▅█", component.Sparkline([]float64{0, 5, 10}, 0, 3))
+
+	// It caps values above the max
+	r.Equal("██", component.Sparkline([]float64{150, 100}, 100, 2))
+
+	// It pads the line to the width
+	r.Equal("   ▁█", component.Sparkline([]float64{0, 10}, 10, 5))
+
+	// It keeps the latest values
+	r.Equal("▅█", component.Sparkline([]float64{0, 5, 10}, 10, 2))
+
+	// It renders nothing but blanks without values
+	r.Equal("  ", component.Sparkline(nil, 0, 2))
+}
+
+func TestFormatMHz(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("0MHz", component.FormatMHz(0))
+	r.Equal("250MHz", component.FormatMHz(250.4))
+	r.Equal("1.5GHz", component.FormatMHz(1500))
+}
@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleVariables     = "Variables"
+	TableTitleVariableItems = "Variable"
+)
+
+var (
+	TableHeaderVariables = []string{
+		LabelPath,
+		LabelNamespace,
+		LabelVariables,
+		LabelModifyIndex,
+		LabelModified,
+	}
+
+	TableHeaderVariableItems = []string{
+		LabelKey,
+		LabelValue,
+	}
+)
+
+// VariableEntry is a row of the variable browser: either a
+// variable or a directory which groups the variables below
+// a path prefix.
+type VariableEntry struct {
+	// Name is the path relative to the prefix of the browser.
+	// Directories end with a slash.
+	Name      string
+	Namespace string
+
+	// Path is the path of the variable or the
+	// prefix of the variables in the directory.
+	Path  string
+	IsDir bool
+
+	// Count is the number of variables in the directory.
+	Count    int
+	Modified time.Time
+
+	Variable *models.Variable
+}
+
+// VariableEntries returns the directories and variables directly
+// below the prefix, which is empty or ends with a slash.
+func VariableEntries(vars []*models.Variable, prefix string) []*VariableEntry {
+	entries := []*VariableEntry{}
+	dirs := map[string]*VariableEntry{}
+
+	for _, v := range vars {
+		if !strings.HasPrefix(v.Path, prefix) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(v.Path, prefix)
+		i := strings.Index(rest, "/")
+		if i < 0 {
+			entries = append(entries, &VariableEntry{
+				Name:      rest,
+				Namespace: v.Namespace,
+				Path:      v.Path,
+				Count:     1,
+				Modified:  v.Modified,
+				Variable:  v,
+			})
+			continue
+		}
+
+		name := rest[:i+1]
+		key := v.Namespace + "\x00" + name
+		dir, ok := dirs[key]
+		if !ok {
+			dir = &VariableEntry{
+				Name:      name,
+				Namespace: v.Namespace,
+				Path:      prefix + name,
+				IsDir:     true,
+			}
+			dirs[key] = dir
+			entries = append(entries, dir)
+		}
+
+		dir.Count++
+		if v.Modified.After(dir.Modified) {
+			dir.Modified = v.Modified
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].IsDir != entries[j].IsDir {
+			return entries[i].IsDir
+		}
+
+		if entries[i].Name != entries[j].Name {
+			return entries[i].Name < entries[j].Name
+		}
+
+		return entries[i].Namespace < entries[j].Namespace
+	})
+
+	return entries
+}
+
+type SelectVariableFunc func(entry *VariableEntry)
+
+// VariableTable lists the Nomad Variables below a path prefix
+// like a directory tree.
+type VariableTable struct {
+	Table Table
+	Props *VariableTableProps
+
+	slot *tview.Flex
+}
+
+type VariableTableProps struct {
+	SelectEntry       SelectVariableFunc
+	HandleNoResources models.HandlerFunc
+
+	// Prefix is the path the table lists. It is
+	// empty or ends with a slash.
+	Prefix string
+
+	Data []*models.Variable
+
+	entries []*VariableEntry
+}
+
+func NewVariableTable() *VariableTable {
+	return &VariableTable{
+		Table: primitive.NewTable(),
+		Props: &VariableTableProps{},
+	}
+}
+
+func (t *VariableTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *VariableTable) Render() error {
+	if t.Props.SelectEntry == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	t.Props.entries = VariableEntries(t.Props.Data, t.Props.Prefix)
+	if len(t.Props.entries) == 0 {
+		t.Props.HandleNoResources(
+			"%sno variables in /%s\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			t.Props.Prefix,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.entrySelected)
+
+	t.Table.SetTitle("%s (/%s)", TableTitleVariables, t.Props.Prefix)
+	t.Table.RenderHeader(TableHeaderVariables)
+	t.Table.SetKeyColumns(0, 1)
+	t.renderRows()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *VariableTable) renderRows() {
+	for i, e := range t.Props.entries {
+		count, index, c := "-", "-", tcell.ColorWhite
+		if e.IsDir {
+			count, c = fmt.Sprint(e.Count), styles.TcellColorHighlighPrimary
+		} else {
+			index = fmt.Sprint(e.Variable.ModifyIndex)
+		}
+
+		row := []string{
+			e.Name,
+			e.Namespace,
+			count,
+			index,
+			e.Modified.Format(time.RFC3339),
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+}
+
+func (t *VariableTable) entrySelected(row, column int) {
+	if entry := t.GetEntryForSelection(); entry != nil {
+		t.Props.SelectEntry(entry)
+	}
+}
+
+// GetEntryForSelection returns the selected directory or
+// variable or nil. The entry is looked up by its name and
+// namespace, as the rows may be sorted.
+func (t *VariableTable) GetEntryForSelection() *VariableEntry {
+	row, _ := t.Table.GetSelection()
+	if row < 1 {
+		return nil
+	}
+
+	name := t.Table.GetCellContent(row, 0)
+	namespace := t.Table.GetCellContent(row, 1)
+	for _, e := range t.Props.entries {
+		if e.Name == name && e.Namespace == namespace {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// VariableItemsTable shows the items of a Nomad Variable.
+// The values are masked unless Reveal is set.
+type VariableItemsTable struct {
+	Table Table
+	Props *VariableItemsTableProps
+
+	slot *tview.Flex
+}
+
+type VariableItemsTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data   *models.Variable
+	Reveal bool
+}
+
+func NewVariableItemsTable() *VariableItemsTable {
+	return &VariableItemsTable{
+		Table: primitive.NewTable(),
+		Props: &VariableItemsTableProps{},
+	}
+}
+
+func (t *VariableItemsTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *VariableItemsTable) Render() error {
+	if t.Props.Data == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	v := t.Props.Data
+	if len(v.Items) == 0 {
+		t.Props.HandleNoResources(
+			"%sno items in %s\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			v.Path,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	values := "masked"
+	if t.Props.Reveal {
+		values = "revealed"
+	}
+
+	t.Table.SetTitle("%s (%s:%s @%d, values %s)", TableTitleVariableItems, v.Namespace, v.Path, v.ModifyIndex, values)
+	t.Table.RenderHeader(TableHeaderVariableItems)
+
+	keys := make([]string, 0, len(v.Items))
+	for k := range v.Items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for i, k := range keys {
+		value := secretMask
+		if t.Props.Reveal {
+			value = v.Items[k]
+		}
+
+		t.Table.RenderRow([]string{k, value}, i+1, tcell.ColorWhite)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestVariableEntries(t *testing.T) {
+	r := require.New(t)
+
+	old := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+	recent := old.Add(time.Hour)
+
+	vars := []*models.Variable{
+		{Namespace: "default", Path: "nomad/jobs/web", ModifyIndex: 10, Modified: old},
+		{Namespace: "default", Path: "nomad/jobs/web/api", ModifyIndex: 12, Modified: recent},
+		{Namespace: "default", Path: "config", ModifyIndex: 3, Modified: old},
+		{Namespace: "prod", Path: "nomad/jobs/db", ModifyIndex: 7, Modified: old},
+	}
+
+	t.Run("It groups the variables by the first path segment", func(t *testing.T) {
+		entries := component.VariableEntries(vars, "")
+		r.Len(entries, 3)
+
+		r.Equal("nomad/", entries[0].Name)
+		r.Equal("default", entries[0].Namespace)
+		r.Equal("nomad/", entries[0].Path)
+		r.True(entries[0].IsDir)
+		r.Equal(2, entries[0].Count)
+		r.Equal(recent, entries[0].Modified)
+
+		r.Equal("nomad/", entries[1].Name)
+		r.Equal("prod", entries[1].Namespace)
+		r.Equal(1, entries[1].Count)
+
+		r.Equal("config", entries[2].Name)
+		r.False(entries[2].IsDir)
+		r.Equal(vars[2], entries[2].Variable)
+	})
+
+	t.Run("It lists a variable next to the directory of the same path", func(t *testing.T) {
+		entries := component.VariableEntries(vars, "nomad/jobs/")
+		r.Len(entries, 3)
+
+		r.Equal("web/", entries[0].Name)
+		r.Equal("nomad/jobs/web/", entries[0].Path)
+		r.Equal("db", entries[1].Name)
+		r.Equal("web", entries[2].Name)
+		r.Equal(vars[0], entries[2].Variable)
+	})
+
+	t.Run("It returns no entries for an unknown prefix", func(t *testing.T) {
+		r.Empty(component.VariableEntries(vars, "foo/"))
+	})
+}
+
+func TestVariableTable_Happy(t *testing.T) {
+	r := require.New(t)
+
+	modified := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	fakeTable := &componentfakes.FakeTable{}
+	vt := component.NewVariableTable()
+	vt.Table = fakeTable
+	vt.Props.Prefix = "nomad/"
+	vt.Props.Data = []*models.Variable{
+		{Namespace: "default", Path: "nomad/jobs/web", ModifyIndex: 10, Modified: modified},
+		{Namespace: "default", Path: "nomad/config", ModifyIndex: 3, Modified: modified},
+	}
+
+	var selected *component.VariableEntry
+	vt.Props.SelectEntry = func(entry *component.VariableEntry) {
+		selected = entry
+	}
+	vt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+	vt.Bind(tview.NewFlex())
+
+	err := vt.Render()
+	r.NoError(err)
+
+	// It renders the header and the title
+	r.Equal(component.TableHeaderVariables, fakeTable.RenderHeaderArgsForCall(0))
+	format, args := fakeTable.SetTitleArgsForCall(0)
+	r.Equal("Variables (/nomad/)", fmt.Sprintf(format, args...))
+
+	// It renders directories first
+	row1, index1, c1 := fakeTable.RenderRowArgsForCall(0)
+	r.Equal([]string{"jobs/", "default", "1", "-", "2023-01-02T15:04:05Z"}, row1)
+	r.Equal(1, index1)
+	r.Equal(styles.TcellColorHighlighPrimary, c1)
+
+	row2, index2, c2 := fakeTable.RenderRowArgsForCall(1)
+	r.Equal([]string{"config", "default", "-", "3", "2023-01-02T15:04:05Z"}, row2)
+	r.Equal(2, index2)
+	r.Equal(tcell.ColorWhite, c2)
+
+	// It selects the entry by the name and namespace of the row
+	fakeTable.GetSelectionReturns(2, 0)
+	fakeTable.GetCellContentStub = func(row, column int) string {
+		return row2[column]
+	}
+	selectFn := fakeTable.SetSelectedFuncArgsForCall(0)
+	selectFn(2, 0)
+	r.NotNil(selected)
+	r.Equal("nomad/config", selected.Path)
+	r.Equal(vt.Props.Data[1], selected.Variable)
+
+	// The header isn't an entry
+	fakeTable.GetSelectionReturns(0, 0)
+	r.Nil(vt.GetEntryForSelection())
+}
+
+func TestVariableTable_Sad(t *testing.T) {
+	t.Run("When there are no variables below the prefix", func(t *testing.T) {
+		r := require.New(t)
+
+		vt := component.NewVariableTable()
+		vt.Table = &componentfakes.FakeTable{}
+		vt.Props.Prefix = "foo/"
+		vt.Props.SelectEntry = func(entry *component.VariableEntry) {}
+
+		var called bool
+		vt.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+			r.Equal("foo/", args[1])
+		}
+
+		vt.Bind(tview.NewFlex())
+		r.NoError(vt.Render())
+		r.True(called)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		vt := component.NewVariableTable()
+		r.ErrorIs(vt.Render(), component.ErrComponentPropsNotSet)
+
+		vt.Props.SelectEntry = func(entry *component.VariableEntry) {}
+		vt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(vt.Render(), component.ErrComponentNotBound)
+	})
+}
+
+func TestVariableItemsTable(t *testing.T) {
+	variable := &models.Variable{
+		Namespace:   "default",
+		Path:        "nomad/jobs/web",
+		ModifyIndex: 10,
+		Items: map[string]string{
+			"password": "hunter2",
+			"user":     "admin",
+		},
+	}
+
+	t.Run("It masks the values", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		items := component.NewVariableItemsTable()
+		items.Table = fakeTable
+		items.Props.Data = variable
+		items.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		items.Bind(tview.NewFlex())
+
+		r.NoError(items.Render())
+
+		format, args := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Variable (default:nomad/jobs/web @10, values masked)", fmt.Sprintf(format, args...))
+		r.Equal(component.TableHeaderVariableItems, fakeTable.RenderHeaderArgsForCall(0))
+
+		row1, _, _ := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"password", "********"}, row1)
+		row2, _, _ := fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"user", "********"}, row2)
+	})
+
+	t.Run("It reveals the values", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		items := component.NewVariableItemsTable()
+		items.Table = fakeTable
+		items.Props.Data = variable
+		items.Props.Reveal = true
+		items.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		items.Bind(tview.NewFlex())
+
+		r.NoError(items.Render())
+
+		row1, _, _ := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"password", "hunter2"}, row1)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		items := component.NewVariableItemsTable()
+		r.ErrorIs(items.Render(), component.ErrComponentPropsNotSet)
+
+		items.Props.Data = variable
+		items.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(items.Render(), component.ErrComponentNotBound)
+	})
+}
@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/models"
+	primitive "github.com/hcjulz/damon/primitives"
+	"github.com/hcjulz/damon/styles"
+)
+
+const (
+	TableTitleVolumes      = "CSI Volumes"
+	TableTitleVolumeClaims = "Claims"
+	TableTitlePlugins      = "CSI Plugins"
+)
+
+var (
+	TableHeaderVolumes = []string{
+		LabelID,
+		LabelNamespace,
+		LabelPlugin,
+		LabelAccessMode,
+		LabelAttachmentMode,
+		LabelSchedulable,
+		LabelReaders,
+		LabelWriters,
+		LabelControllers,
+		LabelNodes,
+	}
+
+	TableHeaderVolumeClaims = []string{
+		LabelAllocID,
+		LabelName,
+		LabelMode,
+		LabelJobID,
+		LabelNode,
+		LabelClientStatus,
+		LabelDesiredStatus,
+	}
+
+	TableHeaderPlugins = []string{
+		LabelID,
+		LabelProvider,
+		LabelControllers,
+		LabelNodes,
+	}
+)
+
+type SelectVolumeFunc func(namespace, id string)
+
+// VolumeTable lists the CSI volumes with
+// their claims and the health of their plugin.
+type VolumeTable struct {
+	Table Table
+	Props *VolumeTableProps
+
+	slot *tview.Flex
+}
+
+type VolumeTableProps struct {
+	SelectVolume      SelectVolumeFunc
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.Volume
+}
+
+func NewVolumeTable() *VolumeTable {
+	return &VolumeTable{
+		Table: primitive.NewTable(),
+		Props: &VolumeTableProps{},
+	}
+}
+
+func (t *VolumeTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *VolumeTable) Render() error {
+	if t.Props.SelectVolume == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno CSI volumes registered\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.volumeSelected)
+
+	t.Table.SetTitle(TableTitleVolumes)
+	t.Table.RenderHeader(TableHeaderVolumes)
+	t.Table.SetKeyColumns(0, 1)
+
+	for i, v := range t.Props.Data {
+		row := []string{
+			v.ID,
+			v.Namespace,
+			v.PluginID,
+			v.AccessMode,
+			v.AttachmentMode,
+			fmt.Sprint(v.Schedulable),
+			fmt.Sprint(v.Readers),
+			fmt.Sprint(v.Writers),
+			controllerHealth(v.ControllerRequired, v.ControllersHealthy, v.ControllersExpected),
+			fmt.Sprintf("%d/%d", v.NodesHealthy, v.NodesExpected),
+		}
+
+		c := tcell.ColorWhite
+		if !v.Schedulable {
+			c = styles.TcellColorAttention
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *VolumeTable) volumeSelected(row, column int) {
+	if row < 1 {
+		return
+	}
+
+	id := t.Table.GetCellContent(row, 0)
+	namespace := t.Table.GetCellContent(row, 1)
+	t.Props.SelectVolume(namespace, id)
+}
+
+type SelectVolumeClaimFunc func(claim *models.VolumeClaim)
+
+// VolumeClaimTable lists the allocations which claim a CSI volume.
+// Claims of allocations which stopped are highlighted, as they keep
+// other allocations from claiming the volume until they are released.
+type VolumeClaimTable struct {
+	Table Table
+	Props *VolumeClaimTableProps
+
+	slot *tview.Flex
+}
+
+type VolumeClaimTableProps struct {
+	SelectClaim       SelectVolumeClaimFunc
+	HandleNoResources models.HandlerFunc
+
+	Data *models.Volume
+}
+
+func NewVolumeClaimTable() *VolumeClaimTable {
+	return &VolumeClaimTable{
+		Table: primitive.NewTable(),
+		Props: &VolumeClaimTableProps{},
+	}
+}
+
+func (t *VolumeClaimTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *VolumeClaimTable) Render() error {
+	if t.Props.SelectClaim == nil || t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	v := t.Props.Data
+	if v == nil || len(v.Claims) == 0 {
+		t.Props.HandleNoResources(
+			"%sno claims on the volume\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetSelectedFunc(t.claimSelected)
+
+	t.Table.SetTitle("%s (%s:%s, %d readers, %d writers)", TableTitleVolumeClaims, v.Namespace, v.ID, v.Readers, v.Writers)
+	t.Table.RenderHeader(TableHeaderVolumeClaims)
+	t.Table.SetKeyColumns(0, 2)
+
+	for i, claim := range v.Claims {
+		row := []string{
+			claim.AllocID,
+			claim.AllocName,
+			claim.Mode,
+			claim.JobID,
+			claim.NodeName,
+			claim.ClientStatus,
+			claim.DesiredStatus,
+		}
+
+		c := tcell.ColorWhite
+		if StaleClaim(claim) {
+			c = styles.TcellColorAttention
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+func (t *VolumeClaimTable) claimSelected(row, column int) {
+	if claim := t.GetClaimForSelection(); claim != nil {
+		t.Props.SelectClaim(claim)
+	}
+}
+
+// GetClaimForSelection returns the selected claim or nil.
+func (t *VolumeClaimTable) GetClaimForSelection() *models.VolumeClaim {
+	row, _ := t.Table.GetSelection()
+	if row < 1 || t.Props.Data == nil {
+		return nil
+	}
+
+	allocID := t.Table.GetCellContent(row, 0)
+	for _, claim := range t.Props.Data.Claims {
+		if claim.AllocID == allocID {
+			return claim
+		}
+	}
+
+	return nil
+}
+
+// StaleClaim reports whether the allocation of the claim stopped,
+// or is about to, but the claim wasn't released yet.
+func StaleClaim(claim *models.VolumeClaim) bool {
+	switch claim.ClientStatus {
+	case models.StatusRunning, models.StatusPending:
+		return claim.DesiredStatus == models.DesiredStatusStop
+	}
+
+	return true
+}
+
+// PluginTable lists the CSI plugins with the
+// health of their controllers and nodes.
+type PluginTable struct {
+	Table Table
+	Props *PluginTableProps
+
+	slot *tview.Flex
+}
+
+type PluginTableProps struct {
+	HandleNoResources models.HandlerFunc
+
+	Data []*models.CSIPlugin
+}
+
+func NewPluginTable() *PluginTable {
+	return &PluginTable{
+		Table: primitive.NewTable(),
+		Props: &PluginTableProps{},
+	}
+}
+
+func (t *PluginTable) Bind(slot *tview.Flex) {
+	t.slot = slot
+}
+
+func (t *PluginTable) Render() error {
+	if t.Props.HandleNoResources == nil {
+		return ErrComponentPropsNotSet
+	}
+
+	if t.slot == nil {
+		return ErrComponentNotBound
+	}
+
+	t.slot.Clear()
+	t.Table.Clear()
+
+	if len(t.Props.Data) == 0 {
+		t.Props.HandleNoResources(
+			"%sno CSI plugins running\n¯%s\\_( ͡• ͜ʖ ͡•)_/¯",
+			styles.HighlightPrimaryTag,
+			styles.HighlightSecondaryTag,
+		)
+
+		return nil
+	}
+
+	t.Table.SetTitle(TableTitlePlugins)
+	t.Table.RenderHeader(TableHeaderPlugins)
+
+	for i, p := range t.Props.Data {
+		row := []string{
+			p.ID,
+			p.Provider,
+			controllerHealth(p.ControllerRequired, p.ControllersHealthy, p.ControllersExpected),
+			fmt.Sprintf("%d/%d", p.NodesHealthy, p.NodesExpected),
+		}
+
+		c := tcell.ColorWhite
+		if p.NodesHealthy < p.NodesExpected || (p.ControllerRequired && p.ControllersHealthy < p.ControllersExpected) {
+			c = styles.TcellColorAttention
+		}
+
+		t.Table.RenderRow(row, i+1, c)
+	}
+
+	t.Table.Commit()
+
+	t.slot.AddItem(t.Table.Primitive(), 0, 1, false)
+	return nil
+}
+
+// controllerHealth renders the healthy and expected controllers
+// of a plugin, or "-" if the plugin doesn't need controllers.
+func controllerHealth(required bool, healthy, expected int) string {
+	if !required {
+		return "-"
+	}
+
+	return fmt.Sprintf("%d/%d", healthy, expected)
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package component_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/component/componentfakes"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+func TestVolumeTable(t *testing.T) {
+	t.Run("It renders the volumes", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTable := &componentfakes.FakeTable{}
+		vt := component.NewVolumeTable()
+		vt.Table = fakeTable
+		vt.Props.Data = []*models.Volume{
+			{
+				ID:                  "pg",
+				Namespace:           "default",
+				PluginID:            "ebs",
+				AccessMode:          "single-node-writer",
+				AttachmentMode:      "file-system",
+				Schedulable:         true,
+				Writers:             1,
+				ControllerRequired:  true,
+				ControllersHealthy:  1,
+				ControllersExpected: 1,
+				NodesHealthy:        3,
+				NodesExpected:       3,
+			},
+			{ID: "cache", Namespace: "default", PluginID: "nfs", NodesHealthy: 1, NodesExpected: 2},
+		}
+
+		var namespace, id string
+		vt.Props.SelectVolume = func(ns, volID string) {
+			namespace, id = ns, volID
+		}
+		vt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		vt.Bind(tview.NewFlex())
+
+		r.NoError(vt.Render())
+
+		r.Equal(component.TableHeaderVolumes, fakeTable.RenderHeaderArgsForCall(0))
+
+		row1, _, c1 := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"pg", "default", "ebs", "single-node-writer", "file-system", "true", "0", "1", "1/1", "3/3"}, row1)
+		r.Equal(tcell.ColorWhite, c1)
+
+		// It highlights volumes which can't be scheduled
+		row2, _, c2 := fakeTable.RenderRowArgsForCall(1)
+		r.Equal([]string{"cache", "default", "nfs", "", "", "false", "0", "0", "-", "1/2"}, row2)
+		r.Equal(styles.TcellColorAttention, c2)
+
+		fakeTable.GetCellContentStub = func(row, column int) string {
+			return row1[column]
+		}
+		fakeTable.SetSelectedFuncArgsForCall(0)(1, 0)
+		r.Equal("default", namespace)
+		r.Equal("pg", id)
+	})
+
+	t.Run("When the component isn't bound or props aren't set", func(t *testing.T) {
+		r := require.New(t)
+
+		vt := component.NewVolumeTable()
+		r.ErrorIs(vt.Render(), component.ErrComponentPropsNotSet)
+
+		vt.Props.SelectVolume = func(ns, volID string) {}
+		vt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		r.ErrorIs(vt.Render(), component.ErrComponentNotBound)
+	})
+}
+
+func TestVolumeClaimTable(t *testing.T) {
+	t.Run("It renders the claims", func(t *testing.T) {
+		r := require.New(t)
+
+		volume := &models.Volume{
+			ID:        "pg",
+			Namespace: "default",
+			Readers:   1,
+			Writers:   1,
+			Claims: []*models.VolumeClaim{
+				{AllocID: "a2", AllocName: "db.primary[0]", Mode: "write", JobID: "db", NodeName: "sun", ClientStatus: "lost", DesiredStatus: "stop"},
+				{AllocID: "a1", AllocName: "db.replica[0]", Mode: "read", JobID: "db", NodeName: "moon", ClientStatus: "running", DesiredStatus: "run"},
+			},
+		}
+
+		fakeTable := &componentfakes.FakeTable{}
+		ct := component.NewVolumeClaimTable()
+		ct.Table = fakeTable
+		ct.Props.Data = volume
+
+		var selected *models.VolumeClaim
+		ct.Props.SelectClaim = func(claim *models.VolumeClaim) {
+			selected = claim
+		}
+		ct.Props.HandleNoResources = func(format string, args ...interface{}) {}
+		ct.Bind(tview.NewFlex())
+
+		r.NoError(ct.Render())
+
+		format, args := fakeTable.SetTitleArgsForCall(0)
+		r.Equal("Claims (default:pg, 1 readers, 1 writers)", fmt.Sprintf(format, args...))
+
+		// It highlights the claims of stopped allocations
+		row1, _, c1 := fakeTable.RenderRowArgsForCall(0)
+		r.Equal([]string{"a2", "db.primary[0]", "write", "db", "sun", "lost", "stop"}, row1)
+		r.Equal(styles.TcellColorAttention, c1)
+
+		_, _, c2 := fakeTable.RenderRowArgsForCall(1)
+		r.Equal(tcell.ColorWhite, c2)
+
+		fakeTable.GetSelectionReturns(2, 0)
+		fakeTable.GetCellContentReturns("a1")
+		fakeTable.SetSelectedFuncArgsForCall(0)(2, 0)
+		r.Equal(volume.Claims[1], selected)
+	})
+
+	t.Run("When there are no claims", func(t *testing.T) {
+		r := require.New(t)
+
+		ct := component.NewVolumeClaimTable()
+		ct.Table = &componentfakes.FakeTable{}
+		ct.Props.Data = &models.Volume{ID: "pg"}
+		ct.Props.SelectClaim = func(claim *models.VolumeClaim) {}
+
+		var called bool
+		ct.Props.HandleNoResources = func(format string, args ...interface{}) {
+			called = true
+		}
+		ct.Bind(tview.NewFlex())
+
+		r.NoError(ct.Render())
+		r.True(called)
+	})
+}
+
+func TestStaleClaim(t *testing.T) {
+	r := require.New(t)
+
+	r.False(component.StaleClaim(&models.VolumeClaim{ClientStatus: "running", DesiredStatus: "run"}))
+	r.False(component.StaleClaim(&models.VolumeClaim{ClientStatus: "pending", DesiredStatus: "run"}))
+	r.True(component.StaleClaim(&models.VolumeClaim{ClientStatus: "running", DesiredStatus: "stop"}))
+	r.True(component.StaleClaim(&models.VolumeClaim{ClientStatus: "complete", DesiredStatus: "run"}))
+	r.True(component.StaleClaim(&models.VolumeClaim{ClientStatus: "lost", DesiredStatus: "stop"}))
+}
+
+func TestPluginTable(t *testing.T) {
+	r := require.New(t)
+
+	fakeTable := &componentfakes.FakeTable{}
+	pt := component.NewPluginTable()
+	pt.Table = fakeTable
+	pt.Props.Data = []*models.CSIPlugin{
+		{ID: "ebs", Provider: "ebs.csi.aws.com", ControllerRequired: true, ControllersHealthy: 0, ControllersExpected: 1, NodesHealthy: 3, NodesExpected: 3},
+		{ID: "nfs", Provider: "nfs.csi.k8s.io", NodesHealthy: 2, NodesExpected: 2},
+	}
+	pt.Props.HandleNoResources = func(format string, args ...interface{}) {}
+
+	r.ErrorIs(pt.Render(), component.ErrComponentNotBound)
+
+	pt.Bind(tview.NewFlex())
+	r.NoError(pt.Render())
+
+	r.Equal(component.TableHeaderPlugins, fakeTable.RenderHeaderArgsForCall(0))
+
+	// It highlights plugins with unhealthy controllers or nodes
+	row1, _, c1 := fakeTable.RenderRowArgsForCall(0)
+	r.Equal([]string{"ebs", "ebs.csi.aws.com", "0/1", "3/3"}, row1)
+	r.Equal(styles.TcellColorAttention, c1)
+
+	row2, _, c2 := fakeTable.RenderRowArgsForCall(1)
+	r.Equal([]string{"nfs", "nfs.csi.k8s.io", "-", "2/2"}, row2)
+	r.Equal(tcell.ColorWhite, c2)
+}
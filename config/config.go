@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+// Package config reads and writes the Damon configuration,
+// which holds the settings that are kept across sessions.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hcjulz/damon/models"
+)
+
+const (
+	// EnvConfigPath overwrites the default location of the config file.
+	EnvConfigPath = "DAMON_CONFIG"
+
+	configDir  = "damon"
+	configFile = "config.json"
+
+	// MaxBookmarkKey is the highest number key a bookmark can be bound to.
+	MaxBookmarkKey = 9
+)
+
+const (
+	ErrBookmarkNameEmpty  = models.Sentinel("bookmark name must not be empty")
+	ErrBookmarkNotFound   = models.Sentinel("bookmark not found")
+	ErrBookmarkInvalidKey = models.Sentinel("bookmark key must be between 1 and 9")
+	ErrNoConfigFile       = models.Sentinel("config has no file to save to")
+)
+
+// Config is the Damon configuration. Changes are
+// written to the config file immediately.
+type Config struct {
+	Bookmarks []*models.Bookmark `json:"bookmarks"`
+
+	path string
+}
+
+// DefaultPath returns the path of the config file. It can be set
+// with DAMON_CONFIG and defaults to damon/config.json in the user's
+// config directory.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(EnvConfigPath); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, configDir, configFile), nil
+}
+
+// Load reads the config file at path. If the file
+// doesn't exist yet, an empty config is returned.
+func Load(path string) (*Config, error) {
+	c := &Config{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// Path returns the path of the config file.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Save writes the config to the config file. A config
+// which wasn't loaded from a file can't be saved.
+func (c *Config) Save() error {
+	if c.path == "" {
+		return ErrNoConfigFile
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+
+	// write to a temporary file first, such that the
+	// config doesn't get corrupted when writing fails.
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.path)
+}
+
+// SaveBookmark adds the bookmark or replaces the bookmark with the same
+// name. The key of a replaced bookmark is kept.
+func (c *Config) SaveBookmark(bookmark *models.Bookmark) error {
+	if bookmark.Name == "" {
+		return ErrBookmarkNameEmpty
+	}
+
+	if existing := c.Bookmark(bookmark.Name); existing != nil {
+		if bookmark.Key == 0 {
+			bookmark.Key = existing.Key
+		}
+
+		*existing = *bookmark
+		return c.Save()
+	}
+
+	c.Bookmarks = append(c.Bookmarks, bookmark)
+	return c.Save()
+}
+
+// DeleteBookmark removes the bookmark with the given name.
+func (c *Config) DeleteBookmark(name string) error {
+	for i, b := range c.Bookmarks {
+		if b.Name == name {
+			c.Bookmarks = append(c.Bookmarks[:i], c.Bookmarks[i+1:]...)
+			return c.Save()
+		}
+	}
+
+	return ErrBookmarkNotFound
+}
+
+// BindBookmark binds the number key to the bookmark with the given
+// name. Another bookmark bound to the same key loses it.
+func (c *Config) BindBookmark(name string, key int) error {
+	if key < 1 || key > MaxBookmarkKey {
+		return ErrBookmarkInvalidKey
+	}
+
+	bookmark := c.Bookmark(name)
+	if bookmark == nil {
+		return ErrBookmarkNotFound
+	}
+
+	for _, b := range c.Bookmarks {
+		if b.Key == key {
+			b.Key = 0
+		}
+	}
+
+	bookmark.Key = key
+	return c.Save()
+}
+
+// Bookmark returns the bookmark with the given name or nil.
+func (c *Config) Bookmark(name string) *models.Bookmark {
+	for _, b := range c.Bookmarks {
+		if b.Name == name {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// BookmarkForKey returns the bookmark bound to the key or nil.
+func (c *Config) BookmarkForKey(key int) *models.Bookmark {
+	for _, b := range c.Bookmarks {
+		if b.Key == key {
+			return b
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/config"
+	"github.com/hcjulz/damon/models"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("When the config file doesn't exist", func(t *testing.T) {
+		r := require.New(t)
+
+		path := filepath.Join(t.TempDir(), "damon", "config.json")
+		c, err := config.Load(path)
+		r.NoError(err)
+		r.Empty(c.Bookmarks)
+		r.Equal(path, c.Path())
+	})
+
+	t.Run("When the config file is invalid", func(t *testing.T) {
+		r := require.New(t)
+
+		path := filepath.Join(t.TempDir(), "config.json")
+		r.NoError(os.WriteFile(path, []byte("{"), 0o600))
+
+		_, err := config.Load(path)
+		r.Error(err)
+	})
+
+	t.Run("When the config has no file", func(t *testing.T) {
+		r := require.New(t)
+
+		c := &config.Config{}
+		err := c.SaveBookmark(&models.Bookmark{Name: "jobs", View: "jobs"})
+		r.ErrorIs(err, config.ErrNoConfigFile)
+	})
+}
+
+func TestDefaultPath(t *testing.T) {
+	r := require.New(t)
+
+	t.Setenv(config.EnvConfigPath, "/tmp/damon.json")
+
+	path, err := config.DefaultPath()
+	r.NoError(err)
+	r.Equal("/tmp/damon.json", path)
+}
+
+func TestBookmarks(t *testing.T) {
+	r := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "damon", "config.json")
+	c, err := config.Load(path)
+	r.NoError(err)
+
+	// It persists saved bookmarks
+	err = c.SaveBookmark(&models.Bookmark{Name: "prod failing services", View: "jobs", Namespace: "prod", Filter: "status!=running"})
+	r.NoError(err)
+	err = c.SaveBookmark(&models.Bookmark{Name: "deployments", View: "deployments"})
+	r.NoError(err)
+
+	loaded, err := config.Load(path)
+	r.NoError(err)
+	r.Len(loaded.Bookmarks, 2)
+	r.Equal("status!=running", loaded.Bookmark("prod failing services").Filter)
+
+	// It binds bookmarks to number keys
+	r.NoError(c.BindBookmark("prod failing services", 1))
+	r.Equal("prod failing services", c.BookmarkForKey(1).Name)
+
+	// A key is bound to one bookmark only
+	r.NoError(c.BindBookmark("deployments", 1))
+	r.Equal("deployments", c.BookmarkForKey(1).Name)
+	r.Equal(0, c.Bookmark("prod failing services").Key)
+
+	// It keeps the key when a bookmark is replaced
+	err = c.SaveBookmark(&models.Bookmark{Name: "deployments", View: "deployments", Filter: "status:failed"})
+	r.NoError(err)
+	r.Len(c.Bookmarks, 2)
+	r.Equal(1, c.Bookmark("deployments").Key)
+	r.Equal("status:failed", c.Bookmark("deployments").Filter)
+
+	// It deletes bookmarks
+	r.NoError(c.DeleteBookmark("deployments"))
+	r.Nil(c.BookmarkForKey(1))
+
+	loaded, err = config.Load(path)
+	r.NoError(err)
+	r.Len(loaded.Bookmarks, 1)
+
+	// It rejects invalid input
+	r.ErrorIs(c.SaveBookmark(&models.Bookmark{}), config.ErrBookmarkNameEmpty)
+	r.ErrorIs(c.DeleteBookmark("unknown"), config.ErrBookmarkNotFound)
+	r.ErrorIs(c.BindBookmark("unknown", 2), config.ErrBookmarkNotFound)
+	r.ErrorIs(c.BindBookmark("prod failing services", 10), config.ErrBookmarkInvalidKey)
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+// Package filter implements the query language which is used
+// to filter the rows of the table views.
+//
+// A query consists of terms which are combined with AND (the
+// default when terms are separated by spaces) and OR. Terms can
+// be negated with a leading - or NOT and grouped with parentheses:
+//
+//	status:run                 field matches the pattern
+//	status=running             field equals the value
+//	status!=running            field doesn't equal the value
+//	failed>0                   numeric comparison (>, >=, <, <=)
+//	age<1h                     age comparison (e.g. 30s, 5m, 1h, 2d)
+//	-type:batch                negation
+//	web OR (api AND -dead)     any field matches the pattern
+//
+// Patterns are case-insensitive regular expressions.
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Kind is the type of a field, which defines
+// the operators that can be used with it.
+type Kind int
+
+const (
+	// String fields support :, = and !=.
+	String Kind = iota
+
+	// Number fields support all operators.
+	Number
+
+	// Time fields are compared by their age using
+	// durations and support all operators but :.
+	Time
+
+	// Any fields hold values of unknown type, e.g. fields of
+	// JSON log lines. They support all operators and compare
+	// as numbers if both sides are numbers.
+	Any
+)
+
+// Schema maps the names of the fields which can
+// be used in a query to their kind.
+type Schema map[string]Kind
+
+// Fields holds the values of a single row. Values are
+// strings, numbers or time.Time according to the schema.
+type Fields map[string]interface{}
+
+type operator string
+
+const (
+	opMatch        operator = ":"
+	opEqual        operator = "="
+	opNotEqual     operator = "!="
+	opGreater      operator = ">"
+	opGreaterEqual operator = ">="
+	opLess         operator = "<"
+	opLessEqual    operator = "<="
+)
+
+// Query is a parsed query. A nil query matches everything.
+type Query struct {
+	root node
+}
+
+// Parse parses the query. Fields used in the query must be
+// part of the schema, unless the schema is nil. Then any field
+// can be used and is of kind Any. An empty query results in a
+// nil query.
+func Parse(query string, schema Schema) (*Query, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, schema: schema}
+	root, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Query{root: root}, nil
+}
+
+// Match reports whether the fields of a row match the query.
+func (q *Query) Match(fields Fields) bool {
+	if q == nil {
+		return true
+	}
+
+	return q.root.match(fields)
+}
+
+type node interface {
+	match(fields Fields) bool
+}
+
+type and struct {
+	left, right node
+}
+
+func (n *and) match(fields Fields) bool {
+	return n.left.match(fields) && n.right.match(fields)
+}
+
+type or struct {
+	left, right node
+}
+
+func (n *or) match(fields Fields) bool {
+	return n.left.match(fields) || n.right.match(fields)
+}
+
+type not struct {
+	node node
+}
+
+func (n *not) match(fields Fields) bool {
+	return !n.node.match(fields)
+}
+
+// term matches if the pattern matches any string field.
+type term struct {
+	rx *regexp.Regexp
+}
+
+func (n *term) match(fields Fields) bool {
+	for _, value := range fields {
+		if s, ok := value.(string); ok && n.rx.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type comparison struct {
+	field string
+	kind  Kind
+	op    operator
+
+	text     string
+	rx       *regexp.Regexp
+	number   float64
+	duration time.Duration
+}
+
+func newComparison(field string, kind Kind, op operator, value string) (*comparison, error) {
+	c := &comparison{field: field, kind: kind, op: op, text: value}
+
+	switch kind {
+	case String:
+		switch op {
+		case opMatch:
+			rx, err := compilePattern(value)
+			if err != nil {
+				return nil, err
+			}
+			c.rx = rx
+		case opEqual, opNotEqual:
+		default:
+			return nil, fmt.Errorf("operator %s is not supported for %s", op, field)
+		}
+
+	case Number:
+		if op == opMatch {
+			c.op = opEqual
+		}
+
+		number, ok := toNumber(value)
+		if !ok {
+			return nil, fmt.Errorf("%s expects a number, got %q", field, value)
+		}
+		c.number = number
+
+	case Time:
+		if op == opMatch {
+			return nil, fmt.Errorf("operator %s is not supported for %s", op, field)
+		}
+
+		duration, err := parseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects a duration like 5m or 2d, got %q", field, value)
+		}
+		c.duration = duration
+
+	case Any:
+		switch op {
+		case opMatch:
+			rx, err := compilePattern(value)
+			if err != nil {
+				return nil, err
+			}
+			c.rx = rx
+		case opEqual, opNotEqual:
+			c.number, _ = toNumber(value)
+		default:
+			number, ok := toNumber(value)
+			if !ok {
+				return nil, fmt.Errorf("%s expects a number, got %q", field, value)
+			}
+			c.number = number
+		}
+	}
+
+	return c, nil
+}
+
+func (c *comparison) match(fields Fields) bool {
+	value, ok := fields[c.field]
+	if !ok {
+		return false
+	}
+
+	switch c.kind {
+	case String:
+		s := fmt.Sprint(value)
+		switch c.op {
+		case opMatch:
+			return c.rx.MatchString(s)
+		case opEqual:
+			return strings.EqualFold(s, c.text)
+		case opNotEqual:
+			return !strings.EqualFold(s, c.text)
+		}
+
+	case Number:
+		number, ok := toNumber(value)
+		if !ok {
+			return false
+		}
+
+		return compare(number, c.number, c.op)
+
+	case Time:
+		t, ok := value.(time.Time)
+		if !ok || t.IsZero() {
+			return false
+		}
+
+		return compare(float64(time.Since(t)), float64(c.duration), c.op)
+
+	case Any:
+		return c.matchAny(value)
+	}
+
+	return false
+}
+
+func (c *comparison) matchAny(value interface{}) bool {
+	number, isNumber := toNumber(value)
+	_, wantsNumber := toNumber(c.text)
+
+	switch c.op {
+	case opMatch:
+		return c.rx.MatchString(fmt.Sprint(value))
+	case opEqual, opNotEqual:
+		equal := strings.EqualFold(fmt.Sprint(value), c.text)
+		if isNumber && wantsNumber {
+			equal = number == c.number
+		}
+
+		return equal == (c.op == opEqual)
+	}
+
+	return isNumber && compare(number, c.number, c.op)
+}
+
+func compare(a, b float64, op operator) bool {
+	switch op {
+	case opEqual:
+		return a == b
+	case opNotEqual:
+		return a != b
+	case opGreater:
+		return a > b
+	case opGreaterEqual:
+		return a >= b
+	case opLess:
+		return a < b
+	case opLessEqual:
+		return a <= b
+	}
+
+	return false
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	rx, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+
+	return rx, nil
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/filter"
+)
+
+var schema = filter.Schema{
+	"id":     filter.String,
+	"status": filter.String,
+	"type":   filter.String,
+	"failed": filter.Number,
+	"age":    filter.Time,
+}
+
+func TestQuery_Match(t *testing.T) {
+	rows := map[string]filter.Fields{
+		"web": {
+			"id":     "web",
+			"status": "running",
+			"type":   "service",
+			"failed": 0,
+			"age":    time.Now().Add(-10 * time.Minute),
+		},
+		"batch": {
+			"id":     "batch",
+			"status": "dead",
+			"type":   "batch",
+			"failed": 2,
+			"age":    time.Now().Add(-3 * 24 * time.Hour),
+		},
+		"api": {
+			"id":     "api",
+			"status": "pending",
+			"type":   "service",
+			"failed": 1,
+			"age":    time.Time{},
+		},
+	}
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{query: "", expected: []string{"api", "batch", "web"}},
+		{query: "web", expected: []string{"web"}},
+		{query: "WE.", expected: []string{"web"}},
+		{query: "status:run", expected: []string{"web"}},
+		{query: "status=running", expected: []string{"web"}},
+		{query: "status=run", expected: []string{}},
+		{query: "status!=running", expected: []string{"api", "batch"}},
+		{query: "-status:dead", expected: []string{"api", "web"}},
+		{query: "NOT status:dead", expected: []string{"api", "web"}},
+		{query: "failed>0", expected: []string{"api", "batch"}},
+		{query: "failed>=2", expected: []string{"batch"}},
+		{query: "failed:1", expected: []string{"api"}},
+		{query: "age<1h", expected: []string{"web"}},
+		{query: "age>1d", expected: []string{"batch"}},
+		{query: "age>=2d12h", expected: []string{"batch"}},
+		{query: "type:service failed=0", expected: []string{"web"}},
+		{query: "type:service AND failed=0", expected: []string{"web"}},
+		{query: "status:dead OR status:pending", expected: []string{"api", "batch"}},
+		{query: "status:dead || id:web", expected: []string{"batch", "web"}},
+		{query: "type:service (status:pending OR failed>5)", expected: []string{"api"}},
+		{query: "-(type:batch OR id:api)", expected: []string{"web"}},
+		{query: `id:"we"`, expected: []string{"web"}},
+		{query: "Status=RUNNING", expected: []string{"web"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			r := require.New(t)
+
+			q, err := filter.Parse(tt.query, schema)
+			r.NoError(err)
+
+			matched := []string{}
+			for _, id := range []string{"api", "batch", "web"} {
+				if q.Match(rows[id]) {
+					matched = append(matched, id)
+				}
+			}
+
+			r.Equal(tt.expected, matched)
+		})
+	}
+}
+
+func TestParse_UnknownField(t *testing.T) {
+	r := require.New(t)
+
+	fields := filter.Fields{"id": "cache", "image": "redis:7"}
+
+	// It searches for text with an unknown field as it is
+	for _, query := range []string{"redis:7", "dis:7", "-foo:bar"} {
+		q, err := filter.Parse(query, schema)
+		r.NoError(err, query)
+		r.True(q.Match(fields), query)
+	}
+
+	q, err := filter.Parse("redis:8", schema)
+	r.NoError(err)
+	r.False(q.Match(fields))
+}
+
+func TestParse_Sad(t *testing.T) {
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{query: "status:", err: "missing value for status"},
+		{query: "status>running", err: "operator > is not supported for status"},
+		{query: "failed>many", err: `failed expects a number, got "many"`},
+		{query: "age<soon", err: `age expects a duration like 5m or 2d, got "soon"`},
+		{query: "age:1h", err: "operator : is not supported for age"},
+		{query: "web(", err: "incomplete expression"},
+		{query: "(web", err: `missing ")"`},
+		{query: "web)", err: `unexpected ")"`},
+		{query: "web AND", err: "incomplete expression"},
+		{query: "OR web", err: `unexpected "OR"`},
+		{query: `id:"web`, err: "missing closing quote"},
+		{query: "status:[", err: `invalid pattern "["`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			r := require.New(t)
+
+			q, err := filter.Parse(tt.query, schema)
+			r.Nil(q)
+			r.EqualError(err, tt.err)
+		})
+	}
+}
+
+func TestParse_WithoutSchema(t *testing.T) {
+	row := filter.Fields{
+		"level":     "error",
+		"user_id":   float64(42),
+		"http.path": "/api/jobs",
+		"ok":        false,
+	}
+
+	tests := []struct {
+		query    string
+		expected bool
+	}{
+		{query: "level=error", expected: true},
+		{query: "level=ERROR", expected: true},
+		{query: "level!=error", expected: false},
+		{query: "user_id=42", expected: true},
+		{query: "user_id=42.0", expected: true},
+		{query: "user_id>40 user_id<=42", expected: true},
+		{query: "user_id>42", expected: false},
+		{query: "http.path:^/api", expected: true},
+		{query: "ok=false", expected: true},
+		{query: "missing=1", expected: false},
+		{query: "level>1", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			r := require.New(t)
+
+			q, err := filter.Parse(tt.query, nil)
+			r.NoError(err)
+			r.Equal(tt.expected, q.Match(row))
+		})
+	}
+
+	t.Run("It requires numbers for ordering", func(t *testing.T) {
+		r := require.New(t)
+
+		_, err := filter.Parse("user_id>many", nil)
+		r.EqualError(err, `user_id expects a number, got "many"`)
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var rxComparison = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_.]*)(!=|>=|<=|:|=|>|<)(.*)$`)
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenOpen
+	tokenClose
+	tokenEOF
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func (t token) is(keywords ...string) bool {
+	if t.kind != tokenWord {
+		return false
+	}
+
+	for _, k := range keywords {
+		if t.text == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lex splits the query into words and parentheses.
+// Spaces and parentheses inside quotes belong to the word.
+func lex(query string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenOpen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenClose, text: ")"})
+			i++
+		default:
+			var quoted bool
+			start := i
+			for ; i < len(runes); i++ {
+				r := runes[i]
+				if r == '"' {
+					quoted = !quoted
+					continue
+				}
+
+				if !quoted && (unicode.IsSpace(r) || r == '(' || r == ')') {
+					break
+				}
+			}
+
+			if quoted {
+				return nil, errors.New("missing closing quote")
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:i])})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	schema Schema
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *parser) parse() (node, error) {
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+
+	return n, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().is("OR", "||") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &or{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		if t.kind == tokenEOF || t.kind == tokenClose || t.is("OR", "||") {
+			return left, nil
+		}
+
+		if t.is("AND", "&&") {
+			p.next()
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &and{left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	t := p.next()
+
+	switch {
+	case t.kind == tokenEOF:
+		return nil, errors.New("incomplete expression")
+
+	case t.kind == tokenClose:
+		return nil, errors.New(`unexpected ")"`)
+
+	case t.kind == tokenOpen:
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next().kind != tokenClose {
+			return nil, errors.New(`missing ")"`)
+		}
+
+		return n, nil
+
+	case t.is("NOT", "-", "!"):
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &not{node: n}, nil
+
+	case t.is("AND", "&&", "OR", "||"):
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+
+	return p.parseTerm(t.text)
+}
+
+func (p *parser) parseTerm(text string) (node, error) {
+	if len(text) > 1 && (text[0] == '-' || text[0] == '!') {
+		n, err := p.parseTerm(text[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		return &not{node: n}, nil
+	}
+
+	// text which doesn't start with a known field, such as
+	// redis:7 or host:port, is searched for as it is.
+	match := rxComparison.FindStringSubmatch(text)
+	if match != nil && p.schema != nil {
+		if _, ok := p.schema[strings.ToLower(match[1])]; !ok {
+			match = nil
+		}
+	}
+
+	if match == nil {
+		rx, err := compilePattern(unquote(text))
+		if err != nil {
+			return nil, err
+		}
+
+		return &term{rx: rx}, nil
+	}
+
+	field := strings.ToLower(match[1])
+	op := operator(match[2])
+	value := unquote(match[3])
+
+	kind := p.schema[field]
+
+	if p.schema == nil {
+		kind = Any
+	}
+
+	if value == "" {
+		return nil, fmt.Errorf("missing value for %s", field)
+	}
+
+	return newComparison(field, kind, op, value)
+}
+
+func unquote(s string) string {
+	return strings.ReplaceAll(s, `"`, "")
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// parseDuration parses durations like time.ParseDuration
+// and additionally supports days, e.g. 2d or 1d12h.
+func parseDuration(s string) (time.Duration, error) {
+	if i := strings.Index(s, "d"); i > 0 {
+		days, err := strconv.ParseFloat(s[:i], 64)
+		if err != nil {
+			return 0, err
+		}
+
+		var rest time.Duration
+		if s[i+1:] != "" {
+			rest, err = time.ParseDuration(s[i+1:])
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		return time.Duration(days*float64(24*time.Hour)) + rest, nil
+	}
+
+	return time.ParseDuration(s)
+}
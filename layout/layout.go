@@ -10,17 +10,38 @@ import (
 const NameMainPage = "main"
 const NameErrorPage = "error"
 
+// SplitMode defines how the Body and the Detail panel
+// share the available space.
+type SplitMode string
+
+const (
+	SplitOff        SplitMode = "off"
+	SplitSideBySide SplitMode = "side"
+	SplitStacked    SplitMode = "stacked"
+
+	// SplitRatioDefault is the share (out of SplitRatioTotal)
+	// the Body gets when the Detail panel is visible.
+	SplitRatioDefault = 6
+	SplitRatioTotal   = 10
+	SplitRatioMin     = 2
+	SplitRatioMax     = 8
+)
+
 type Layout struct {
 	Container *tview.Application
 
 	Pages    *tview.Pages
 	MainPage *tview.Flex
 
-	Header *Header
-	Body   *tview.Flex
-	Footer *tview.Flex
+	Header  *Header
+	Content *tview.Flex
+	Body    *tview.Flex
+	Detail  *tview.Flex
+	Footer  *tview.Flex
 
 	Elements *Elements
+
+	Split *Split
 }
 
 type Elements struct {
@@ -34,6 +55,15 @@ type Header struct {
 	SlotLogo *tview.Flex
 }
 
+// Split holds the configuration of the split pane. The Detail
+// panel is only shown when the mode is not SplitOff and the
+// current view provides a detail (Active).
+type Split struct {
+	Mode   SplitMode
+	Ratio  int
+	Active bool
+}
+
 func EnableMouse(l *Layout) {
 	l.Container.EnableMouse(true)
 }
@@ -51,6 +81,10 @@ func New(options ...func(*Layout)) *Layout {
 func Default(l *Layout) {
 	l.Header = &Header{}
 	l.Elements = &Elements{}
+	l.Split = &Split{
+		Mode:  SplitOff,
+		Ratio: SplitRatioDefault,
+	}
 
 	l.Elements.ClusterInfo = tview.NewFlex()
 	l.Elements.Dropdowns = tview.NewFlex().SetDirection(tview.FlexRow)
@@ -71,17 +105,24 @@ func Default(l *Layout) {
 
 	footer := tview.NewFlex()
 	body := tview.NewFlex()
+	detail := tview.NewFlex()
+
+	content := tview.NewFlex().
+		AddItem(body, 0, 1, false).
+		AddItem(detail, 0, 0, false)
 
 	mainPage := tview.NewFlex().SetDirection(tview.FlexRow)
 	mainPage.
 		AddItem(header, 0, 4, false).
-		AddItem(body, 0, 12, false).
+		AddItem(content, 0, 12, false).
 		AddItem(footer, 0, 0, false)
 
 	pages := tview.NewPages()
 	pages.AddPage(NameMainPage, mainPage, true, true)
 
+	l.Content = content
 	l.Body = body
+	l.Detail = detail
 	l.Footer = footer
 
 	l.MainPage = mainPage
@@ -92,3 +133,80 @@ func Default(l *Layout) {
 		SetFocus(pages)
 
 }
+
+// SetSplit sets the split mode and the share of the Body.
+// The ratio is clamped to SplitRatioMin and SplitRatioMax.
+func (l *Layout) SetSplit(mode SplitMode, ratio int) {
+	switch mode {
+	case SplitSideBySide, SplitStacked:
+		l.Split.Mode = mode
+	default:
+		l.Split.Mode = SplitOff
+	}
+
+	l.Split.Ratio = clampRatio(ratio)
+	l.applySplit()
+}
+
+// CycleSplit switches to the next split mode:
+// off -> side by side -> stacked -> off.
+func (l *Layout) CycleSplit() {
+	switch l.Split.Mode {
+	case SplitOff:
+		l.Split.Mode = SplitSideBySide
+	case SplitSideBySide:
+		l.Split.Mode = SplitStacked
+	default:
+		l.Split.Mode = SplitOff
+	}
+
+	l.applySplit()
+}
+
+// ResizeSplit grows (positive delta) or shrinks (negative delta)
+// the Body in favour of the Detail panel.
+func (l *Layout) ResizeSplit(delta int) {
+	l.Split.Ratio = clampRatio(l.Split.Ratio + delta)
+	l.applySplit()
+}
+
+// ActivateDetail marks whether the current view renders into the
+// Detail panel. Views without a detail hide the panel.
+func (l *Layout) ActivateDetail(active bool) {
+	l.Split.Active = active
+	l.applySplit()
+}
+
+// DetailVisible returns true if the Detail panel is currently shown.
+func (l *Layout) DetailVisible() bool {
+	return l.Split.Active && l.Split.Mode != SplitOff
+}
+
+func (l *Layout) applySplit() {
+	if !l.DetailVisible() {
+		l.Content.ResizeItem(l.Body, 0, 1)
+		l.Content.ResizeItem(l.Detail, 0, 0)
+		return
+	}
+
+	direction := tview.FlexColumn
+	if l.Split.Mode == SplitStacked {
+		direction = tview.FlexRow
+	}
+
+	l.Content.SetDirection(direction)
+	l.Content.ResizeItem(l.Body, 0, l.Split.Ratio)
+	l.Content.ResizeItem(l.Detail, 0, SplitRatioTotal-l.Split.Ratio)
+}
+
+func clampRatio(ratio int) int {
+	if ratio < SplitRatioMin {
+		return SplitRatioMin
+	}
+
+	if ratio > SplitRatioMax {
+		return SplitRatioMax
+	}
+
+	return ratio
+}
@@ -45,9 +45,73 @@ func TestDefaultLayout(t *testing.T) {
 	r.NotNil(l.Body)
 	r.IsType(l.Body, &tview.Flex{})
 
+	r.NotNil(l.Detail)
+	r.IsType(l.Detail, &tview.Flex{})
+
+	r.NotNil(l.Content)
+	r.IsType(l.Content, &tview.Flex{})
+	r.Equal(l.Content.GetItemCount(), 2)
+	r.Equal(l.Content.GetItem(0), l.Body)
+	r.Equal(l.Content.GetItem(1), l.Detail)
+
+	r.NotNil(l.Split)
+	r.Equal(l.Split.Mode, layout.SplitOff)
+	r.Equal(l.Split.Ratio, layout.SplitRatioDefault)
+	r.False(l.DetailVisible())
+
 	r.NotNil(l.Footer)
 	r.IsType(l.Footer, &tview.Flex{})
 
 	r.NotNil(l.MainPage)
 	r.IsType(l.MainPage, &tview.Flex{})
 }
+
+func TestLayout_Split(t *testing.T) {
+	r := require.New(t)
+
+	t.Run("It only shows the detail when the view provides one", func(t *testing.T) {
+		l := layout.New(layout.Default)
+
+		l.SetSplit(layout.SplitSideBySide, 5)
+		r.False(l.DetailVisible())
+
+		l.ActivateDetail(true)
+		r.True(l.DetailVisible())
+
+		l.ActivateDetail(false)
+		r.False(l.DetailVisible())
+	})
+
+	t.Run("It falls back to off for unknown modes", func(t *testing.T) {
+		l := layout.New(layout.Default)
+
+		l.SetSplit(layout.SplitMode("diagonal"), 5)
+		r.Equal(l.Split.Mode, layout.SplitOff)
+	})
+
+	t.Run("It cycles through the split modes", func(t *testing.T) {
+		l := layout.New(layout.Default)
+
+		l.CycleSplit()
+		r.Equal(l.Split.Mode, layout.SplitSideBySide)
+
+		l.CycleSplit()
+		r.Equal(l.Split.Mode, layout.SplitStacked)
+
+		l.CycleSplit()
+		r.Equal(l.Split.Mode, layout.SplitOff)
+	})
+
+	t.Run("It clamps the ratio when resizing", func(t *testing.T) {
+		l := layout.New(layout.Default)
+
+		l.SetSplit(layout.SplitStacked, 100)
+		r.Equal(l.Split.Ratio, layout.SplitRatioMax)
+
+		l.ResizeSplit(-1)
+		r.Equal(l.Split.Ratio, layout.SplitRatioMax-1)
+
+		l.ResizeSplit(-100)
+		r.Equal(l.Split.Ratio, layout.SplitRatioMin)
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+// Package logbuffer holds log lines in a bounded ring buffer,
+// such that high volume log streams use a constant amount of
+// memory and can be rendered incrementally.
+package logbuffer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// DefaultLimit is the number of lines a buffer keeps by default.
+const DefaultLimit = 1000
+
+// Buffer is a bounded ring buffer of log lines. When the buffer
+// is full, appending a line drops the oldest line. Every appended
+// line gets a sequence number, such that readers can fetch the
+// lines they haven't seen yet.
+type Buffer struct {
+	mutex sync.RWMutex
+
+	lines []*models.LogLine
+	head  int
+	size  int
+
+	// seq is the sequence number of the last line. It keeps
+	// increasing across resets, which advance it by one, such
+	// that readers of the lines before a reset notice it.
+	seq uint64
+
+	// reset is the sequence number of the last reset.
+	reset uint64
+}
+
+// New returns a buffer which keeps up to limit lines.
+func New(limit int) *Buffer {
+	if limit < 1 {
+		limit = DefaultLimit
+	}
+
+	return &Buffer{lines: make([]*models.LogLine, limit)}
+}
+
+// Limit returns the number of lines the buffer keeps.
+func (b *Buffer) Limit() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return len(b.lines)
+}
+
+// Len returns the number of lines in the buffer.
+func (b *Buffer) Len() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.size
+}
+
+// Append adds lines to the buffer.
+func (b *Buffer) Append(lines ...*models.LogLine) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	limit := len(b.lines)
+	for _, l := range lines {
+		b.lines[(b.head+b.size)%limit] = l
+		if b.size < limit {
+			b.size++
+		} else {
+			b.head = (b.head + 1) % limit
+		}
+
+		b.seq++
+	}
+}
+
+// Lines returns all lines in the buffer, oldest first, and
+// the sequence number of the last line.
+func (b *Buffer) Lines() ([]*models.LogLine, uint64) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.last(b.size), b.seq
+}
+
+// Since returns the lines which were appended after the line with
+// the sequence number seq and the sequence number of the last line.
+// If lines after seq were dropped already, or the buffer was reset,
+// ok is false and the caller has to start over using Lines.
+func (b *Buffer) Since(seq uint64) (lines []*models.LogLine, last uint64, ok bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if seq < b.reset || seq > b.seq || b.seq-seq > uint64(b.size) {
+		return nil, b.seq, false
+	}
+
+	return b.last(int(b.seq - seq)), b.seq, true
+}
+
+// WriteTo writes the lines of the buffer as plain text. Lines of
+// a merged stream are prefixed with their alloc ID, task and source.
+func (b *Buffer) WriteTo(w io.Writer) (int64, error) {
+	lines, _ := b.Lines()
+
+	var written int64
+	for _, l := range lines {
+		var n int
+		var err error
+		if l.AllocID == "" {
+			n, err = fmt.Fprintf(w, "%s\n", l.Text)
+		} else {
+			n, err = fmt.Fprintf(w, "%s/%s %s: %s\n", l.AllocID, l.Task, l.Source, l.Text)
+		}
+
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// Reset removes all lines from the buffer.
+func (b *Buffer) Reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for i := range b.lines {
+		b.lines[i] = nil
+	}
+
+	b.head = 0
+	b.size = 0
+	b.seq++
+	b.reset = b.seq
+}
+
+// last returns the newest n lines, oldest first.
+func (b *Buffer) last(n int) []*models.LogLine {
+	limit := len(b.lines)
+	result := make([]*models.LogLine, 0, n)
+	for i := b.size - n; i < b.size; i++ {
+		result = append(result, b.lines[(b.head+i)%limit])
+	}
+
+	return result
+}
+
+// Splitter splits a stream of frames into lines. Partial lines at
+// the end of a frame are kept until the next frame completes them.
+type Splitter struct {
+	partial []byte
+}
+
+// Split returns the complete lines of the data, without
+// the trailing newline.
+func (s *Splitter) Split(data []byte) []string {
+	data = append(s.partial, data...)
+
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		s.partial = data
+		return nil
+	}
+
+	s.partial = append([]byte{}, data[end+1:]...)
+
+	lines := []string{}
+	for _, l := range bytes.Split(data[:end], []byte("\n")) {
+		lines = append(lines, string(bytes.TrimSuffix(l, []byte("\r"))))
+	}
+
+	return lines
+}
+
+// Partial returns the incomplete line which is kept.
+func (s *Splitter) Partial() string {
+	return string(s.partial)
+}
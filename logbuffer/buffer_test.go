@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package logbuffer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/logbuffer"
+	"github.com/hcjulz/damon/models"
+)
+
+func line(text string) *models.LogLine {
+	return &models.LogLine{Text: text}
+}
+
+func texts(lines []*models.LogLine) []string {
+	result := []string{}
+	for _, l := range lines {
+		result = append(result, l.Text)
+	}
+
+	return result
+}
+
+func TestBuffer(t *testing.T) {
+	r := require.New(t)
+
+	b := logbuffer.New(3)
+	r.Equal(3, b.Limit())
+	r.Equal(0, b.Len())
+
+	// It keeps appended lines in order
+	b.Append(line("one"), line("two"))
+	lines, seq := b.Lines()
+	r.Equal([]string{"one", "two"}, texts(lines))
+	r.Equal(uint64(2), seq)
+
+	// It drops the oldest lines when it is full
+	b.Append(line("three"), line("four"))
+	lines, seq = b.Lines()
+	r.Equal([]string{"two", "three", "four"}, texts(lines))
+	r.Equal(uint64(4), seq)
+	r.Equal(3, b.Len())
+
+	// It returns the lines appended since a sequence number
+	b.Append(line("five"))
+	lines, seq, ok := b.Since(4)
+	r.True(ok)
+	r.Equal([]string{"five"}, texts(lines))
+	r.Equal(uint64(5), seq)
+
+	lines, _, ok = b.Since(5)
+	r.True(ok)
+	r.Empty(lines)
+
+	// It reports when lines since the sequence number were dropped
+	_, _, ok = b.Since(1)
+	r.False(ok)
+
+	// It starts over after a reset
+	b.Reset()
+	r.Equal(0, b.Len())
+
+	_, _, ok = b.Since(5)
+	r.False(ok)
+
+	b.Append(line("six"))
+	lines, seq = b.Lines()
+	r.Equal([]string{"six"}, texts(lines))
+	r.Equal(uint64(7), seq)
+
+	// It doesn't continue from a sequence number
+	// from before the reset once it is refilled
+	_, _, ok = b.Since(5)
+	r.False(ok)
+
+	lines, _, ok = b.Since(6)
+	r.True(ok)
+	r.Equal([]string{"six"}, texts(lines))
+}
+
+func TestBuffer_WriteTo(t *testing.T) {
+	r := require.New(t)
+
+	b := logbuffer.New(10)
+	b.Append(
+		line("single stream"),
+		&models.LogLine{AllocID: "alloc-1", Task: "web", Source: "stderr", Text: "merged stream"},
+	)
+
+	out := bytes.Buffer{}
+	n, err := b.WriteTo(&out)
+	r.NoError(err)
+	r.Equal(int64(out.Len()), n)
+	r.Equal("single stream\nalloc-1/web stderr: merged stream\n", out.String())
+}
+
+func TestNew_DefaultLimit(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(logbuffer.DefaultLimit, logbuffer.New(0).Limit())
+}
+
+func TestSplitter(t *testing.T) {
+	r := require.New(t)
+
+	s := logbuffer.Splitter{}
+
+	// It returns complete lines only
+	r.Equal([]string{"first line"}, s.Split([]byte("first line\nsecond ")))
+	r.Equal("second ", s.Partial())
+
+	// It keeps partial lines across frames
+	r.Nil(s.Split([]byte("line")))
+	r.Equal([]string{"second line", "third line"}, s.Split([]byte("\r\nthird line\n")))
+	r.Equal("", s.Partial())
+
+	// It keeps empty lines
+	r.Equal([]string{"", ""}, s.Split([]byte("\n\n")))
+}
@@ -4,6 +4,9 @@
 package models
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
@@ -18,10 +21,27 @@ const (
 	HandleFatal Handler = Handler("Fatal")
 	HandleInfo  Handler = Handler("Info")
 
-	TopicNamespace api.Topic = api.Topic("Namespace")
-	TopicTaskGroup api.Topic = api.Topic("TaskGroup")
-	TopicJobStatus api.Topic = api.Topic("JobStatus")
-	TopicLog       api.Topic = api.Topic("Log")
+	TopicNamespace        api.Topic = api.Topic("Namespace")
+	TopicTaskGroup        api.Topic = api.Topic("TaskGroup")
+	TopicJobStatus        api.Topic = api.Topic("JobStatus")
+	TopicLog              api.Topic = api.Topic("Log")
+	TopicAllocStats       api.Topic = api.Topic("AllocStats")
+	TopicAllocDetail      api.Topic = api.Topic("AllocDetail")
+	TopicVariable         api.Topic = api.Topic("Variable")
+	TopicService          api.Topic = api.Topic("Service")
+	TopicServiceInstance  api.Topic = api.Topic("ServiceInstance")
+	TopicVolume           api.Topic = api.Topic("Volume")
+	TopicVolumeClaim      api.Topic = api.Topic("VolumeClaim")
+	TopicACL              api.Topic = api.Topic("ACL")
+	TopicCluster          api.Topic = api.Topic("Cluster")
+	TopicNode             api.Topic = api.Topic("Node")
+	TopicEvaluation       api.Topic = api.Topic("Evaluation")
+	TopicServer           api.Topic = api.Topic("Server")
+	TopicNodeDetail       api.Topic = api.Topic("NodeDetail")
+	TopicNodePool         api.Topic = api.Topic("NodePool")
+	TopicJobVersion       api.Topic = api.Topic("JobVersion")
+	TopicDeploymentDetail api.Topic = api.Topic("DeploymentDetail")
+	TopicJobSpec          api.Topic = api.Topic("JobSpec")
 )
 
 type Job struct {
@@ -50,6 +70,10 @@ type JobStatus struct {
 	TaskGroups        []*TaskGroup
 	TaskGroupStatus   []*TaskGroupStatus
 	Allocations       []*Alloc
+
+	// TaskGroupCounts holds the count of each
+	// task group as it is set in the job spec.
+	TaskGroupCounts map[string]int
 }
 
 type Summary struct {
@@ -70,6 +94,8 @@ type TaskGroup struct {
 
 type TaskGroupStatus struct {
 	ID                string
+	DeploymentID      string
+	TaskGroup         string
 	Desired           int
 	Placed            int
 	Healthy           int
@@ -100,22 +126,69 @@ type Alloc struct {
 	Modified      time.Time
 }
 
+// AllocDetail holds the details of an allocation which aren't
+// part of the allocation list: its reschedule history, deployment
+// health, ports and the metrics of its placement.
+type AllocDetail struct {
+	ID                 string
+	Name               string
+	Namespace          string
+	JobID              string
+	JobVersion         uint64
+	TaskGroup          string
+	NodeID             string
+	NodeName           string
+	DesiredStatus      string
+	DesiredDescription string
+	Status             string
+	StatusDescription  string
+	Created            time.Time
+	Modified           time.Time
+
+	PreviousAllocation string
+	NextAllocation     string
+	Reschedules        []*api.RescheduleEvent
+
+	DeploymentID     string
+	DeploymentStatus *api.AllocDeploymentStatus
+
+	Ports   []api.PortMapping
+	Metrics *api.AllocationMetric
+}
+
 type AllocTask struct {
 	Name   string
 	Events []*api.TaskEvent
 }
 
 type Task struct {
-	Name     string
-	Driver   string
-	State    string
-	Events   []*api.TaskEvent
-	Config   map[string]interface{}
-	Env      map[string]string
-	Image    string
-	CPU      int
-	MemoryMB int
-	DiskMB   int
+	Name          string
+	Driver        string
+	State         string
+	Events        []*api.TaskEvent
+	Config        map[string]interface{}
+	Env           map[string]string
+	Image         string
+	CPU           int
+	MemoryMB      int
+	MemoryMaxMB   int
+	DiskMB        int
+	Lifecycle     *api.TaskLifecycle
+	RestartPolicy *api.RestartPolicy
+	Artifacts     []*api.TaskArtifact
+	Templates     []*api.Template
+	VolumeMounts  []*api.VolumeMount
+
+	// Allocated holds the resources the scheduler allocated
+	// to the task, nil if they aren't known.
+	Allocated *TaskResources
+}
+
+// TaskResources are the resources allocated to a task.
+type TaskResources struct {
+	CPU         int64
+	MemoryMB    int64
+	MemoryMaxMB int64
 }
 
 type Namespace struct {
@@ -131,9 +204,446 @@ type Deployment struct {
 	StatusDescription string
 }
 
+// DeploymentDetail is a deployment with the
+// state of each task group it deploys.
+type DeploymentDetail struct {
+	ID                string
+	JobID             string
+	JobVersion        uint64
+	Namespace         string
+	Status            string
+	StatusDescription string
+	TaskGroups        []*DeploymentTaskGroup
+}
+
+// DeploymentTaskGroup is the state of a task group in a deployment.
+type DeploymentTaskGroup struct {
+	Name              string
+	Desired           int
+	Placed            int
+	Healthy           int
+	Unhealthy         int
+	DesiredCanaries   int
+	PlacedCanaries    int
+	Promoted          bool
+	AutoRevert        bool
+	ProgressDeadline  time.Duration
+	RequireProgressBy time.Time
+}
+
+// JobVersion is a version of a job. Changes describes
+// what changed compared to the previous version.
+type JobVersion struct {
+	Version    uint64
+	Stable     bool
+	SubmitTime time.Time
+	Changes    []string
+}
+
+// The formats of a job spec. The source of a job is
+// in the format it was submitted in, e.g. HCL.
+const (
+	JobSpecFormatHCL1 = "hcl1"
+	JobSpecFormatHCL2 = "hcl2"
+	JobSpecFormatJSON = "json"
+)
+
+// JobSpec is the definition of a job. Source is the job as it was
+// submitted, if Nomad still has it, JSON is the job as Nomad runs it.
+type JobSpec struct {
+	ID        string
+	Namespace string
+	Version   uint64
+	Format    string
+	Source    string
+	JSON      string
+}
+
 type SearchResult struct {
 }
 
+// LogScope selects the tasks whose logs are merged into one
+// stream: all tasks of an allocation, or of every allocation
+// of a job or a task group of a job.
+type LogScope struct {
+	AllocID   string
+	JobID     string
+	TaskGroup string
+}
+
+// The origins a log stream can start from.
+const (
+	LogOriginStart = "start"
+	LogOriginEnd   = "end"
+)
+
+// LogStart is the position a log stream starts at. Offset is the
+// number of bytes from the origin. If Lines is set, the stream
+// starts with the last Lines lines of the log instead.
+type LogStart struct {
+	Origin string
+	Offset int64
+	Lines  int
+}
+
+const ErrInvalidLogStart = Sentinel("expected start, end, +bytes, -bytes or a number of lines")
+
+// ParseLogStart parses where log streams start: at the start or end of
+// the log, at a byte offset from the start (+1024) or the end (-1024),
+// or with the last number of lines (200). The end is returned as nil.
+func ParseLogStart(text string) (*LogStart, error) {
+	text = strings.TrimSpace(text)
+
+	switch text {
+	case "", LogOriginEnd:
+		return nil, nil
+	case LogOriginStart:
+		return &LogStart{Origin: LogOriginStart}, nil
+	}
+
+	origin := ""
+	if rest, ok := strings.CutPrefix(text, "+"); ok {
+		origin, text = LogOriginStart, rest
+	} else if rest, ok := strings.CutPrefix(text, "-"); ok {
+		origin, text = LogOriginEnd, rest
+	}
+
+	// the sign was cut already, any other one is invalid
+	if text == "" || text[0] < '0' || text[0] > '9' {
+		return nil, ErrInvalidLogStart
+	}
+
+	n, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidLogStart
+	}
+
+	if origin != "" {
+		return &LogStart{Origin: origin, Offset: n}, nil
+	}
+
+	if n == 0 {
+		return nil, ErrInvalidLogStart
+	}
+
+	return &LogStart{Lines: int(n)}, nil
+}
+
+// FormatLogStart is the inverse of ParseLogStart.
+func FormatLogStart(start *LogStart) string {
+	switch {
+	case start == nil:
+		return LogOriginEnd
+	case start.Lines > 0:
+		return strconv.Itoa(start.Lines)
+	case start.Origin == LogOriginStart && start.Offset == 0:
+		return LogOriginStart
+	case start.Origin == LogOriginStart:
+		return fmt.Sprintf("+%d", start.Offset)
+	}
+
+	return fmt.Sprintf("-%d", start.Offset)
+}
+
+// LogLine is a line of a log stream. Lines of a single
+// stream leave the AllocID empty.
+type LogLine struct {
+	AllocID string
+	Task    string
+	Source  string
+	Text    string
+}
+
+// AllocFile is a file or directory in the filesystem of an
+// allocation. Path is relative to the root of the allocation
+// directory.
+type AllocFile struct {
+	Name     string
+	Path     string
+	IsDir    bool
+	Size     int64
+	Mode     string
+	Modified time.Time
+}
+
+// ResourceUsage is a sample of the CPU (in MHz) and
+// memory (in bytes) used by an allocation or a task.
+type ResourceUsage struct {
+	Time        time.Time
+	CPU         float64
+	MemoryBytes uint64
+}
+
+// AllocUsage is a sample of the resource usage of an
+// allocation and each of its tasks.
+type AllocUsage struct {
+	ResourceUsage
+	Tasks map[string]ResourceUsage
+}
+
+// AllocStats is the recent resource usage of an allocation
+// and each of its tasks, oldest sample first.
+type AllocStats struct {
+	Usage []ResourceUsage
+	Tasks map[string][]ResourceUsage
+}
+
+// Variable is a Nomad variable. Variables which
+// are listed don't carry their items.
+type Variable struct {
+	Namespace   string
+	Path        string
+	Items       map[string]string
+	CreateIndex uint64
+	ModifyIndex uint64
+	Modified    time.Time
+}
+
+// Service is a service registered with the Nomad service
+// provider, with the tags of all its instances.
+type Service struct {
+	Name      string
+	Namespace string
+	Tags      []string
+}
+
+// ServiceInstance is the registration of a service by an
+// allocation, with the results of the health checks of the
+// service in the allocation. CheckError is set if the checks
+// couldn't be read from the client.
+type ServiceInstance struct {
+	ID          string
+	ServiceName string
+	Namespace   string
+	NodeID      string
+	Datacenter  string
+	JobID       string
+	AllocID     string
+	Tags        []string
+	Address     string
+	Port        int
+
+	Checks     []*ServiceCheck
+	CheckError string
+}
+
+// ServiceCheck is the latest result of a
+// health check of a service instance.
+type ServiceCheck struct {
+	Name       string
+	Task       string
+	Mode       string
+	Status     string
+	StatusCode int
+	Output     string
+	Time       time.Time
+}
+
+// Volume is a CSI volume with the health of its plugin. The
+// claims of the allocations using it are only set when the
+// volume is read on its own.
+type Volume struct {
+	ID             string
+	Name           string
+	Namespace      string
+	ExternalID     string
+	PluginID       string
+	Provider       string
+	AccessMode     string
+	AttachmentMode string
+	Schedulable    bool
+	Readers        int
+	Writers        int
+
+	ControllerRequired  bool
+	ControllersHealthy  int
+	ControllersExpected int
+	NodesHealthy        int
+	NodesExpected       int
+
+	Claims []*VolumeClaim
+}
+
+// VolumeClaim is a read or write claim of an allocation on a volume.
+type VolumeClaim struct {
+	AllocID       string
+	AllocName     string
+	JobID         string
+	NodeID        string
+	NodeName      string
+	Mode          string
+	DesiredStatus string
+	ClientStatus  string
+}
+
+// CSIPlugin is a CSI plugin with the health of
+// its controller and node instances.
+type CSIPlugin struct {
+	ID                  string
+	Provider            string
+	ControllerRequired  bool
+	ControllersHealthy  int
+	ControllersExpected int
+	NodesHealthy        int
+	NodesExpected       int
+}
+
+// Cluster is the state of the servers and clients of the
+// cluster. Errors maps the parts which couldn't be read,
+// e.g. for lack of permissions, to the reason.
+type Cluster struct {
+	Leader       string
+	Region       string
+	Servers      []*ServerMember
+	RaftPeers    []*RaftPeer
+	Autopilot    *AutopilotHealth
+	Nodes        []*Node
+	BlockedEvals []*Evaluation
+
+	Errors map[string]string
+}
+
+// ServerMember is a Nomad server as seen by the gossip pool.
+type ServerMember struct {
+	Name       string
+	Address    string
+	Port       int
+	Status     string
+	Version    string
+	Region     string
+	Datacenter string
+	Leader     bool
+}
+
+// RaftPeer is a server of the Raft configuration.
+type RaftPeer struct {
+	ID           string
+	Node         string
+	Address      string
+	Leader       bool
+	Voter        bool
+	RaftProtocol string
+}
+
+// AutopilotHealth is the health of the servers as
+// reported by autopilot.
+type AutopilotHealth struct {
+	Healthy          bool
+	FailureTolerance int
+	Servers          []*ServerHealth
+}
+
+// ServerHealth is the autopilot health of a server. LastContact
+// is the time since the server heard from the leader and LastIndex
+// the index of the last Raft log entry it applied.
+type ServerHealth struct {
+	ID          string
+	Name        string
+	Address     string
+	SerfStatus  string
+	Version     string
+	Leader      bool
+	Voter       bool
+	Healthy     bool
+	LastContact time.Duration
+	LastTerm    uint64
+	LastIndex   uint64
+	StableSince time.Time
+}
+
+// Node is a Nomad client.
+type Node struct {
+	ID                string
+	Name              string
+	Address           string
+	Datacenter        string
+	NodeClass         string
+	NodePool          string
+	Version           string
+	Status            string
+	StatusDescription string
+	Eligibility       string
+	Drain             bool
+}
+
+// NodeDetail holds the details of a client which aren't part
+// of the client list: its attributes, drivers, host volumes and
+// resources, the events of the client and its allocations.
+type NodeDetail struct {
+	Node
+
+	HTTPAddress string
+	Attributes  map[string]string
+	Meta        map[string]string
+	Drivers     []*NodeDriver
+	HostVolumes []*NodeHostVolume
+	Events      []*api.NodeEvent
+
+	// Total are the resources of the client, Reserved the
+	// part of them which the scheduler doesn't give to tasks.
+	Total    NodeResources
+	Reserved NodeResources
+
+	Allocations []*Alloc
+}
+
+// NodeDriver is a task driver of a client.
+type NodeDriver struct {
+	Name              string
+	Detected          bool
+	Healthy           bool
+	HealthDescription string
+	Updated           time.Time
+}
+
+// NodeHostVolume is a host volume of a client.
+type NodeHostVolume struct {
+	Name     string
+	Path     string
+	ReadOnly bool
+}
+
+type NodeResources struct {
+	CPU      int64
+	MemoryMB int64
+	DiskMB   int64
+}
+
+// NodePool groups the clients jobs can be placed on.
+type NodePool struct {
+	Name               string
+	Description        string
+	SchedulerAlgorithm string
+	Meta               map[string]string
+}
+
+// Evaluation is an evaluation of the scheduler.
+type Evaluation struct {
+	ID                string
+	Namespace         string
+	JobID             string
+	Type              string
+	Priority          int
+	TriggeredBy       string
+	Status            string
+	StatusDescription string
+	NodeID            string
+	DeploymentID      string
+	Created           time.Time
+}
+
+// Bookmark is a saved view with its namespace and filter.
+// Key is the number key (1-9) bound to the bookmark, 0 if
+// it isn't bound to a key.
+type Bookmark struct {
+	Name      string `json:"name"`
+	View      string `json:"view"`
+	JobID     string `json:"job_id,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Filter    string `json:"filter,omitempty"`
+	Key       int    `json:"key,omitempty"`
+}
+
 type Status string
 
 const (
@@ -144,11 +654,205 @@ const (
 	StatusDead        = "dead"
 	StatusFailed      = "failed"
 	StatusSuccessful  = "successful"
+	StatusLost        = "lost"
 
 	TypeBatch   = "batch"
 	TypeService = "service"
+
+	CheckStatusSuccess = "success"
+	CheckStatusFailure = "failure"
+	CheckStatusPending = "pending"
+
+	ClaimModeRead  = "read"
+	ClaimModeWrite = "write"
+
+	MemberStatusAlive  = "alive"
+	MemberStatusLeft   = "left"
+	MemberStatusFailed = "failed"
+
+	NodeStatusReady        = "ready"
+	NodeStatusInitializing = "initializing"
+	NodeStatusDown         = "down"
+	NodeStatusDisconnected = "disconnected"
+	NodeEligible           = "eligible"
+	NodeIneligible         = "ineligible"
+
+	EvalStatusBlocked = "blocked"
+
+	// The parts of the cluster which are read on their own.
+	ClusterServers   = "servers"
+	ClusterRaft      = "raft"
+	ClusterAutopilot = "autopilot"
+	ClusterNodes     = "nodes"
+	ClusterEvals     = "evaluations"
+
+	TokenTypeClient     = "client"
+	TokenTypeManagement = "management"
+
+	CapabilityDeny           = "deny"
+	CapabilityReadJob        = "read-job"
+	CapabilitySubmitJob      = "submit-job"
+	CapabilityScaleJob       = "scale-job"
+	CapabilityReadLogs       = "read-logs"
+	CapabilityReadFS         = "read-fs"
+	CapabilityAllocExec      = "alloc-exec"
+	CapabilityCSIWriteVolume = "csi-write-volume"
+
+	// Variables have path based rules; these name the
+	// capability needed on the path of the variable.
+	CapabilityVariablesWrite   = "variables write"
+	CapabilityVariablesDestroy = "variables destroy"
 )
 
+// Token is the ACL token Damon talks to Nomad with. Namespaces
+// maps the namespace patterns of the token's policies, which may
+// contain globs, to the capabilities they grant.
+type Token struct {
+	AccessorID string
+	Name       string
+	Type       string
+	Global     bool
+	Expires    *time.Time
+	Policies   []string
+	Namespaces map[string][]string
+
+	// Disabled is set if ACLs are disabled in the cluster.
+	Disabled bool
+
+	// Unresolved is set if the token or its policies couldn't
+	// be read. Nomad decides about every action in that case.
+	Unresolved bool
+}
+
+// Allowed reports whether the token has the capability in the
+// namespace. Like Nomad, an exact namespace rule wins over globs
+// and of the globs the closest match is used. For the wildcard
+// namespace "*" it reports whether any namespace grants it.
+func (t *Token) Allowed(namespace, capability string) bool {
+	if t == nil || t.Disabled || t.Unresolved || t.Type == TokenTypeManagement {
+		return true
+	}
+
+	if namespace == "*" {
+		for _, capabilities := range t.Namespaces {
+			if grants(capabilities, capability) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	capabilities, ok := t.Namespaces[namespace]
+	if !ok {
+		capabilities = t.closestNamespace(namespace)
+	}
+
+	return grants(capabilities, capability)
+}
+
+func (t *Token) closestNamespace(namespace string) []string {
+	var (
+		capabilities []string
+		closest      = -1
+	)
+
+	for pattern, caps := range t.Namespaces {
+		if !strings.Contains(pattern, "*") || !globMatch(pattern, namespace) {
+			continue
+		}
+
+		diff := len(namespace) - len(strings.ReplaceAll(pattern, "*", ""))
+		if closest == -1 || diff < closest {
+			capabilities, closest = caps, diff
+		}
+	}
+
+	return capabilities
+}
+
+func grants(capabilities []string, capability string) bool {
+	granted := false
+	for _, c := range capabilities {
+		switch c {
+		case CapabilityDeny:
+			return false
+		case capability:
+			granted = true
+		}
+	}
+
+	return granted
+}
+
+// globMatch matches s against a pattern in which
+// every * stands for any number of characters.
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+// ACLPolicy is an ACL policy. Rules holds the HCL of the
+// policy and is only set when a single policy is read.
+type ACLPolicy struct {
+	Name        string
+	Description string
+	Rules       string
+	ModifyIndex uint64
+}
+
+// ACLRole groups ACL policies under a name.
+type ACLRole struct {
+	ID          string
+	Name        string
+	Description string
+	Policies    []string
+}
+
+// ACLToken is a token as listed by Nomad, without its secret.
+type ACLToken struct {
+	AccessorID string
+	Name       string
+	Type       string
+	Global     bool
+	Policies   []string
+	Roles      []string
+	Created    time.Time
+	Expires    *time.Time
+}
+
+// PermissionError is returned for actions the
+// ACL token lacks the capability for.
+type PermissionError struct {
+	Token      string
+	Namespace  string
+	Capability string
+}
+
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf(
+		"Permission denied: the ACL token %q lacks the %q capability in the namespace %q.",
+		e.Token, e.Capability, e.Namespace,
+	)
+}
+
 type Sentinel string
 
 func (s Sentinel) Error() string {
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+)
+
+func TestParseLogStart(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected *models.LogStart
+		err      error
+	}{
+		{text: "", expected: nil},
+		{text: "end", expected: nil},
+		{text: "start", expected: &models.LogStart{Origin: models.LogOriginStart}},
+		{text: " +1024 ", expected: &models.LogStart{Origin: models.LogOriginStart, Offset: 1024}},
+		{text: "-1024", expected: &models.LogStart{Origin: models.LogOriginEnd, Offset: 1024}},
+		{text: "200", expected: &models.LogStart{Lines: 200}},
+		{text: "0", err: models.ErrInvalidLogStart},
+		{text: "+", err: models.ErrInvalidLogStart},
+		{text: "+-5", err: models.ErrInvalidLogStart},
+		{text: "--5", err: models.ErrInvalidLogStart},
+		{text: "++5", err: models.ErrInvalidLogStart},
+		{text: "- 5", err: models.ErrInvalidLogStart},
+		{text: "5kb", err: models.ErrInvalidLogStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			r := require.New(t)
+
+			start, err := models.ParseLogStart(tt.text)
+			if tt.err != nil {
+				r.ErrorIs(err, tt.err)
+				r.Nil(start)
+				return
+			}
+
+			r.NoError(err)
+			r.Equal(tt.expected, start)
+
+			// It formats the start as it was parsed
+			if start != nil {
+				again, err := models.ParseLogStart(models.FormatLogStart(start))
+				r.NoError(err)
+				r.Equal(start, again)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+var (
+	readCapabilities = []string{
+		"list-jobs",
+		"parse-job",
+		"read-job",
+		"csi-list-volume",
+		"csi-read-volume",
+		"read-job-scaling",
+		"list-scaling-policies",
+		"read-scaling-policy",
+	}
+
+	writeCapabilities = append([]string{
+		models.CapabilityScaleJob,
+		models.CapabilitySubmitJob,
+		"dispatch-job",
+		models.CapabilityReadLogs,
+		models.CapabilityReadFS,
+		models.CapabilityAllocExec,
+		"alloc-lifecycle",
+		"csi-mount-volume",
+		models.CapabilityCSIWriteVolume,
+		"submit-recommendation",
+	}, readCapabilities...)
+
+	scaleCapabilities = []string{
+		"list-scaling-policies",
+		"read-scaling-policy",
+		"read-job-scaling",
+		models.CapabilityScaleJob,
+	}
+)
+
+// aclPolicyRules holds the parts of an ACL policy
+// Damon evaluates; everything else is ignored.
+type aclPolicyRules struct {
+	Namespaces []*aclNamespaceRule `hcl:"namespace,expand"`
+}
+
+type aclNamespaceRule struct {
+	Name         string   `hcl:",key"`
+	Policy       string   `hcl:"policy"`
+	Capabilities []string `hcl:"capabilities"`
+}
+
+// Token reads the ACL token Damon uses together with the namespace
+// capabilities of its policies, including the policies of its roles.
+// If a policy can't be read the token is marked as unresolved.
+func (n *Nomad) Token() (*models.Token, error) {
+	token, _, err := n.TokenClient.Self(nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "ACL support disabled") {
+			return &models.Token{Disabled: true}, nil
+		}
+
+		return nil, err
+	}
+
+	t := &models.Token{
+		AccessorID: token.AccessorID,
+		Name:       token.Name,
+		Type:       token.Type,
+		Global:     token.Global,
+		Expires:    token.ExpirationTime,
+		Policies:   token.Policies,
+		Namespaces: map[string][]string{},
+	}
+
+	if t.Type == models.TokenTypeManagement {
+		return t, nil
+	}
+
+	policies := append([]string{}, token.Policies...)
+	for _, link := range token.Roles {
+		role, _, err := n.RoleClient.Get(link.ID, nil)
+		if err != nil {
+			t.Unresolved = true
+			continue
+		}
+
+		for _, p := range role.Policies {
+			policies = append(policies, p.Name)
+		}
+	}
+
+	for _, name := range policies {
+		policy, _, err := n.PolicyClient.Info(name, nil)
+		if err != nil {
+			t.Unresolved = true
+			continue
+		}
+
+		rules, err := parsePolicyRules(policy.Rules)
+		if err != nil {
+			t.Unresolved = true
+			continue
+		}
+
+		for _, ns := range rules.Namespaces {
+			t.Namespaces[ns.Name] = append(t.Namespaces[ns.Name], expandNamespacePolicy(ns)...)
+		}
+	}
+
+	return t, nil
+}
+
+func parsePolicyRules(rules string) (*aclPolicyRules, error) {
+	var p aclPolicyRules
+	if err := hcl.Decode(&p, rules); err != nil {
+		return nil, fmt.Errorf("failed to parse ACL policy: %w", err)
+	}
+
+	return &p, nil
+}
+
+// expandNamespacePolicy returns the capabilities of the rule,
+// where the coarse policy is expanded the same way Nomad does.
+func expandNamespacePolicy(rule *aclNamespaceRule) []string {
+	capabilities := append([]string{}, rule.Capabilities...)
+
+	switch rule.Policy {
+	case "deny":
+		capabilities = append(capabilities, models.CapabilityDeny)
+	case "read":
+		capabilities = append(capabilities, readCapabilities...)
+	case "write":
+		capabilities = append(capabilities, writeCapabilities...)
+	case "scale":
+		capabilities = append(capabilities, scaleCapabilities...)
+	}
+
+	return capabilities
+}
+
+// ACLPolicies lists the ACL policies sorted by name.
+func (n *Nomad) ACLPolicies() ([]*models.ACLPolicy, error) {
+	list, _, err := n.PolicyClient.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*models.ACLPolicy, 0, len(list))
+	for _, p := range list {
+		policies = append(policies, &models.ACLPolicy{
+			Name:        p.Name,
+			Description: p.Description,
+			ModifyIndex: p.ModifyIndex,
+		})
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	return policies, nil
+}
+
+// ACLPolicy reads the ACL policy with its rules.
+func (n *Nomad) ACLPolicy(name string) (*models.ACLPolicy, error) {
+	p, _, err := n.PolicyClient.Info(name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ACLPolicy{
+		Name:        p.Name,
+		Description: p.Description,
+		Rules:       p.Rules,
+		ModifyIndex: p.ModifyIndex,
+	}, nil
+}
+
+// PutACLPolicy creates or updates the ACL policy. The rules are
+// parsed first, such that syntax errors are reported right away.
+func (n *Nomad) PutACLPolicy(policy *models.ACLPolicy) error {
+	if _, err := parsePolicyRules(policy.Rules); err != nil {
+		return err
+	}
+
+	_, err := n.PolicyClient.Upsert(&api.ACLPolicy{
+		Name:        policy.Name,
+		Description: policy.Description,
+		Rules:       policy.Rules,
+	}, nil)
+
+	return err
+}
+
+// ACLRoles lists the ACL roles sorted by name.
+func (n *Nomad) ACLRoles() ([]*models.ACLRole, error) {
+	list, _, err := n.RoleClient.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*models.ACLRole, 0, len(list))
+	for _, r := range list {
+		policies := make([]string, 0, len(r.Policies))
+		for _, p := range r.Policies {
+			policies = append(policies, p.Name)
+		}
+
+		roles = append(roles, &models.ACLRole{
+			ID:          r.ID,
+			Name:        r.Name,
+			Description: r.Description,
+			Policies:    policies,
+		})
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	return roles, nil
+}
+
+// ACLTokens lists the ACL tokens sorted by name and accessor ID.
+func (n *Nomad) ACLTokens() ([]*models.ACLToken, error) {
+	list, _, err := n.TokenClient.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*models.ACLToken, 0, len(list))
+	for _, t := range list {
+		roles := make([]string, 0, len(t.Roles))
+		for _, r := range t.Roles {
+			roles = append(roles, r.Name)
+		}
+
+		tokens = append(tokens, &models.ACLToken{
+			AccessorID: t.AccessorID,
+			Name:       t.Name,
+			Type:       t.Type,
+			Global:     t.Global,
+			Policies:   t.Policies,
+			Roles:      roles,
+			Created:    t.CreateTime,
+			Expires:    t.ExpirationTime,
+		})
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].Name != tokens[j].Name {
+			return tokens[i].Name < tokens[j].Name
+		}
+
+		return tokens[i].AccessorID < tokens[j].AccessorID
+	})
+
+	return tokens, nil
+}
+
+// RevokeToken deletes the ACL token, which revokes it right away.
+func (n *Nomad) RevokeToken(accessorID string) error {
+	_, err := n.TokenClient.Delete(accessorID, nil)
+	return err
+}
@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestToken(t *testing.T) {
+	setup := func() (*nomad.Nomad, *nomadfakes.FakeACLTokensClient, *nomadfakes.FakeACLPoliciesClient, *nomadfakes.FakeACLRolesClient) {
+		tokens := &nomadfakes.FakeACLTokensClient{}
+		policies := &nomadfakes.FakeACLPoliciesClient{}
+		roles := &nomadfakes.FakeACLRolesClient{}
+
+		return &nomad.Nomad{TokenClient: tokens, PolicyClient: policies, RoleClient: roles}, tokens, policies, roles
+	}
+
+	t.Run("It reports disabled ACLs", func(t *testing.T) {
+		r := require.New(t)
+
+		client, tokens, _, _ := setup()
+		tokens.SelfReturns(nil, nil, errors.New("Unexpected response code: 400 (ACL support disabled)"))
+
+		token, err := client.Token()
+		r.NoError(err)
+		r.True(token.Disabled)
+		r.True(token.Allowed("default", models.CapabilitySubmitJob))
+	})
+
+	t.Run("It returns other errors", func(t *testing.T) {
+		r := require.New(t)
+
+		client, tokens, _, _ := setup()
+		tokens.SelfReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Token()
+		r.EqualError(err, "argh")
+	})
+
+	t.Run("It allows a management token anything without reading policies", func(t *testing.T) {
+		r := require.New(t)
+
+		client, tokens, policies, _ := setup()
+		tokens.SelfReturns(&api.ACLToken{Name: "root", Type: "management"}, nil, nil)
+
+		token, err := client.Token()
+		r.NoError(err)
+		r.Equal("root", token.Name)
+		r.Zero(policies.InfoCallCount())
+		r.True(token.Allowed("anything", models.CapabilityAllocExec))
+	})
+
+	t.Run("It evaluates the namespace rules of the token and role policies", func(t *testing.T) {
+		r := require.New(t)
+
+		client, tokens, policies, roles := setup()
+		tokens.SelfReturns(&api.ACLToken{
+			AccessorID: "a1",
+			Name:       "dev",
+			Type:       "client",
+			Policies:   []string{"readonly"},
+			Roles:      []*api.ACLTokenRoleLink{{ID: "r1", Name: "deployer"}},
+		}, nil, nil)
+
+		roles.GetReturns(&api.ACLRole{
+			Policies: []*api.ACLRolePolicyLink{{Name: "deploy"}},
+		}, nil, nil)
+
+		policies.InfoStub = func(name string, _ *api.QueryOptions) (*api.ACLPolicy, *api.QueryMeta, error) {
+			switch name {
+			case "readonly":
+				return &api.ACLPolicy{Rules: `
+namespace "*" {
+  policy = "read"
+}
+
+node {
+  policy = "read"
+}`}, nil, nil
+			case "deploy":
+				return &api.ACLPolicy{Rules: `
+namespace "apps-*" {
+  policy       = "read"
+  capabilities = ["submit-job"]
+}
+
+namespace "apps-secret" {
+  policy = "deny"
+}
+
+namespace "default" {
+  policy = "write"
+}`}, nil, nil
+			}
+
+			return nil, nil, errors.New("not found")
+		}
+
+		token, err := client.Token()
+		r.NoError(err)
+		r.False(token.Unresolved)
+		roleID, _ := roles.GetArgsForCall(0)
+		r.Equal("r1", roleID)
+		r.Equal([]string{"readonly"}, token.Policies)
+
+		r.True(token.Allowed("default", models.CapabilityAllocExec))
+		r.True(token.Allowed("apps-web", models.CapabilitySubmitJob))
+		r.False(token.Allowed("apps-web", models.CapabilityReadLogs))
+		r.False(token.Allowed("apps-secret", models.CapabilitySubmitJob))
+		r.False(token.Allowed("other", models.CapabilitySubmitJob))
+		r.True(token.Allowed("other", "read-job"))
+		r.True(token.Allowed("*", models.CapabilityReadFS))
+		r.False(token.Allowed("*", "submit-recommendation-nope"))
+	})
+
+	t.Run("It marks the token unresolved if a policy can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		client, tokens, policies, _ := setup()
+		tokens.SelfReturns(&api.ACLToken{Type: "client", Policies: []string{"p"}}, nil, nil)
+		policies.InfoReturns(nil, nil, errors.New("Unexpected response code: 403 (Permission denied)"))
+
+		token, err := client.Token()
+		r.NoError(err)
+		r.True(token.Unresolved)
+		r.True(token.Allowed("default", models.CapabilitySubmitJob))
+	})
+}
+
+func TestACLPolicies(t *testing.T) {
+	t.Run("It lists the policies sorted by name", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePolicies := &nomadfakes.FakeACLPoliciesClient{}
+		client := &nomad.Nomad{PolicyClient: fakePolicies}
+
+		fakePolicies.ListReturns([]*api.ACLPolicyListStub{
+			{Name: "write", Description: "write all", ModifyIndex: 7},
+			{Name: "anonymous"},
+		}, nil, nil)
+
+		policies, err := client.ACLPolicies()
+		r.NoError(err)
+		r.Equal([]*models.ACLPolicy{
+			{Name: "anonymous"},
+			{Name: "write", Description: "write all", ModifyIndex: 7},
+		}, policies)
+	})
+
+	t.Run("It reads a policy with its rules", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePolicies := &nomadfakes.FakeACLPoliciesClient{}
+		client := &nomad.Nomad{PolicyClient: fakePolicies}
+
+		fakePolicies.InfoReturns(&api.ACLPolicy{Name: "read", Rules: `namespace "*" {}`}, nil, nil)
+
+		policy, err := client.ACLPolicy("read")
+		r.NoError(err)
+		r.Equal(&models.ACLPolicy{Name: "read", Rules: `namespace "*" {}`}, policy)
+
+		name, _ := fakePolicies.InfoArgsForCall(0)
+		r.Equal("read", name)
+	})
+
+	t.Run("It writes a policy", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePolicies := &nomadfakes.FakeACLPoliciesClient{}
+		client := &nomad.Nomad{PolicyClient: fakePolicies}
+
+		err := client.PutACLPolicy(&models.ACLPolicy{
+			Name:        "read",
+			Description: "read all",
+			Rules:       `namespace "*" { policy = "read" }`,
+		})
+		r.NoError(err)
+
+		policy, _ := fakePolicies.UpsertArgsForCall(0)
+		r.Equal(&api.ACLPolicy{
+			Name:        "read",
+			Description: "read all",
+			Rules:       `namespace "*" { policy = "read" }`,
+		}, policy)
+	})
+
+	t.Run("It rejects rules which don't parse", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePolicies := &nomadfakes.FakeACLPoliciesClient{}
+		client := &nomad.Nomad{PolicyClient: fakePolicies}
+
+		err := client.PutACLPolicy(&models.ACLPolicy{Name: "broken", Rules: `namespace "*" {`})
+		r.Error(err)
+		r.Zero(fakePolicies.UpsertCallCount())
+	})
+
+	t.Run("It returns the error of the client", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePolicies := &nomadfakes.FakeACLPoliciesClient{}
+		client := &nomad.Nomad{PolicyClient: fakePolicies}
+
+		fakePolicies.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.ACLPolicies()
+		r.EqualError(err, "argh")
+	})
+}
+
+func TestACLRoles(t *testing.T) {
+	r := require.New(t)
+
+	fakeRoles := &nomadfakes.FakeACLRolesClient{}
+	client := &nomad.Nomad{RoleClient: fakeRoles}
+
+	fakeRoles.ListReturns([]*api.ACLRoleListStub{
+		{ID: "2", Name: "ops", Policies: []*api.ACLRolePolicyLink{{Name: "write"}, {Name: "node"}}},
+		{ID: "1", Name: "dev"},
+	}, nil, nil)
+
+	roles, err := client.ACLRoles()
+	r.NoError(err)
+	r.Equal([]*models.ACLRole{
+		{ID: "1", Name: "dev", Policies: []string{}},
+		{ID: "2", Name: "ops", Policies: []string{"write", "node"}},
+	}, roles)
+}
+
+func TestACLTokens(t *testing.T) {
+	t.Run("It lists the tokens sorted by name and accessor ID", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTokens := &nomadfakes.FakeACLTokensClient{}
+		client := &nomad.Nomad{TokenClient: fakeTokens}
+
+		fakeTokens.ListReturns([]*api.ACLTokenListStub{
+			{AccessorID: "b", Name: "ci", Type: "client", Policies: []string{"deploy"}},
+			{AccessorID: "c", Name: "ci", Type: "client", Roles: []*api.ACLTokenRoleLink{{ID: "1", Name: "dev"}}},
+			{AccessorID: "a", Name: "Bootstrap Token", Type: "management", Global: true},
+		}, nil, nil)
+
+		tokens, err := client.ACLTokens()
+		r.NoError(err)
+		r.Equal([]*models.ACLToken{
+			{AccessorID: "a", Name: "Bootstrap Token", Type: "management", Global: true, Roles: []string{}},
+			{AccessorID: "b", Name: "ci", Type: "client", Policies: []string{"deploy"}, Roles: []string{}},
+			{AccessorID: "c", Name: "ci", Type: "client", Roles: []string{"dev"}},
+		}, tokens)
+	})
+
+	t.Run("It revokes a token", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeTokens := &nomadfakes.FakeACLTokensClient{}
+		client := &nomad.Nomad{TokenClient: fakeTokens}
+
+		r.NoError(client.RevokeToken("a"))
+
+		accessorID, _ := fakeTokens.DeleteArgsForCall(0)
+		r.Equal("a", accessorID)
+	})
+}
@@ -5,6 +5,7 @@ package nomad
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
@@ -58,14 +59,39 @@ func (n *Nomad) Allocations(so *SearchOptions) ([]*models.Alloc, error) {
 func getTasksFromAlloc(taskStates map[string]*api.TaskState, alloc *api.Allocation) []*models.Task {
 	tasks := []*models.Task{}
 
-	for _, t := range alloc.GetTaskGroup().Tasks {
+	tg := alloc.GetTaskGroup()
+	for _, t := range tg.Tasks {
 		task := &models.Task{
-			Name:     t.Name,
-			Driver:   t.Driver,
-			Env:      t.Env,
-			Config:   t.Config,
-			CPU:      *t.Resources.CPU,
-			MemoryMB: *t.Resources.MemoryMB,
+			Name:          t.Name,
+			Driver:        t.Driver,
+			Env:           t.Env,
+			Config:        t.Config,
+			CPU:           *t.Resources.CPU,
+			MemoryMB:      *t.Resources.MemoryMB,
+			Lifecycle:     t.Lifecycle,
+			RestartPolicy: t.RestartPolicy,
+			Artifacts:     t.Artifacts,
+			Templates:     t.Templates,
+			VolumeMounts:  t.VolumeMounts,
+		}
+
+		if t.Resources.MemoryMaxMB != nil {
+			task.MemoryMaxMB = *t.Resources.MemoryMaxMB
+		}
+
+		// tasks of older jobs inherit the restart policy of the group
+		if task.RestartPolicy == nil {
+			task.RestartPolicy = tg.RestartPolicy
+		}
+
+		if alloc.AllocatedResources != nil {
+			if r, ok := alloc.AllocatedResources.Tasks[t.Name]; ok && r != nil {
+				task.Allocated = &models.TaskResources{
+					CPU:         r.Cpu.CpuShares,
+					MemoryMB:    r.Memory.MemoryMB,
+					MemoryMaxMB: r.Memory.MemoryMaxMB,
+				}
+			}
 		}
 
 		if taskStates[t.Name] != nil {
@@ -90,44 +116,141 @@ func (n *Nomad) toAllocs(list []*api.AllocationListStub) ([]*models.Alloc, error
 			return nil, err
 		}
 
-		tasks := getTasksFromAlloc(el.TaskStates, a)
-
-		alloc := &models.Alloc{
-			ID:            el.ID,
-			Namespace:     el.Namespace,
-			TaskGroup:     el.TaskGroup,
-			TaskList:      tasks,
-			JobID:         el.JobID,
-			JobType:       el.JobType,
-			NodeID:        el.NodeID,
-			NodeName:      el.NodeName,
-			DesiredStatus: el.DesiredStatus,
-			Version:       el.JobVersion,
-			Status:        el.ClientStatus,
-			Created:       time.Unix(0, el.CreateTime),
-			Modified:      time.Unix(0, el.ModifyTime),
+		result = append(result, toAlloc(el, a))
+	}
+
+	return result, nil
+}
+
+// toAlloc converts an allocation of the list, which has the task
+// states, and the allocation itself, which has the tasks.
+func toAlloc(el *api.AllocationListStub, a *api.Allocation) *models.Alloc {
+	tasks := getTasksFromAlloc(el.TaskStates, a)
+
+	alloc := &models.Alloc{
+		ID:            el.ID,
+		Namespace:     el.Namespace,
+		TaskGroup:     el.TaskGroup,
+		TaskList:      tasks,
+		JobID:         el.JobID,
+		JobType:       el.JobType,
+		NodeID:        el.NodeID,
+		NodeName:      el.NodeName,
+		DesiredStatus: el.DesiredStatus,
+		Version:       el.JobVersion,
+		Status:        el.ClientStatus,
+		Created:       time.Unix(0, el.CreateTime),
+		Modified:      time.Unix(0, el.ModifyTime),
+	}
+
+	for _, t := range tasks {
+		alloc.TaskNames = append(alloc.TaskNames, t.Name)
+		alloc.Tasks = append(alloc.Tasks, models.AllocTask{
+			Name:   t.Name,
+			Events: t.Events,
+		})
+	}
+
+	if a.AllocatedResources != nil {
+		for _, net := range a.AllocatedResources.Shared.Ports {
+			alloc.HostAddresses = append(
+				alloc.HostAddresses,
+				fmt.Sprintf("%s/%s:%d", net.Label, net.HostIP, net.Value),
+			)
+		}
+
+	}
+
+	return alloc
+}
+
+// AllocDetail returns the details of an allocation.
+func (n *Nomad) AllocDetail(allocID string) (*models.AllocDetail, error) {
+	a, _, err := n.AllocClient.Info(allocID, &api.QueryOptions{
+		Namespace: "*",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &models.AllocDetail{
+		ID:                 a.ID,
+		Name:               a.Name,
+		Namespace:          a.Namespace,
+		JobID:              a.JobID,
+		TaskGroup:          a.TaskGroup,
+		NodeID:             a.NodeID,
+		NodeName:           a.NodeName,
+		DesiredStatus:      a.DesiredStatus,
+		DesiredDescription: a.DesiredDescription,
+		Status:             a.ClientStatus,
+		StatusDescription:  a.ClientDescription,
+		Created:            time.Unix(0, a.CreateTime),
+		Modified:           time.Unix(0, a.ModifyTime),
+		PreviousAllocation: a.PreviousAllocation,
+		NextAllocation:     a.NextAllocation,
+		DeploymentID:       a.DeploymentID,
+		DeploymentStatus:   a.DeploymentStatus,
+		Ports:              allocPorts(a),
+		Metrics:            a.Metrics,
+	}
+
+	if a.Job != nil && a.Job.Version != nil {
+		detail.JobVersion = *a.Job.Version
+	}
+
+	if a.RescheduleTracker != nil {
+		detail.Reschedules = a.RescheduleTracker.Events
+	}
+
+	return detail, nil
+}
+
+// allocPorts returns the ports of the allocation. Ports of group
+// networks are mapped explicitly, while older jobs define the ports
+// on the networks of the group or its tasks.
+func allocPorts(a *api.Allocation) []api.PortMapping {
+	if a.AllocatedResources == nil {
+		return nil
+	}
+
+	shared := a.AllocatedResources.Shared
+	if len(shared.Ports) > 0 {
+		return shared.Ports
+	}
+
+	networks := append([]*api.NetworkResource{}, shared.Networks...)
+	for _, name := range sortedTaskNames(a.AllocatedResources.Tasks) {
+		networks = append(networks, a.AllocatedResources.Tasks[name].Networks...)
+	}
+
+	ports := []api.PortMapping{}
+	for _, network := range networks {
+		if network == nil {
+			continue
 		}
 
-		for _, t := range tasks {
-			alloc.TaskNames = append(alloc.TaskNames, t.Name)
-			alloc.Tasks = append(alloc.Tasks, models.AllocTask{
-				Name:   t.Name,
-				Events: t.Events,
+		for _, p := range append(network.ReservedPorts, network.DynamicPorts...) {
+			ports = append(ports, api.PortMapping{
+				Label:  p.Label,
+				Value:  p.Value,
+				To:     p.To,
+				HostIP: network.IP,
 			})
 		}
+	}
 
-		if a.AllocatedResources != nil {
-			for _, net := range a.AllocatedResources.Shared.Ports {
-				alloc.HostAddresses = append(
-					alloc.HostAddresses,
-					fmt.Sprintf("%s/%s:%d", net.Label, net.HostIP, net.Value),
-				)
-			}
+	return ports
+}
 
+func sortedTaskNames(tasks map[string]*api.AllocatedTaskResources) []string {
+	names := []string{}
+	for name, task := range tasks {
+		if task != nil {
+			names = append(names, name)
 		}
-
-		result = append(result, alloc)
 	}
 
-	return result, nil
+	sort.Strings(names)
+	return names
 }
@@ -596,3 +596,181 @@ func TestAllocations(t *testing.T) {
 		r.EqualError(err, "argh")
 	})
 }
+
+func TestAllocations_TaskDetails(t *testing.T) {
+	r := require.New(t)
+
+	fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+	client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+	cpu, memory, memoryMax, attempts := 100, 64, 128, 3
+	tgName := "the-group"
+	lifecycle := &api.TaskLifecycle{Hook: "prestart"}
+	template := &api.Template{DestPath: stringPtr("local/app.env")}
+
+	fakeAllocClient.ListReturns([]*api.AllocationListStub{{ID: "id-one", TaskGroup: tgName}}, nil, nil)
+	fakeAllocClient.InfoReturns(&api.Allocation{
+		TaskGroup: tgName,
+		Job: &api.Job{
+			TaskGroups: []*api.TaskGroup{
+				{
+					Name:          &tgName,
+					RestartPolicy: &api.RestartPolicy{Attempts: &attempts},
+					Tasks: []*api.Task{
+						{
+							Name:      "task-1",
+							Lifecycle: lifecycle,
+							Templates: []*api.Template{template},
+							Resources: &api.Resources{
+								CPU:         &cpu,
+								MemoryMB:    &memory,
+								MemoryMaxMB: &memoryMax,
+							},
+						},
+					},
+				},
+			},
+		},
+		AllocatedResources: &api.AllocatedResources{
+			Tasks: map[string]*api.AllocatedTaskResources{
+				"task-1": {
+					Cpu:    api.AllocatedCpuResources{CpuShares: 100},
+					Memory: api.AllocatedMemoryResources{MemoryMB: 64, MemoryMaxMB: 128},
+				},
+			},
+		},
+	}, nil, nil)
+
+	allocs, err := client.Allocations(nil)
+	r.NoError(err)
+
+	task := allocs[0].TaskList[0]
+	r.Equal(lifecycle, task.Lifecycle)
+	r.Equal([]*api.Template{template}, task.Templates)
+	r.Equal(128, task.MemoryMaxMB)
+	r.Equal(&models.TaskResources{CPU: 100, MemoryMB: 64, MemoryMaxMB: 128}, task.Allocated)
+
+	// It falls back to the restart policy of the group
+	r.Equal(3, *task.RestartPolicy.Attempts)
+}
+
+func TestAllocDetail(t *testing.T) {
+	t.Run("It returns the details of the allocation", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+		healthy := true
+		version := uint64(3)
+		metrics := &api.AllocationMetric{NodesEvaluated: 3, NodesFiltered: 1}
+		fakeAllocClient.InfoReturns(&api.Allocation{
+			ID:                 "moon",
+			Name:               "web.app[0]",
+			JobID:              "web",
+			Job:                &api.Job{Version: &version},
+			TaskGroup:          "app",
+			NodeID:             "node-id",
+			NodeName:           "node",
+			DesiredStatus:      "run",
+			DesiredDescription: "alloc is being rescheduled",
+			ClientStatus:       "failed",
+			ClientDescription:  "Failed tasks",
+			CreateTime:         100,
+			ModifyTime:         200,
+			PreviousAllocation: "sun",
+			NextAllocation:     "star",
+			RescheduleTracker: &api.RescheduleTracker{
+				Events: []*api.RescheduleEvent{
+					{RescheduleTime: 50, PrevAllocID: "sun", PrevNodeID: "other-node"},
+				},
+			},
+			DeploymentID:     "deploy",
+			DeploymentStatus: &api.AllocDeploymentStatus{Healthy: &healthy},
+			AllocatedResources: &api.AllocatedResources{
+				Shared: api.AllocatedSharedResources{
+					Ports: []api.PortMapping{{Label: "http", Value: 25000, To: 8080, HostIP: "10.0.0.1"}},
+				},
+			},
+			Metrics: metrics,
+		}, nil, nil)
+
+		detail, err := client.AllocDetail("moon")
+		r.NoError(err)
+
+		allocID, _ := fakeAllocClient.InfoArgsForCall(0)
+		r.Equal("moon", allocID)
+
+		r.Equal(&models.AllocDetail{
+			ID:                 "moon",
+			Name:               "web.app[0]",
+			JobID:              "web",
+			JobVersion:         3,
+			TaskGroup:          "app",
+			NodeID:             "node-id",
+			NodeName:           "node",
+			DesiredStatus:      "run",
+			DesiredDescription: "alloc is being rescheduled",
+			Status:             "failed",
+			StatusDescription:  "Failed tasks",
+			Created:            time.Unix(0, 100),
+			Modified:           time.Unix(0, 200),
+			PreviousAllocation: "sun",
+			NextAllocation:     "star",
+			Reschedules: []*api.RescheduleEvent{
+				{RescheduleTime: 50, PrevAllocID: "sun", PrevNodeID: "other-node"},
+			},
+			DeploymentID:     "deploy",
+			DeploymentStatus: &api.AllocDeploymentStatus{Healthy: &healthy},
+			Ports:            []api.PortMapping{{Label: "http", Value: 25000, To: 8080, HostIP: "10.0.0.1"}},
+			Metrics:          metrics,
+		}, detail)
+	})
+
+	t.Run("It returns the ports of networks of older jobs", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+		fakeAllocClient.InfoReturns(&api.Allocation{
+			ID: "moon",
+			AllocatedResources: &api.AllocatedResources{
+				Tasks: map[string]*api.AllocatedTaskResources{
+					"web": {
+						Networks: []*api.NetworkResource{
+							{
+								IP:            "10.0.0.1",
+								ReservedPorts: []api.Port{{Label: "admin", Value: 9000}},
+								DynamicPorts:  []api.Port{{Label: "http", Value: 25000, To: 8080}},
+							},
+						},
+					},
+				},
+			},
+		}, nil, nil)
+
+		detail, err := client.AllocDetail("moon")
+		r.NoError(err)
+		r.Equal([]api.PortMapping{
+			{Label: "admin", Value: 9000, HostIP: "10.0.0.1"},
+			{Label: "http", Value: 25000, To: 8080, HostIP: "10.0.0.1"},
+		}, detail.Ports)
+	})
+
+	t.Run("When the allocation can't be fetched", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+		fakeAllocClient.InfoReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.AllocDetail("moon")
+		r.Error(err)
+	})
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
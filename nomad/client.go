@@ -5,6 +5,7 @@ package nomad
 
 import (
 	"context"
+	"io"
 
 	"github.com/hashicorp/nomad/api"
 )
@@ -22,17 +23,107 @@ type JobClient interface {
 	Allocations(string, bool, *api.QueryOptions) ([]*api.AllocationListStub, *api.QueryMeta, error)
 	Deregister(jobID string, purge bool, q *api.WriteOptions) (string, *api.WriteMeta, error)
 	Register(job *api.Job, q *api.WriteOptions) (*api.JobRegisterResponse, *api.WriteMeta, error)
+	Versions(jobID string, diffs bool, q *api.QueryOptions) ([]*api.Job, []*api.JobDiff, *api.QueryMeta, error)
+	Scale(jobID, group string, count *int, message string, error bool, meta map[string]interface{}, q *api.WriteOptions) (*api.JobRegisterResponse, *api.WriteMeta, error)
+	Submission(jobID string, version int, q *api.QueryOptions) (*api.JobSubmission, *api.QueryMeta, error)
 }
 
 //go:generate counterfeiter . AllocationsClient
 type AllocationsClient interface {
 	List(*api.QueryOptions) ([]*api.AllocationListStub, *api.QueryMeta, error)
 	Info(string, *api.QueryOptions) (*api.Allocation, *api.QueryMeta, error)
+	Stats(*api.Allocation, *api.QueryOptions) (*api.AllocResourceUsage, error)
+	Checks(allocID string, q *api.QueryOptions) (api.AllocCheckStatuses, error)
 }
 
 //go:generate counterfeiter . AllocFSClient
 type AllocFSClient interface {
 	Logs(alloc *api.Allocation, follow bool, task string, logType string, origin string, offset int64, cancel <-chan struct{}, q *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error)
+	List(alloc *api.Allocation, path string, q *api.QueryOptions) ([]*api.AllocFileInfo, *api.QueryMeta, error)
+	Stat(alloc *api.Allocation, path string, q *api.QueryOptions) (*api.AllocFileInfo, *api.QueryMeta, error)
+	ReadAt(alloc *api.Allocation, path string, offset int64, limit int64, q *api.QueryOptions) (io.ReadCloser, error)
+	Cat(alloc *api.Allocation, path string, q *api.QueryOptions) (io.ReadCloser, error)
+	Stream(alloc *api.Allocation, path, origin string, offset int64, cancel <-chan struct{}, q *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error)
+}
+
+//go:generate counterfeiter . VariablesClient
+type VariablesClient interface {
+	PrefixList(prefix string, q *api.QueryOptions) ([]*api.VariableMetadata, *api.QueryMeta, error)
+	Read(path string, q *api.QueryOptions) (*api.Variable, *api.QueryMeta, error)
+	CheckedCreate(v *api.Variable, q *api.WriteOptions) (*api.Variable, *api.WriteMeta, error)
+	CheckedUpdate(v *api.Variable, q *api.WriteOptions) (*api.Variable, *api.WriteMeta, error)
+	CheckedDelete(path string, checkIndex uint64, q *api.WriteOptions) (*api.WriteMeta, error)
+}
+
+//go:generate counterfeiter . ServicesClient
+type ServicesClient interface {
+	List(q *api.QueryOptions) ([]*api.ServiceRegistrationListStub, *api.QueryMeta, error)
+	Get(serviceName string, q *api.QueryOptions) ([]*api.ServiceRegistration, *api.QueryMeta, error)
+}
+
+//go:generate counterfeiter . CSIVolumesClient
+type CSIVolumesClient interface {
+	List(q *api.QueryOptions) ([]*api.CSIVolumeListStub, *api.QueryMeta, error)
+	Info(id string, q *api.QueryOptions) (*api.CSIVolume, *api.QueryMeta, error)
+	Detach(volID, nodeID string, w *api.WriteOptions) error
+}
+
+//go:generate counterfeiter . CSIPluginsClient
+type CSIPluginsClient interface {
+	List(q *api.QueryOptions) ([]*api.CSIPluginListStub, *api.QueryMeta, error)
+}
+
+//go:generate counterfeiter . ACLTokensClient
+type ACLTokensClient interface {
+	Self(q *api.QueryOptions) (*api.ACLToken, *api.QueryMeta, error)
+	List(q *api.QueryOptions) ([]*api.ACLTokenListStub, *api.QueryMeta, error)
+	Delete(accessorID string, q *api.WriteOptions) (*api.WriteMeta, error)
+}
+
+//go:generate counterfeiter . ACLPoliciesClient
+type ACLPoliciesClient interface {
+	List(q *api.QueryOptions) ([]*api.ACLPolicyListStub, *api.QueryMeta, error)
+	Info(policyName string, q *api.QueryOptions) (*api.ACLPolicy, *api.QueryMeta, error)
+	Upsert(policy *api.ACLPolicy, q *api.WriteOptions) (*api.WriteMeta, error)
+}
+
+//go:generate counterfeiter . ACLRolesClient
+type ACLRolesClient interface {
+	List(q *api.QueryOptions) ([]*api.ACLRoleListStub, *api.QueryMeta, error)
+	Get(roleID string, q *api.QueryOptions) (*api.ACLRole, *api.QueryMeta, error)
+}
+
+//go:generate counterfeiter . AgentClient
+type AgentClient interface {
+	Members() (*api.ServerMembers, error)
+}
+
+//go:generate counterfeiter . StatusClient
+type StatusClient interface {
+	Leader() (string, error)
+}
+
+//go:generate counterfeiter . OperatorClient
+type OperatorClient interface {
+	RaftGetConfiguration(q *api.QueryOptions) (*api.RaftConfiguration, error)
+	AutopilotServerHealth(q *api.QueryOptions) (*api.OperatorHealthReply, *api.QueryMeta, error)
+}
+
+//go:generate counterfeiter . NodesClient
+type NodesClient interface {
+	List(q *api.QueryOptions) ([]*api.NodeListStub, *api.QueryMeta, error)
+	Info(nodeID string, q *api.QueryOptions) (*api.Node, *api.QueryMeta, error)
+	Allocations(nodeID string, q *api.QueryOptions) ([]*api.Allocation, *api.QueryMeta, error)
+}
+
+//go:generate counterfeiter . NodePoolsClient
+type NodePoolsClient interface {
+	List(q *api.QueryOptions) ([]*api.NodePool, *api.QueryMeta, error)
+}
+
+//go:generate counterfeiter . EvaluationsClient
+type EvaluationsClient interface {
+	List(q *api.QueryOptions) ([]*api.Evaluation, *api.QueryMeta, error)
 }
 
 //go:generate counterfeiter . NamespaceClient
@@ -43,6 +134,7 @@ type NamespaceClient interface {
 //go:generate counterfeiter . DeploymentClient
 type DeploymentClient interface {
 	List(*api.QueryOptions) ([]*api.Deployment, *api.QueryMeta, error)
+	Info(deploymentID string, q *api.QueryOptions) (*api.Deployment, *api.QueryMeta, error)
 }
 
 //go:generate counterfeiter . EventsClient
@@ -65,6 +157,23 @@ type Nomad struct {
 	AllocClient   AllocationsClient
 	AllocFSClient AllocFSClient
 	DpClient      DeploymentClient
+	VarClient     VariablesClient
+	SvcClient     ServicesClient
+	VolClient     CSIVolumesClient
+	PluginClient  CSIPluginsClient
+	TokenClient   ACLTokensClient
+	PolicyClient  ACLPoliciesClient
+	RoleClient    ACLRolesClient
+	AgentClient   AgentClient
+	StatusClient  StatusClient
+	OpClient      OperatorClient
+	NodeClient    NodesClient
+	PoolClient    NodePoolsClient
+	EvalClient    EvaluationsClient
+
+	// LogOffset is the number of bytes from the end
+	// of a log a stream starts with.
+	LogOffset int64
 }
 
 func New(opts ...func(*Nomad) error) (*Nomad, error) {
@@ -93,6 +202,19 @@ func Default(n *Nomad) error {
 	n.AllocClient = client.Allocations()
 	n.AllocFSClient = client.AllocFS()
 	n.DpClient = client.Deployments()
+	n.VarClient = client.Variables()
+	n.SvcClient = client.Services()
+	n.VolClient = client.CSIVolumes()
+	n.PluginClient = client.CSIPlugins()
+	n.TokenClient = client.ACLTokens()
+	n.PolicyClient = client.ACLPolicies()
+	n.RoleClient = client.ACLRoles()
+	n.AgentClient = client.Agent()
+	n.StatusClient = client.Status()
+	n.OpClient = client.Operator()
+	n.NodeClient = client.Nodes()
+	n.PoolClient = client.NodePools()
+	n.EvalClient = client.Evaluations()
 
 	return nil
 }
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// Cluster reads the servers, the Raft peers, the clients and the
+// blocked evaluations of the cluster. The parts are read one by one,
+// such that a token which may only read some of them still gets
+// those; the errors of the others are kept in Cluster.Errors. An
+// error is only returned if the leader can't be read.
+func (n *Nomad) Cluster() (*models.Cluster, error) {
+	cluster, err := n.Servers()
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Nodes, err = n.Nodes(); err != nil {
+		cluster.Errors[models.ClusterNodes] = err.Error()
+	}
+
+	if cluster.BlockedEvals, err = n.evaluations(&api.QueryOptions{
+		Namespace: "*",
+		Filter:    fmt.Sprintf("Status == %q", models.EvalStatusBlocked),
+	}); err != nil {
+		cluster.Errors[models.ClusterEvals] = err.Error()
+	}
+
+	return cluster, nil
+}
+
+// Servers reads the server members, the Raft peers and the autopilot
+// health. Like Cluster, it keeps the errors of the parts which can't
+// be read and only fails if the leader can't be read.
+func (n *Nomad) Servers() (*models.Cluster, error) {
+	leader, err := n.StatusClient.Leader()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &models.Cluster{
+		Leader: leader,
+		Errors: map[string]string{},
+	}
+
+	members, err := n.AgentClient.Members()
+	if err != nil {
+		cluster.Errors[models.ClusterServers] = err.Error()
+	} else {
+		cluster.Region = members.ServerRegion
+		cluster.Servers = toServerMembers(members.Members, leader)
+	}
+
+	if cluster.RaftPeers, err = n.RaftPeers(); err != nil {
+		cluster.Errors[models.ClusterRaft] = err.Error()
+	}
+
+	if cluster.Autopilot, err = n.Autopilot(); err != nil {
+		cluster.Errors[models.ClusterAutopilot] = err.Error()
+	}
+
+	return cluster, nil
+}
+
+func toServerMembers(list []*api.AgentMember, leader string) []*models.ServerMember {
+	members := make([]*models.ServerMember, 0, len(list))
+	for _, m := range list {
+		members = append(members, &models.ServerMember{
+			Name:       m.Name,
+			Address:    m.Addr,
+			Port:       int(m.Port),
+			Status:     m.Status,
+			Version:    m.Tags["build"],
+			Region:     m.Tags["region"],
+			Datacenter: m.Tags["dc"],
+			Leader:     net.JoinHostPort(m.Addr, m.Tags["port"]) == leader,
+		})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Region != members[j].Region {
+			return members[i].Region < members[j].Region
+		}
+
+		return members[i].Name < members[j].Name
+	})
+
+	return members
+}
+
+// RaftPeers lists the servers of the Raft configuration.
+func (n *Nomad) RaftPeers() ([]*models.RaftPeer, error) {
+	config, err := n.OpClient.RaftGetConfiguration(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*models.RaftPeer, 0, len(config.Servers))
+	for _, s := range config.Servers {
+		peers = append(peers, &models.RaftPeer{
+			ID:           s.ID,
+			Node:         s.Node,
+			Address:      s.Address,
+			Leader:       s.Leader,
+			Voter:        s.Voter,
+			RaftProtocol: s.RaftProtocol,
+		})
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Node < peers[j].Node
+	})
+
+	return peers, nil
+}
+
+// Autopilot reads the health of the servers as reported by autopilot.
+func (n *Nomad) Autopilot() (*models.AutopilotHealth, error) {
+	reply, _, err := n.OpClient.AutopilotServerHealth(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &models.AutopilotHealth{
+		Healthy:          reply.Healthy,
+		FailureTolerance: reply.FailureTolerance,
+		Servers:          make([]*models.ServerHealth, 0, len(reply.Servers)),
+	}
+
+	for _, s := range reply.Servers {
+		health.Servers = append(health.Servers, &models.ServerHealth{
+			ID:          s.ID,
+			Name:        s.Name,
+			Address:     s.Address,
+			SerfStatus:  s.SerfStatus,
+			Version:     s.Version,
+			Leader:      s.Leader,
+			Voter:       s.Voter,
+			Healthy:     s.Healthy,
+			LastContact: s.LastContact,
+			LastTerm:    s.LastTerm,
+			LastIndex:   s.LastIndex,
+			StableSince: s.StableSince,
+		})
+	}
+
+	return health, nil
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+type clusterFakes struct {
+	agent    *nomadfakes.FakeAgentClient
+	status   *nomadfakes.FakeStatusClient
+	operator *nomadfakes.FakeOperatorClient
+	nodes    *nomadfakes.FakeNodesClient
+	evals    *nomadfakes.FakeEvaluationsClient
+}
+
+func setupCluster() (*nomad.Nomad, *clusterFakes) {
+	fakes := &clusterFakes{
+		agent:    &nomadfakes.FakeAgentClient{},
+		status:   &nomadfakes.FakeStatusClient{},
+		operator: &nomadfakes.FakeOperatorClient{},
+		nodes:    &nomadfakes.FakeNodesClient{},
+		evals:    &nomadfakes.FakeEvaluationsClient{},
+	}
+
+	fakes.status.LeaderReturns("10.0.0.2:4647", nil)
+	fakes.agent.MembersReturns(&api.ServerMembers{
+		ServerRegion: "global",
+		Members: []*api.AgentMember{
+			{Name: "server-2.global", Addr: "10.0.0.2", Port: 4648, Status: "alive", Tags: map[string]string{"port": "4647", "build": "1.6.1", "region": "global", "dc": "dc1"}},
+			{Name: "server-1.global", Addr: "10.0.0.1", Port: 4648, Status: "failed", Tags: map[string]string{"port": "4647", "build": "1.6.0", "region": "global", "dc": "dc1"}},
+		},
+	}, nil)
+	fakes.operator.RaftGetConfigurationReturns(&api.RaftConfiguration{
+		Servers: []*api.RaftServer{
+			{ID: "b", Node: "server-2.global", Address: "10.0.0.2:4647", Leader: true, Voter: true, RaftProtocol: "3"},
+			{ID: "a", Node: "server-1.global", Address: "10.0.0.1:4647", Voter: true, RaftProtocol: "3"},
+		},
+	}, nil)
+	fakes.operator.AutopilotServerHealthReturns(&api.OperatorHealthReply{
+		Healthy:          true,
+		FailureTolerance: 0,
+		Servers: []api.ServerHealth{
+			{ID: "b", Name: "server-2.global", Address: "10.0.0.2:4647", SerfStatus: "alive", Version: "1.6.1", Leader: true, Voter: true, Healthy: true, LastTerm: 2, LastIndex: 120},
+			{ID: "a", Name: "server-1.global", Address: "10.0.0.1:4647", SerfStatus: "failed", Version: "1.6.0", Voter: true, LastContact: 3 * time.Second, LastTerm: 2, LastIndex: 100},
+		},
+	}, nil, nil)
+
+	return &nomad.Nomad{
+		AgentClient:  fakes.agent,
+		StatusClient: fakes.status,
+		OpClient:     fakes.operator,
+		NodeClient:   fakes.nodes,
+		EvalClient:   fakes.evals,
+	}, fakes
+}
+
+func TestCluster(t *testing.T) {
+	t.Run("It reads all parts of the cluster", func(t *testing.T) {
+		r := require.New(t)
+
+		client, fakes := setupCluster()
+		fakes.nodes.ListReturns([]*api.NodeListStub{
+			{ID: "n1", Name: "client-1", Status: "ready", SchedulingEligibility: "eligible"},
+		}, nil, nil)
+		fakes.evals.ListReturns([]*api.Evaluation{
+			{ID: "e1", JobID: "web", Status: "blocked"},
+		}, nil, nil)
+
+		cluster, err := client.Cluster()
+		r.NoError(err)
+		r.Empty(cluster.Errors)
+
+		r.Equal("10.0.0.2:4647", cluster.Leader)
+		r.Equal("global", cluster.Region)
+		r.Equal([]*models.ServerMember{
+			{Name: "server-1.global", Address: "10.0.0.1", Port: 4648, Status: "failed", Version: "1.6.0", Region: "global", Datacenter: "dc1"},
+			{Name: "server-2.global", Address: "10.0.0.2", Port: 4648, Status: "alive", Version: "1.6.1", Region: "global", Datacenter: "dc1", Leader: true},
+		}, cluster.Servers)
+		r.Equal([]*models.RaftPeer{
+			{ID: "a", Node: "server-1.global", Address: "10.0.0.1:4647", Voter: true, RaftProtocol: "3"},
+			{ID: "b", Node: "server-2.global", Address: "10.0.0.2:4647", Leader: true, Voter: true, RaftProtocol: "3"},
+		}, cluster.RaftPeers)
+		r.Len(cluster.Nodes, 1)
+		r.Equal("client-1", cluster.Nodes[0].Name)
+		r.Len(cluster.BlockedEvals, 1)
+
+		q := fakes.evals.ListArgsForCall(0)
+		r.Equal("*", q.Namespace)
+		r.Equal(`Status == "blocked"`, q.Filter)
+	})
+
+	t.Run("It keeps the errors of the parts which can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		client, fakes := setupCluster()
+		fakes.operator.RaftGetConfigurationReturns(nil, errors.New("Permission denied"))
+		fakes.nodes.ListReturns(nil, nil, errors.New("argh"))
+
+		cluster, err := client.Cluster()
+		r.NoError(err)
+		r.Len(cluster.Servers, 2)
+		r.Equal(map[string]string{
+			models.ClusterRaft:  "Permission denied",
+			models.ClusterNodes: "argh",
+		}, cluster.Errors)
+	})
+
+	t.Run("It fails if the leader can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		client, fakes := setupCluster()
+		fakes.status.LeaderReturns("", errors.New("no cluster leader"))
+
+		_, err := client.Cluster()
+		r.EqualError(err, "no cluster leader")
+	})
+}
+
+func TestServers(t *testing.T) {
+	t.Run("It reads the members, the Raft peers and the autopilot health", func(t *testing.T) {
+		r := require.New(t)
+
+		client, fakes := setupCluster()
+
+		cluster, err := client.Servers()
+		r.NoError(err)
+		r.Empty(cluster.Errors)
+		r.Len(cluster.Servers, 2)
+		r.Len(cluster.RaftPeers, 2)
+		r.Nil(cluster.Nodes)
+		r.Equal(0, fakes.nodes.ListCallCount())
+
+		r.Equal(&models.AutopilotHealth{
+			Healthy:          true,
+			FailureTolerance: 0,
+			Servers: []*models.ServerHealth{
+				{ID: "b", Name: "server-2.global", Address: "10.0.0.2:4647", SerfStatus: "alive", Version: "1.6.1", Leader: true, Voter: true, Healthy: true, LastTerm: 2, LastIndex: 120},
+				{ID: "a", Name: "server-1.global", Address: "10.0.0.1:4647", SerfStatus: "failed", Version: "1.6.0", Voter: true, LastContact: 3 * time.Second, LastTerm: 2, LastIndex: 100},
+			},
+		}, cluster.Autopilot)
+	})
+
+	t.Run("It keeps the error if the autopilot health can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		client, fakes := setupCluster()
+		fakes.operator.AutopilotServerHealthReturns(nil, nil, errors.New("Permission denied"))
+
+		cluster, err := client.Servers()
+		r.NoError(err)
+		r.Nil(cluster.Autopilot)
+		r.Equal(map[string]string{models.ClusterAutopilot: "Permission denied"}, cluster.Errors)
+	})
+
+	t.Run("It fails if the leader can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		client, fakes := setupCluster()
+		fakes.status.LeaderReturns("", errors.New("no cluster leader"))
+
+		_, err := client.Servers()
+		r.EqualError(err, "no cluster leader")
+	})
+}
@@ -4,6 +4,8 @@
 package nomad
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/nomad/api"
 
 	"github.com/hcjulz/damon/models"
@@ -38,3 +40,40 @@ func toDeployments(dep []*api.Deployment) []*models.Deployment {
 	}
 	return result
 }
+
+// DeploymentDetail returns the deployment with the
+// state of its task groups, sorted by name.
+func (n *Nomad) DeploymentDetail(namespace, deploymentID string) (*models.DeploymentDetail, error) {
+	d, _, err := n.DpClient.Info(deploymentID, &api.QueryOptions{Namespace: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve deployment: %w", err)
+	}
+
+	detail := &models.DeploymentDetail{
+		ID:                d.ID,
+		JobID:             d.JobID,
+		JobVersion:        d.JobVersion,
+		Namespace:         d.Namespace,
+		Status:            d.Status,
+		StatusDescription: d.StatusDescription,
+	}
+
+	for _, name := range sortedGroups(d.TaskGroups) {
+		s := d.TaskGroups[name]
+		detail.TaskGroups = append(detail.TaskGroups, &models.DeploymentTaskGroup{
+			Name:              name,
+			Desired:           s.DesiredTotal,
+			Placed:            s.PlacedAllocs,
+			Healthy:           s.HealthyAllocs,
+			Unhealthy:         s.UnhealthyAllocs,
+			DesiredCanaries:   s.DesiredCanaries,
+			PlacedCanaries:    len(s.PlacedCanaries),
+			Promoted:          s.Promoted,
+			AutoRevert:        s.AutoRevert,
+			ProgressDeadline:  s.ProgressDeadline,
+			RequireProgressBy: s.RequireProgressBy,
+		})
+	}
+
+	return detail, nil
+}
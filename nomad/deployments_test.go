@@ -6,6 +6,7 @@ package nomad_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/stretchr/testify/require"
@@ -79,3 +80,80 @@ func TestDeployments(t *testing.T) {
 		r.EqualError(err, "fatal")
 	})
 }
+
+func TestDeploymentDetail(t *testing.T) {
+	r := require.New(t)
+
+	fakeClient := &nomadfakes.FakeDeploymentClient{}
+	client := nomad.Nomad{DpClient: fakeClient}
+
+	t.Run("When there are no issues", func(t *testing.T) {
+		deadline := time.Now()
+
+		fakeClient.InfoReturns(&api.Deployment{
+			ID:                "42",
+			JobID:             "bumblebee",
+			JobVersion:        3,
+			Namespace:         "transformers",
+			Status:            "running",
+			StatusDescription: "Deployment is running",
+			TaskGroups: map[string]*api.DeploymentState{
+				"car": {
+					DesiredTotal:    2,
+					PlacedAllocs:    2,
+					HealthyAllocs:   1,
+					DesiredCanaries: 1,
+					PlacedCanaries:  []string{"c1"},
+					AutoRevert:      true,
+				},
+				"camaro": {
+					DesiredTotal:      1,
+					UnhealthyAllocs:   1,
+					ProgressDeadline:  10 * time.Minute,
+					RequireProgressBy: deadline,
+				},
+			},
+		}, nil, nil)
+
+		detail, err := client.DeploymentDetail("transformers", "42")
+		r.NoError(err)
+
+		id, queryOptions := fakeClient.InfoArgsForCall(0)
+		r.Equal("42", id)
+		r.Equal(&api.QueryOptions{Namespace: "transformers"}, queryOptions)
+
+		r.Equal(&models.DeploymentDetail{
+			ID:                "42",
+			JobID:             "bumblebee",
+			JobVersion:        3,
+			Namespace:         "transformers",
+			Status:            "running",
+			StatusDescription: "Deployment is running",
+			TaskGroups: []*models.DeploymentTaskGroup{
+				{
+					Name:              "camaro",
+					Desired:           1,
+					Unhealthy:         1,
+					ProgressDeadline:  10 * time.Minute,
+					RequireProgressBy: deadline,
+				},
+				{
+					Name:            "car",
+					Desired:         2,
+					Placed:          2,
+					Healthy:         1,
+					DesiredCanaries: 1,
+					PlacedCanaries:  1,
+					AutoRevert:      true,
+				},
+			},
+		}, detail)
+	})
+
+	t.Run("When there are issues with the client", func(t *testing.T) {
+		fakeClient.InfoReturns(nil, nil, errors.New("fatal"))
+
+		_, err := client.DeploymentDetail("transformers", "42")
+		r.EqualError(err, "failed to retrieve deployment: fatal")
+	})
+}
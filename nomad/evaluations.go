@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// Evaluations lists the evaluations, newest first.
+func (n *Nomad) Evaluations(so *SearchOptions) ([]*models.Evaluation, error) {
+	if so == nil {
+		so = &SearchOptions{}
+	}
+
+	return n.evaluations(&api.QueryOptions{
+		Namespace: so.Namespace,
+		Region:    so.Region,
+	})
+}
+
+func (n *Nomad) evaluations(q *api.QueryOptions) ([]*models.Evaluation, error) {
+	list, _, err := n.EvalClient.List(q)
+	if err != nil {
+		return nil, err
+	}
+
+	evals := make([]*models.Evaluation, 0, len(list))
+	for _, e := range list {
+		evals = append(evals, &models.Evaluation{
+			ID:                e.ID,
+			Namespace:         e.Namespace,
+			JobID:             e.JobID,
+			Type:              e.Type,
+			Priority:          e.Priority,
+			TriggeredBy:       e.TriggeredBy,
+			Status:            e.Status,
+			StatusDescription: e.StatusDescription,
+			NodeID:            e.NodeID,
+			DeploymentID:      e.DeploymentID,
+			Created:           time.Unix(0, e.CreateTime),
+		})
+	}
+
+	sort.SliceStable(evals, func(i, j int) bool {
+		return evals[i].Created.After(evals[j].Created)
+	})
+
+	return evals, nil
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestEvaluations(t *testing.T) {
+	t.Run("It lists the evaluations newest first", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeEvals := &nomadfakes.FakeEvaluationsClient{}
+		client := &nomad.Nomad{EvalClient: fakeEvals}
+
+		older := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+		newer := older.Add(time.Minute)
+
+		fakeEvals.ListReturns([]*api.Evaluation{
+			{ID: "a", Namespace: "default", JobID: "web", Type: "service", Priority: 50, TriggeredBy: "job-register", Status: "complete", CreateTime: older.UnixNano()},
+			{ID: "b", Namespace: "default", JobID: "web", Type: "service", Priority: 50, TriggeredBy: "node-update", Status: "blocked", NodeID: "n1", CreateTime: newer.UnixNano()},
+		}, nil, nil)
+
+		evals, err := client.Evaluations(&nomad.SearchOptions{Namespace: "*"})
+		r.NoError(err)
+		r.Equal("*", fakeEvals.ListArgsForCall(0).Namespace)
+
+		r.Len(evals, 2)
+		r.Equal("b", evals[0].ID)
+		r.True(newer.Equal(evals[0].Created))
+		r.Equal(&models.Evaluation{
+			ID:          "a",
+			Namespace:   "default",
+			JobID:       "web",
+			Type:        "service",
+			Priority:    50,
+			TriggeredBy: "job-register",
+			Status:      "complete",
+			Created:     evals[1].Created,
+		}, evals[1])
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeEvals := &nomadfakes.FakeEvaluationsClient{}
+		client := &nomad.Nomad{EvalClient: fakeEvals}
+
+		fakeEvals.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Evaluations(nil)
+		r.EqualError(err, "argh")
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"io"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// ListFiles lists the directory at the path in the filesystem of the
+// allocation. Directories are listed first, both sorted by name.
+func (n *Nomad) ListFiles(allocID, dir string) ([]*models.AllocFile, error) {
+	list, _, err := n.AllocFSClient.List(&api.Allocation{ID: allocID}, dir, &api.QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*models.AllocFile, 0, len(list))
+	for _, info := range list {
+		files = append(files, toAllocFile(dir, info))
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].IsDir != files[j].IsDir {
+			return files[i].IsDir
+		}
+
+		return files[i].Name < files[j].Name
+	})
+
+	return files, nil
+}
+
+// StatFile returns the file at the path in the filesystem of the allocation.
+func (n *Nomad) StatFile(allocID, file string) (*models.AllocFile, error) {
+	info, _, err := n.AllocFSClient.Stat(&api.Allocation{ID: allocID}, file, &api.QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return toAllocFile(path.Dir(file), info), nil
+}
+
+// ReadFile reads up to limit bytes of the file from the offset.
+func (n *Nomad) ReadFile(allocID, file string, offset, limit int64) ([]byte, error) {
+	r, err := n.AllocFSClient.ReadAt(&api.Allocation{ID: allocID}, file, offset, limit, &api.QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// CatFile reads the whole file.
+func (n *Nomad) CatFile(allocID, file string) ([]byte, error) {
+	r, err := n.AllocFSClient.Cat(&api.Allocation{ID: allocID}, file, &api.QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// StreamFile follows the file from the given start, or from
+// the end of the file if start is nil, like Logs does for logs.
+func (n *Nomad) StreamFile(allocID, file string, start *models.LogStart, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
+	origin, offset := models.LogOriginEnd, n.LogOffset
+	if offset <= 0 {
+		offset = defaultLogOffset
+	}
+
+	if start != nil {
+		origin, offset = start.Origin, start.Offset
+
+		if start.Lines > 0 {
+			var err error
+			origin = models.LogOriginEnd
+			offset, err = n.fileTailOffset(allocID, file, start.Lines)
+			if err != nil {
+				errCh := make(chan error, 1)
+				errCh <- err
+				return nil, errCh
+			}
+		}
+	}
+
+	return n.AllocFSClient.Stream(
+		&api.Allocation{ID: allocID},
+		file,
+		origin,
+		offset,
+		cancel,
+		&api.QueryOptions{},
+	)
+}
+
+// fileTailOffset returns the number of bytes from the
+// end of the file which hold the last lines.
+func (n *Nomad) fileTailOffset(allocID, file string, lines int) (int64, error) {
+	info, err := n.StatFile(allocID, file)
+	if err != nil {
+		return 0, err
+	}
+
+	return tailOffset(lines, func(size int64) ([]byte, error) {
+		if size > info.Size {
+			size = info.Size
+		}
+
+		return n.ReadFile(allocID, file, info.Size-size, size)
+	})
+}
+
+func toAllocFile(dir string, info *api.AllocFileInfo) *models.AllocFile {
+	return &models.AllocFile{
+		Name:     info.Name,
+		Path:     path.Join(dir, info.Name),
+		IsDir:    info.IsDir,
+		Size:     info.Size,
+		Mode:     info.FileMode,
+		Modified: info.ModTime,
+	}
+}
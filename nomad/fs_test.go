@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestListFiles(t *testing.T) {
+	t.Run("It lists directories first", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		modified := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+		fakeFSClient.ListReturns([]*api.AllocFileInfo{
+			{Name: "template.env", Size: 42, FileMode: "-rw-r--r--", ModTime: modified},
+			{Name: "secrets", IsDir: true, FileMode: "drwxrwxrwx"},
+			{Name: "local", IsDir: true, FileMode: "drwxrwxrwx"},
+		}, nil, nil)
+
+		files, err := client.ListFiles("moon", "/web")
+		r.NoError(err)
+
+		alloc, dir, _ := fakeFSClient.ListArgsForCall(0)
+		r.Equal(&api.Allocation{ID: "moon"}, alloc)
+		r.Equal("/web", dir)
+
+		r.Equal([]*models.AllocFile{
+			{Name: "local", Path: "/web/local", IsDir: true, Mode: "drwxrwxrwx"},
+			{Name: "secrets", Path: "/web/secrets", IsDir: true, Mode: "drwxrwxrwx"},
+			{Name: "template.env", Path: "/web/template.env", Size: 42, Mode: "-rw-r--r--", Modified: modified},
+		}, files)
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		fakeFSClient.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.ListFiles("moon", "/")
+		r.Error(err)
+	})
+}
+
+func TestReadFile(t *testing.T) {
+	r := require.New(t)
+
+	fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+	client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+	fakeFSClient.ReadAtReturns(io.NopCloser(strings.NewReader("PORT=8080\n")), nil)
+
+	data, err := client.ReadFile("moon", "/web/local/template.env", 100, 10)
+	r.NoError(err)
+	r.Equal("PORT=8080\n", string(data))
+
+	_, file, offset, limit, _ := fakeFSClient.ReadAtArgsForCall(0)
+	r.Equal("/web/local/template.env", file)
+	r.Equal(int64(100), offset)
+	r.Equal(int64(10), limit)
+
+	fakeFSClient.CatReturns(nil, errors.New("argh"))
+	_, err = client.CatFile("moon", "/web/local/template.env")
+	r.Error(err)
+}
+
+func TestStreamFile(t *testing.T) {
+	t.Run("It follows the end of the file by default", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+		cancel := make(<-chan struct{})
+
+		client.StreamFile("moon", "/alloc/data/app.log", nil, cancel)
+
+		alloc, file, origin, offset, actualCancel, _ := fakeFSClient.StreamArgsForCall(0)
+		r.Equal(&api.Allocation{ID: "moon"}, alloc)
+		r.Equal("/alloc/data/app.log", file)
+		r.Equal(models.LogOriginEnd, origin)
+		r.Equal(int64(20000), offset)
+		r.Equal(cancel, actualCancel)
+	})
+
+	t.Run("It starts with the last lines", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		content := "one\ntwo\nthree\n"
+		fakeFSClient.StatReturns(&api.AllocFileInfo{Name: "app.log", Size: int64(len(content))}, nil, nil)
+		fakeFSClient.ReadAtStub = func(alloc *api.Allocation, file string, offset, limit int64, q *api.QueryOptions) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content[offset : offset+limit])), nil
+		}
+
+		client.StreamFile("moon", "/alloc/data/app.log", &models.LogStart{Lines: 2}, nil)
+
+		_, _, origin, offset, _, _ := fakeFSClient.StreamArgsForCall(0)
+		r.Equal(models.LogOriginEnd, origin)
+		r.Equal(int64(len("two\nthree\n")), offset)
+	})
+
+	t.Run("When the file can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		fakeFSClient.StatReturns(nil, nil, errors.New("no such file"))
+
+		_, errCh := client.StreamFile("moon", "/alloc/data/app.log", &models.LogStart{Lines: 2}, nil)
+		r.EqualError(<-errCh, "no such file")
+		r.Equal(0, fakeFSClient.StreamCallCount())
+	})
+}
@@ -5,6 +5,7 @@ package nomad
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,7 +21,7 @@ func (n *Nomad) JobStatus(jobID string, so *SearchOptions) (*models.JobStatus, e
 
 	taskgroups, _ := n.TaskGroups(jobID, so)
 
-	info, err := n.GetJob(jobID)
+	info, err := n.GetJob(so.Namespace, jobID)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve job info: %w", err)
@@ -63,6 +64,13 @@ func toJobStatus(job *api.Job, tasks []*models.TaskGroup, taskStatus []*models.T
 		TaskGroups:      tasks,
 		TaskGroupStatus: taskStatus,
 		Allocations:     allocs,
+		TaskGroupCounts: map[string]int{},
+	}
+
+	for _, tg := range job.TaskGroups {
+		if tg.Name != nil && tg.Count != nil {
+			jobStatus.TaskGroupCounts[*tg.Name] = *tg.Count
+		}
 	}
 
 	return jobStatus
@@ -72,9 +80,12 @@ func toTaskGroupStatus(jobID string, dep []*api.Deployment) []*models.TaskGroupS
 	result := make([]*models.TaskGroupStatus, 0., len(dep))
 	for _, d := range dep {
 		if d.JobID == jobID {
-			for _, t := range d.TaskGroups {
+			for _, name := range sortedGroups(d.TaskGroups) {
+				t := d.TaskGroups[name]
 				result = append(result, &models.TaskGroupStatus{
 					ID:                d.JobID,
+					DeploymentID:      d.ID,
+					TaskGroup:         name,
 					Status:            d.Status,
 					StatusDescription: d.StatusDescription,
 					Desired:           t.DesiredTotal,
@@ -90,3 +101,13 @@ func toTaskGroupStatus(jobID string, dep []*api.Deployment) []*models.TaskGroupS
 	}
 	return result
 }
+
+func sortedGroups(groups map[string]*api.DeploymentState) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
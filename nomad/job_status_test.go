@@ -33,6 +33,7 @@ func TestJobStatus(t *testing.T) {
 		value := 100
 		ns := "ns"
 		fakeTaskGroup := "fakeTaskGroup"
+		count := 3
 
 		fakeJobClient.InfoReturns(&api.Job{
 			ID:         &id,
@@ -44,7 +45,8 @@ func TestJobStatus(t *testing.T) {
 			Namespace:  &ns,
 			TaskGroups: []*api.TaskGroup{
 				{
-					Name: &fakeTaskGroup,
+					Name:  &fakeTaskGroup,
+					Count: &count,
 				},
 			},
 		}, nil, nil)
@@ -65,6 +67,7 @@ func TestJobStatus(t *testing.T) {
 
 		fakeDpClient.ListReturns([]*api.Deployment{
 			{
+				ID:    "fakeDeploymentID",
 				JobID: "fakeID",
 				TaskGroups: map[string]*api.DeploymentState{
 					"dp1": {
@@ -111,10 +114,13 @@ func TestJobStatus(t *testing.T) {
 					Lost:     0,
 				},
 			},
-			Allocations: []*models.Alloc{},
+			Allocations:     []*models.Alloc{},
+			TaskGroupCounts: map[string]int{"fakeTaskGroup": 3},
 			TaskGroupStatus: []*models.TaskGroupStatus{
 				{
 					ID:                "fakeID",
+					DeploymentID:      "fakeDeploymentID",
+					TaskGroup:         "dp1",
 					Healthy:           1,
 					Unhealthy:         0,
 					Desired:           0,
@@ -135,7 +141,7 @@ func TestJobStatus(t *testing.T) {
 		r.Equal(fakeJobClient.InfoCallCount(), 1)
 
 		//check that the query params where passed correctly
-		r.Nil(queryOptions)
+		r.Equal("default", queryOptions.Namespace)
 
 		//check all fields are set
 		r.Equal(expectedJobStatus, jobStatus)
@@ -4,7 +4,9 @@
 package nomad
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
@@ -12,6 +14,9 @@ import (
 	"github.com/hcjulz/damon/models"
 )
 
+// diffTypeNone is the type of the parts of a job diff which didn't change.
+const diffTypeNone = "None"
+
 func (n *Nomad) Jobs(so *SearchOptions) ([]*models.Job, error) {
 	if so == nil {
 		so = &SearchOptions{}
@@ -60,8 +65,8 @@ func toJob(j *api.JobListStub) *models.Job {
 	}
 }
 
-func (n *Nomad) GetJob(jobID string) (*api.Job, error) {
-	job, _, err := n.JobClient.Info(jobID, nil)
+func (n *Nomad) GetJob(namespace, jobID string) (*api.Job, error) {
+	job, _, err := n.JobClient.Info(jobID, &api.QueryOptions{Namespace: namespace})
 	return job, err
 }
 
@@ -77,3 +82,139 @@ func (n *Nomad) StopJob(jobID string) error {
 	_, _, err := n.JobClient.Deregister(jobID, false, nil)
 	return err
 }
+
+// JobVersions returns the versions of a job, the latest first,
+// with the changes of each version to the one before.
+func (n *Nomad) JobVersions(jobID string, so *SearchOptions) ([]*models.JobVersion, error) {
+	if so == nil {
+		so = &SearchOptions{}
+	}
+
+	jobs, diffs, _, err := n.JobClient.Versions(jobID, true, &api.QueryOptions{
+		Namespace: so.Namespace,
+		Region:    so.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve job versions: %w", err)
+	}
+
+	versions := make([]*models.JobVersion, 0, len(jobs))
+	for i, j := range jobs {
+		version := &models.JobVersion{
+			Stable: j.Stable != nil && *j.Stable,
+		}
+
+		if j.Version != nil {
+			version.Version = *j.Version
+		}
+
+		if j.SubmitTime != nil {
+			version.SubmitTime = time.Unix(0, *j.SubmitTime)
+		}
+
+		// the diffs are between a version and the one
+		// before, the oldest version has no diff
+		if i < len(diffs) && diffs[i] != nil {
+			version.Changes = jobChanges(diffs[i])
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// ScaleJob sets the count of a task group of a job.
+func (n *Nomad) ScaleJob(namespace, jobID, group string, count int) error {
+	msg := fmt.Sprintf("scaled to %d by damon", count)
+	_, _, err := n.JobClient.Scale(jobID, group, &count, msg, false, nil, &api.WriteOptions{Namespace: namespace})
+	return err
+}
+
+// JobSpec returns the definition of a job: the source it was submitted
+// with, if Nomad still has it, and the job rendered as JSON. Nomad keeps
+// the source of the latest versions only and older servers don't keep
+// it at all, in which case the spec is shown as JSON.
+func (n *Nomad) JobSpec(job *api.Job) (*models.JobSpec, error) {
+	// the spec is shown, not sent, so <, > and & stay readable
+	var rendered strings.Builder
+	enc := json.NewEncoder(&rendered)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(job); err != nil {
+		return nil, fmt.Errorf("failed to render job: %w", err)
+	}
+
+	spec := &models.JobSpec{
+		ID:     *job.ID,
+		Format: models.JobSpecFormatJSON,
+		JSON:   strings.TrimSuffix(rendered.String(), "\n"),
+	}
+
+	if job.Namespace != nil {
+		spec.Namespace = *job.Namespace
+	}
+
+	if job.Version != nil {
+		spec.Version = *job.Version
+	}
+
+	sub, _, err := n.JobClient.Submission(spec.ID, int(spec.Version), &api.QueryOptions{Namespace: spec.Namespace})
+	if err == nil && sub != nil && sub.Source != "" {
+		spec.Format = sub.Format
+		spec.Source = sub.Source
+	}
+
+	return spec, nil
+}
+
+// jobChanges lists the fields a job diff changes, e.g.
+// "web.server.Config.image: nginx:1.24 → nginx:1.25".
+func jobChanges(diff *api.JobDiff) []string {
+	changes := fieldChanges("", diff.Fields, diff.Objects)
+	for _, tg := range diff.TaskGroups {
+		if tg.Type == diffTypeNone {
+			continue
+		}
+
+		changes = append(changes, fieldChanges(tg.Name+".", tg.Fields, tg.Objects)...)
+		for _, task := range tg.Tasks {
+			if task.Type == diffTypeNone {
+				continue
+			}
+
+			changes = append(changes, fieldChanges(tg.Name+"."+task.Name+".", task.Fields, task.Objects)...)
+		}
+	}
+
+	return changes
+}
+
+func fieldChanges(prefix string, fields []*api.FieldDiff, objects []*api.ObjectDiff) []string {
+	changes := []string{}
+	for _, f := range fields {
+		if f.Type == diffTypeNone {
+			continue
+		}
+
+		changes = append(changes, fmt.Sprintf("%s%s: %s → %s", prefix, f.Name, orNone(f.Old), orNone(f.New)))
+	}
+
+	for _, o := range objects {
+		if o.Type == diffTypeNone {
+			continue
+		}
+
+		changes = append(changes, fieldChanges(prefix+o.Name+".", o.Fields, o.Objects)...)
+	}
+
+	return changes
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+
+	return value
+}
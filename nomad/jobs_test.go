@@ -163,21 +163,181 @@ func TestGetJob(t *testing.T) {
 	t.Run("When everything is fine", func(t *testing.T) {
 		id := "test"
 		fakeJobClient.InfoReturns(&api.Job{ID: &id}, nil, nil)
-		job, err := client.GetJob("test")
+		job, err := client.GetJob("prod", "test")
 		r.NoError(err)
 
 		actualJobID, queryOptions := fakeJobClient.InfoArgsForCall(0)
 
 		r.Equal(actualJobID, "test")
 		r.Equal(*job.ID, "test")
-		r.Nil(queryOptions)
+		r.Equal("prod", queryOptions.Namespace)
 	})
 
 	t.Run("When the client is failing", func(t *testing.T) {
 		fakeJobClient.InfoReturns(nil, nil, errors.New("argh"))
 
-		_, err := client.GetJob("test")
+		_, err := client.GetJob("prod", "test")
 		r.Error(err)
 		r.EqualError(err, "argh")
 	})
 }
+
+func TestJobVersions(t *testing.T) {
+	r := require.New(t)
+
+	fakeJobClient := &nomadfakes.FakeJobClient{}
+	client := &nomad.Nomad{JobClient: fakeJobClient}
+
+	t.Run("When everything is fine", func(t *testing.T) {
+		now := time.Now().UnixNano()
+		v0, v1 := uint64(0), uint64(1)
+		stable := true
+
+		fakeJobClient.VersionsReturns([]*api.Job{
+			{Version: &v1, SubmitTime: &now},
+			{Version: &v0, Stable: &stable, SubmitTime: &now},
+		}, []*api.JobDiff{
+			{
+				Type: "Edited",
+				TaskGroups: []*api.TaskGroupDiff{
+					{
+						Type: "Edited",
+						Name: "web",
+						Fields: []*api.FieldDiff{
+							{Type: "Edited", Name: "Count", Old: "1", New: "2"},
+						},
+						Tasks: []*api.TaskDiff{
+							{
+								Type: "Edited",
+								Name: "server",
+								Objects: []*api.ObjectDiff{
+									{
+										Type: "Edited",
+										Name: "Config",
+										Fields: []*api.FieldDiff{
+											{Type: "Edited", Name: "image", Old: "nginx:1.24", New: "nginx:1.25"},
+											{Type: "None", Name: "ports", Old: "http", New: "http"},
+										},
+									},
+								},
+							},
+						},
+					},
+					{Type: "None", Name: "cache"},
+				},
+			},
+		}, nil, nil)
+
+		versions, err := client.JobVersions("web", &nomad.SearchOptions{Namespace: "prod"})
+		r.NoError(err)
+
+		jobID, diffs, queryOptions := fakeJobClient.VersionsArgsForCall(0)
+		r.Equal("web", jobID)
+		r.True(diffs)
+		r.Equal(&api.QueryOptions{Namespace: "prod"}, queryOptions)
+
+		r.Equal([]*models.JobVersion{
+			{
+				Version:    1,
+				SubmitTime: time.Unix(0, now),
+				Changes: []string{
+					"web.Count: 1 → 2",
+					"web.server.Config.image: nginx:1.24 → nginx:1.25",
+				},
+			},
+			{
+				Version:    0,
+				Stable:     true,
+				SubmitTime: time.Unix(0, now),
+			},
+		}, versions)
+	})
+
+	t.Run("When the client is failing", func(t *testing.T) {
+		fakeJobClient.VersionsReturns(nil, nil, nil, errors.New("argh"))
+
+		_, err := client.JobVersions("web", nil)
+		r.Error(err)
+		r.EqualError(err, "failed to retrieve job versions: argh")
+	})
+}
+
+func TestScaleJob(t *testing.T) {
+	r := require.New(t)
+
+	fakeJobClient := &nomadfakes.FakeJobClient{}
+	client := &nomad.Nomad{JobClient: fakeJobClient}
+
+	t.Run("When everything is fine", func(t *testing.T) {
+		fakeJobClient.ScaleReturns(&api.JobRegisterResponse{}, &api.WriteMeta{}, nil)
+
+		err := client.ScaleJob("prod", "web", "frontend", 3)
+		r.NoError(err)
+
+		jobID, group, count, msg, policyOverride, meta, writeOpts := fakeJobClient.ScaleArgsForCall(0)
+		r.Equal("web", jobID)
+		r.Equal("frontend", group)
+		r.Equal(3, *count)
+		r.Equal("scaled to 3 by damon", msg)
+		r.False(policyOverride)
+		r.Nil(meta)
+		r.Equal(&api.WriteOptions{Namespace: "prod"}, writeOpts)
+	})
+
+	t.Run("When the client is failing", func(t *testing.T) {
+		fakeJobClient.ScaleReturns(nil, nil, errors.New("argh"))
+
+		err := client.ScaleJob("prod", "web", "frontend", 3)
+		r.EqualError(err, "argh")
+	})
+}
+
+func TestJobSpec(t *testing.T) {
+	r := require.New(t)
+
+	version := uint64(4)
+	job := &api.Job{
+		ID:        stringPtr("web"),
+		Namespace: stringPtr("prod"),
+		Version:   &version,
+	}
+
+	t.Run("When the source of the job is available", func(t *testing.T) {
+		fakeJobClient := &nomadfakes.FakeJobClient{}
+		client := &nomad.Nomad{JobClient: fakeJobClient}
+
+		fakeJobClient.SubmissionReturns(&api.JobSubmission{
+			Source: `job "web" {}`,
+			Format: "hcl2",
+		}, nil, nil)
+
+		spec, err := client.JobSpec(job)
+		r.NoError(err)
+
+		jobID, version, opts := fakeJobClient.SubmissionArgsForCall(0)
+		r.Equal("web", jobID)
+		r.Equal(4, version)
+		r.Equal(&api.QueryOptions{Namespace: "prod"}, opts)
+
+		r.Equal("web", spec.ID)
+		r.Equal("prod", spec.Namespace)
+		r.Equal(uint64(4), spec.Version)
+		r.Equal(models.JobSpecFormatHCL2, spec.Format)
+		r.Equal(`job "web" {}`, spec.Source)
+		r.Contains(spec.JSON, `"ID": "web"`)
+	})
+
+	t.Run("When the source of the job isn't available", func(t *testing.T) {
+		fakeJobClient := &nomadfakes.FakeJobClient{}
+		client := &nomad.Nomad{JobClient: fakeJobClient}
+
+		fakeJobClient.SubmissionReturns(nil, nil, errors.New("job source not found"))
+
+		spec, err := client.JobSpec(job)
+		r.NoError(err)
+
+		r.Equal(models.JobSpecFormatJSON, spec.Format)
+		r.Empty(spec.Source)
+		r.Contains(spec.JSON, `"Namespace": "prod"`)
+	})
+}
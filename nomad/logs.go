@@ -3,18 +3,144 @@
 
 package nomad
 
-import "github.com/hashicorp/nomad/api"
+import (
+	"bytes"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+const (
+	// defaultLogOffset is the number of bytes from the end
+	// of a log a stream starts with by default.
+	defaultLogOffset = 20000
+
+	// logLineBytes is the estimated size of a log line, used
+	// to derive the offset of a stream from the line limit.
+	logLineBytes = 128
+
+	// maxTailBytes limits how much of a log is read to find
+	// where the last lines start.
+	maxTailBytes = 16 << 20
+)
+
+// WithLogLines sets the offset of log streams such that they
+// start with roughly the given number of lines.
+func WithLogLines(lines int) func(*Nomad) error {
+	return func(n *Nomad) error {
+		n.LogOffset = int64(lines) * logLineBytes
+		return nil
+	}
+}
+
+// Logs streams the log of a task from the given start, or
+// from the end of the log if start is nil.
+// TODO fix bug with task name
+func (n *Nomad) Logs(allocID, taskName, logType string, start *models.LogStart, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
+	origin, offset := models.LogOriginEnd, n.LogOffset
+	if offset <= 0 {
+		offset = defaultLogOffset
+	}
+
+	if start != nil {
+		origin, offset = start.Origin, start.Offset
+
+		if start.Lines > 0 {
+			var err error
+			origin = models.LogOriginEnd
+			offset, err = tailOffset(start.Lines, func(size int64) ([]byte, error) {
+				return n.readLog(allocID, taskName, logType, models.LogOriginEnd, size)
+			})
+			if err != nil {
+				errCh := make(chan error, 1)
+				errCh <- err
+				return nil, errCh
+			}
+		}
+	}
 
-//TODO fix bug with task name
-func (n *Nomad) Logs(allocID, taskName, logType string, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
 	return n.AllocFSClient.Logs(
 		&api.Allocation{ID: allocID},
 		true,
 		taskName,
 		logType,
-		"end",
-		20000,
+		origin,
+		offset,
+		cancel,
+		&api.QueryOptions{},
+	)
+}
+
+// FullLog reads the whole log of a task.
+func (n *Nomad) FullLog(allocID, taskName, logType string) ([]byte, error) {
+	return n.readLog(allocID, taskName, logType, models.LogOriginStart, 0)
+}
+
+// readLog reads the log of a task from the offset
+// until the current end of the log.
+func (n *Nomad) readLog(allocID, taskName, logType, origin string, offset int64) ([]byte, error) {
+	cancel := make(chan struct{})
+	defer close(cancel)
+
+	streamCh, errorCh := n.AllocFSClient.Logs(
+		&api.Allocation{ID: allocID},
+		false,
+		taskName,
+		logType,
+		origin,
+		offset,
 		cancel,
 		&api.QueryOptions{},
 	)
+
+	buf := bytes.Buffer{}
+	for {
+		select {
+		case frame, ok := <-streamCh:
+			if !ok {
+				return buf.Bytes(), nil
+			}
+
+			if frame != nil {
+				buf.Write(frame.Data)
+			}
+		case err := <-errorCh:
+			return nil, err
+		}
+	}
+}
+
+// tailOffset returns the number of bytes from the end of a log or
+// file which hold the last lines. readTail reads the last size bytes,
+// in growing chunks until they hold enough lines or the whole content.
+func tailOffset(lines int, readTail func(size int64) ([]byte, error)) (int64, error) {
+	size := int64(lines+1) * logLineBytes
+	for {
+		data, err := readTail(size)
+		if err != nil {
+			return 0, err
+		}
+
+		// ignore the newline which ends the last line
+		content := bytes.TrimSuffix(data, []byte("\n"))
+
+		count := 0
+		for i := len(content) - 1; i >= 0; i-- {
+			if content[i] != '\n' {
+				continue
+			}
+
+			count++
+			if count == lines {
+				return int64(len(data) - i - 1), nil
+			}
+		}
+
+		if int64(len(data)) < size || size >= maxTailBytes {
+			return int64(len(data)), nil
+		}
+
+		size *= 2
+	}
 }
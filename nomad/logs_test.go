@@ -4,11 +4,13 @@
 package nomad_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/nomad/api"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hcjulz/damon/models"
 	"github.com/hcjulz/damon/nomad"
 	"github.com/hcjulz/damon/nomad/nomadfakes"
 )
@@ -25,7 +27,7 @@ func TestLogs(t *testing.T) {
 		logType := "stderr"
 		cancelCh := make(<-chan struct{})
 
-		client.Logs(allocID, taskName, logType, cancelCh)
+		client.Logs(allocID, taskName, logType, nil, cancelCh)
 
 		alloc,
 			doFollow,
@@ -55,9 +57,105 @@ func TestLogs(t *testing.T) {
 		errorChan := make(<-chan error)
 
 		fakeFSClient.LogsReturns(streamChan, errorChan)
-		actualStreamChan, actualErrorChan := client.Logs(allocID, taskName, logType, cancelCh)
+		actualStreamChan, actualErrorChan := client.Logs(allocID, taskName, logType, nil, cancelCh)
 
 		r.Equal(actualStreamChan, streamChan)
 		r.Equal(actualErrorChan, errorChan)
 	})
+
+	t.Run("It derives the offset from the line limit", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client, err := nomad.New(nomad.WithLogLines(500))
+		r.NoError(err)
+		client.AllocFSClient = fakeFSClient
+
+		client.Logs("moon", "solar-system", "stdout", nil, make(<-chan struct{}))
+
+		_, _, _, _, _, offset, _, _ := fakeFSClient.LogsArgsForCall(0)
+		r.Equal(int64(500*128), offset)
+	})
+
+	t.Run("It starts at the given origin and offset", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		start := &models.LogStart{Origin: models.LogOriginStart, Offset: 1024}
+		client.Logs("moon", "solar-system", "stdout", start, make(<-chan struct{}))
+
+		_, follow, _, _, origin, offset, _, _ := fakeFSClient.LogsArgsForCall(0)
+		r.True(follow)
+		r.Equal("start", origin)
+		r.Equal(int64(1024), offset)
+	})
+
+	t.Run("It starts with the last lines", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		fakeFSClient.LogsStub = fakeLog([]byte("one\ntwo\nthree\nfour\n"))
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		start := &models.LogStart{Lines: 2}
+		client.Logs("moon", "solar-system", "stdout", start, make(<-chan struct{}))
+
+		// the tail of the log is read first to find where the lines start
+		_, follow, _, _, origin, _, _, _ := fakeFSClient.LogsArgsForCall(0)
+		r.False(follow)
+		r.Equal("end", origin)
+
+		_, follow, _, _, origin, offset, _, _ := fakeFSClient.LogsArgsForCall(1)
+		r.True(follow)
+		r.Equal("end", origin)
+		r.Equal(int64(len("three\nfour\n")), offset)
+	})
+}
+
+func TestFullLog(t *testing.T) {
+	r := require.New(t)
+
+	t.Run("It reads the whole log", func(t *testing.T) {
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		fakeFSClient.LogsStub = fakeLog([]byte("first\n"), []byte("second\n"))
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		log, err := client.FullLog("moon", "solar-system", "stderr")
+		r.NoError(err)
+		r.Equal("first\nsecond\n", string(log))
+
+		_, follow, _, logType, origin, offset, _, _ := fakeFSClient.LogsArgsForCall(0)
+		r.False(follow)
+		r.Equal("stderr", logType)
+		r.Equal("start", origin)
+		r.Equal(int64(0), offset)
+	})
+
+	t.Run("It returns stream errors", func(t *testing.T) {
+		errCh := make(chan error, 1)
+		errCh <- errors.New("argh")
+
+		fakeFSClient := &nomadfakes.FakeAllocFSClient{}
+		fakeFSClient.LogsReturns(nil, errCh)
+		client := &nomad.Nomad{AllocFSClient: fakeFSClient}
+
+		_, err := client.FullLog("moon", "solar-system", "stderr")
+		r.EqualError(err, "argh")
+	})
+}
+
+// fakeLog returns a stub for AllocFSClient.Logs which sends
+// the frames and closes the stream, like a finished log.
+func fakeLog(frames ...[]byte) func(*api.Allocation, bool, string, string, string, int64, <-chan struct{}, *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error) {
+	return func(*api.Allocation, bool, string, string, string, int64, <-chan struct{}, *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error) {
+		streamCh := make(chan *api.StreamFrame, len(frames))
+		for _, data := range frames {
+			streamCh <- &api.StreamFrame{Data: data}
+		}
+
+		close(streamCh)
+		return streamCh, make(chan error)
+	}
 }
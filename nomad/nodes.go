@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"sort"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// Nodes lists the clients of the cluster sorted by name.
+func (n *Nomad) Nodes() ([]*models.Node, error) {
+	list, _, err := n.NodeClient.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*models.Node, 0, len(list))
+	for _, node := range list {
+		nodes = append(nodes, &models.Node{
+			ID:                node.ID,
+			Name:              node.Name,
+			Address:           node.Address,
+			Datacenter:        node.Datacenter,
+			NodeClass:         node.NodeClass,
+			NodePool:          node.NodePool,
+			Version:           node.Version,
+			Status:            node.Status,
+			StatusDescription: node.StatusDescription,
+			Eligibility:       node.SchedulingEligibility,
+			Drain:             node.Drain,
+		})
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Name != nodes[j].Name {
+			return nodes[i].Name < nodes[j].Name
+		}
+
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	return nodes, nil
+}
+
+// NodeDetail returns the details of a client with its
+// allocations, the most recent allocation first.
+func (n *Nomad) NodeDetail(nodeID string) (*models.NodeDetail, error) {
+	node, _, err := n.NodeClient.Info(nodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	allocs, _, err := n.NodeClient.Allocations(nodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &models.NodeDetail{
+		Node: models.Node{
+			ID:                node.ID,
+			Name:              node.Name,
+			Address:           node.Attributes["unique.network.ip-address"],
+			Datacenter:        node.Datacenter,
+			NodeClass:         node.NodeClass,
+			NodePool:          node.NodePool,
+			Version:           node.Attributes["nomad.version"],
+			Status:            node.Status,
+			StatusDescription: node.StatusDescription,
+			Eligibility:       node.SchedulingEligibility,
+			Drain:             node.Drain,
+		},
+		HTTPAddress: node.HTTPAddr,
+		Attributes:  node.Attributes,
+		Meta:        node.Meta,
+		Events:      node.Events,
+	}
+
+	for name, d := range node.Drivers {
+		detail.Drivers = append(detail.Drivers, &models.NodeDriver{
+			Name:              name,
+			Detected:          d.Detected,
+			Healthy:           d.Healthy,
+			HealthDescription: d.HealthDescription,
+			Updated:           d.UpdateTime,
+		})
+	}
+
+	sort.Slice(detail.Drivers, func(i, j int) bool {
+		return detail.Drivers[i].Name < detail.Drivers[j].Name
+	})
+
+	for name, v := range node.HostVolumes {
+		detail.HostVolumes = append(detail.HostVolumes, &models.NodeHostVolume{
+			Name:     name,
+			Path:     v.Path,
+			ReadOnly: v.ReadOnly,
+		})
+	}
+
+	sort.Slice(detail.HostVolumes, func(i, j int) bool {
+		return detail.HostVolumes[i].Name < detail.HostVolumes[j].Name
+	})
+
+	if r := node.NodeResources; r != nil {
+		detail.Total = models.NodeResources{
+			CPU:      r.Cpu.CpuShares,
+			MemoryMB: r.Memory.MemoryMB,
+			DiskMB:   r.Disk.DiskMB,
+		}
+	}
+
+	if r := node.ReservedResources; r != nil {
+		detail.Reserved = models.NodeResources{
+			CPU:      int64(r.Cpu.CpuShares),
+			MemoryMB: int64(r.Memory.MemoryMB),
+			DiskMB:   int64(r.Disk.DiskMB),
+		}
+	}
+
+	for _, a := range allocs {
+		// the tasks are only known with the job
+		if a.Job == nil {
+			continue
+		}
+
+		detail.Allocations = append(detail.Allocations, toAlloc(a.Stub(), a))
+	}
+
+	sort.SliceStable(detail.Allocations, func(i, j int) bool {
+		return detail.Allocations[i].Created.After(detail.Allocations[j].Created)
+	})
+
+	return detail, nil
+}
+
+// NodePools lists the node pools of the cluster sorted by name.
+func (n *Nomad) NodePools() ([]*models.NodePool, error) {
+	list, _, err := n.PoolClient.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]*models.NodePool, 0, len(list))
+	for _, p := range list {
+		pool := &models.NodePool{
+			Name:        p.Name,
+			Description: p.Description,
+			Meta:        p.Meta,
+		}
+
+		if c := p.SchedulerConfiguration; c != nil {
+			pool.SchedulerAlgorithm = string(c.SchedulerAlgorithm)
+		}
+
+		pools = append(pools, pool)
+	}
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].Name < pools[j].Name
+	})
+
+	return pools, nil
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestNodes(t *testing.T) {
+	t.Run("It lists the nodes sorted by name", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeNodes := &nomadfakes.FakeNodesClient{}
+		client := &nomad.Nomad{NodeClient: fakeNodes}
+
+		fakeNodes.ListReturns([]*api.NodeListStub{
+			{ID: "2", Name: "client-2", Address: "10.0.0.2", Datacenter: "dc1", NodePool: "default", Version: "1.6.1", Status: "down", SchedulingEligibility: "ineligible", Drain: true},
+			{ID: "1", Name: "client-1", Datacenter: "dc1", NodeClass: "gpu", NodePool: "gpu", Status: "ready", SchedulingEligibility: "eligible"},
+		}, nil, nil)
+
+		nodes, err := client.Nodes()
+		r.NoError(err)
+		r.Equal([]*models.Node{
+			{ID: "1", Name: "client-1", Datacenter: "dc1", NodeClass: "gpu", NodePool: "gpu", Status: "ready", Eligibility: "eligible"},
+			{ID: "2", Name: "client-2", Address: "10.0.0.2", Datacenter: "dc1", NodePool: "default", Version: "1.6.1", Status: "down", Eligibility: "ineligible", Drain: true},
+		}, nodes)
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeNodes := &nomadfakes.FakeNodesClient{}
+		client := &nomad.Nomad{NodeClient: fakeNodes}
+
+		fakeNodes.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Nodes()
+		r.EqualError(err, "argh")
+	})
+}
+
+func TestNodeDetail(t *testing.T) {
+	version := uint64(3)
+	job := &api.Job{
+		Type:       stringPtr("service"),
+		Version:    &version,
+		TaskGroups: []*api.TaskGroup{{Name: stringPtr("web")}},
+	}
+
+	t.Run("It returns the details and the allocations of the node", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeNodes := &nomadfakes.FakeNodesClient{}
+		client := &nomad.Nomad{NodeClient: fakeNodes}
+
+		updated := time.Unix(1000, 0)
+		fakeNodes.InfoReturns(&api.Node{
+			ID:                    "1",
+			Name:                  "client-1",
+			HTTPAddr:              "10.0.0.1:4646",
+			Datacenter:            "dc1",
+			NodePool:              "default",
+			Status:                "ready",
+			SchedulingEligibility: "eligible",
+			Attributes:            map[string]string{"unique.network.ip-address": "10.0.0.1", "nomad.version": "1.6.1"},
+			Meta:                  map[string]string{"rack": "r1"},
+			Drivers: map[string]*api.DriverInfo{
+				"raw_exec": {Detected: false},
+				"docker":   {Detected: true, Healthy: true, HealthDescription: "Healthy", UpdateTime: updated},
+			},
+			HostVolumes: map[string]*api.HostVolumeInfo{
+				"data":  {Path: "/srv/data"},
+				"certs": {Path: "/etc/certs", ReadOnly: true},
+			},
+			NodeResources: &api.NodeResources{
+				Cpu:    api.NodeCpuResources{CpuShares: 4000},
+				Memory: api.NodeMemoryResources{MemoryMB: 8192},
+				Disk:   api.NodeDiskResources{DiskMB: 50000},
+			},
+			ReservedResources: &api.NodeReservedResources{
+				Cpu:    api.NodeReservedCpuResources{CpuShares: 500},
+				Memory: api.NodeReservedMemoryResources{MemoryMB: 512},
+			},
+		}, nil, nil)
+		fakeNodes.AllocationsReturns([]*api.Allocation{
+			{ID: "a1", JobID: "web", TaskGroup: "web", NodeID: "1", ClientStatus: "complete", CreateTime: 1, Job: job},
+			{ID: "a2", JobID: "web", TaskGroup: "web", NodeID: "1", ClientStatus: "running", CreateTime: 2, Job: job},
+			{ID: "a3", JobID: "gone", TaskGroup: "web", NodeID: "1"},
+		}, nil, nil)
+
+		detail, err := client.NodeDetail("1")
+		r.NoError(err)
+
+		id, _ := fakeNodes.InfoArgsForCall(0)
+		r.Equal("1", id)
+		id, _ = fakeNodes.AllocationsArgsForCall(0)
+		r.Equal("1", id)
+
+		r.Equal(models.Node{ID: "1", Name: "client-1", Address: "10.0.0.1", Datacenter: "dc1", NodePool: "default", Version: "1.6.1", Status: "ready", Eligibility: "eligible"}, detail.Node)
+		r.Equal("10.0.0.1:4646", detail.HTTPAddress)
+		r.Equal(map[string]string{"rack": "r1"}, detail.Meta)
+		r.Equal([]*models.NodeDriver{
+			{Name: "docker", Detected: true, Healthy: true, HealthDescription: "Healthy", Updated: updated},
+			{Name: "raw_exec"},
+		}, detail.Drivers)
+		r.Equal([]*models.NodeHostVolume{
+			{Name: "certs", Path: "/etc/certs", ReadOnly: true},
+			{Name: "data", Path: "/srv/data"},
+		}, detail.HostVolumes)
+		r.Equal(models.NodeResources{CPU: 4000, MemoryMB: 8192, DiskMB: 50000}, detail.Total)
+		r.Equal(models.NodeResources{CPU: 500, MemoryMB: 512}, detail.Reserved)
+
+		r.Len(detail.Allocations, 2)
+		r.Equal("a2", detail.Allocations[0].ID)
+		r.Equal("service", detail.Allocations[0].JobType)
+		r.Equal(uint64(3), detail.Allocations[0].Version)
+		r.Equal("a1", detail.Allocations[1].ID)
+	})
+
+	t.Run("When the node can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeNodes := &nomadfakes.FakeNodesClient{}
+		client := &nomad.Nomad{NodeClient: fakeNodes}
+
+		fakeNodes.InfoReturns(nil, nil, errors.New("node not found"))
+
+		_, err := client.NodeDetail("1")
+		r.EqualError(err, "node not found")
+	})
+
+	t.Run("When the allocations can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeNodes := &nomadfakes.FakeNodesClient{}
+		client := &nomad.Nomad{NodeClient: fakeNodes}
+
+		fakeNodes.InfoReturns(&api.Node{ID: "1"}, nil, nil)
+		fakeNodes.AllocationsReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.NodeDetail("1")
+		r.EqualError(err, "argh")
+	})
+}
+
+func TestNodePools(t *testing.T) {
+	t.Run("It lists the node pools sorted by name", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePools := &nomadfakes.FakeNodePoolsClient{}
+		client := &nomad.Nomad{PoolClient: fakePools}
+
+		fakePools.ListReturns([]*api.NodePool{
+			{Name: "gpu", Description: "GPU clients", SchedulerConfiguration: &api.NodePoolSchedulerConfiguration{SchedulerAlgorithm: api.SchedulerAlgorithmSpread}},
+			{Name: "all"},
+			{Name: "default"},
+		}, nil, nil)
+
+		pools, err := client.NodePools()
+		r.NoError(err)
+		r.Equal([]*models.NodePool{
+			{Name: "all"},
+			{Name: "default"},
+			{Name: "gpu", Description: "GPU clients", SchedulerAlgorithm: "spread"},
+		}, pools)
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakePools := &nomadfakes.FakeNodePoolsClient{}
+		client := &nomad.Nomad{PoolClient: fakePools}
+
+		fakePools.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.NodePools()
+		r.EqualError(err, "argh")
+	})
+}
@@ -0,0 +1,290 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeACLPoliciesClient struct {
+	InfoStub        func(string, *api.QueryOptions) (*api.ACLPolicy, *api.QueryMeta, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	infoReturns struct {
+		result1 *api.ACLPolicy
+		result2 *api.QueryMeta
+		result3 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 *api.ACLPolicy
+		result2 *api.QueryMeta
+		result3 error
+	}
+	ListStub        func(*api.QueryOptions) ([]*api.ACLPolicyListStub, *api.QueryMeta, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	listReturns struct {
+		result1 []*api.ACLPolicyListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []*api.ACLPolicyListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	UpsertStub        func(*api.ACLPolicy, *api.WriteOptions) (*api.WriteMeta, error)
+	upsertMutex       sync.RWMutex
+	upsertArgsForCall []struct {
+		arg1 *api.ACLPolicy
+		arg2 *api.WriteOptions
+	}
+	upsertReturns struct {
+		result1 *api.WriteMeta
+		result2 error
+	}
+	upsertReturnsOnCall map[int]struct {
+		result1 *api.WriteMeta
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeACLPoliciesClient) Info(arg1 string, arg2 *api.QueryOptions) (*api.ACLPolicy, *api.QueryMeta, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.InfoStub
+	fakeReturns := fake.infoReturns
+	fake.recordInvocation("Info", []interface{}{arg1, arg2})
+	fake.infoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeACLPoliciesClient) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeACLPoliciesClient) InfoCalls(stub func(string, *api.QueryOptions) (*api.ACLPolicy, *api.QueryMeta, error)) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = stub
+}
+
+func (fake *FakeACLPoliciesClient) InfoArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	argsForCall := fake.infoArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeACLPoliciesClient) InfoReturns(result1 *api.ACLPolicy, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 *api.ACLPolicy
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLPoliciesClient) InfoReturnsOnCall(i int, result1 *api.ACLPolicy, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 *api.ACLPolicy
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 *api.ACLPolicy
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLPoliciesClient) List(arg1 *api.QueryOptions) ([]*api.ACLPolicyListStub, *api.QueryMeta, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeACLPoliciesClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeACLPoliciesClient) ListCalls(stub func(*api.QueryOptions) ([]*api.ACLPolicyListStub, *api.QueryMeta, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeACLPoliciesClient) ListArgsForCall(i int) *api.QueryOptions {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeACLPoliciesClient) ListReturns(result1 []*api.ACLPolicyListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []*api.ACLPolicyListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLPoliciesClient) ListReturnsOnCall(i int, result1 []*api.ACLPolicyListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []*api.ACLPolicyListStub
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []*api.ACLPolicyListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLPoliciesClient) Upsert(arg1 *api.ACLPolicy, arg2 *api.WriteOptions) (*api.WriteMeta, error) {
+	fake.upsertMutex.Lock()
+	ret, specificReturn := fake.upsertReturnsOnCall[len(fake.upsertArgsForCall)]
+	fake.upsertArgsForCall = append(fake.upsertArgsForCall, struct {
+		arg1 *api.ACLPolicy
+		arg2 *api.WriteOptions
+	}{arg1, arg2})
+	stub := fake.UpsertStub
+	fakeReturns := fake.upsertReturns
+	fake.recordInvocation("Upsert", []interface{}{arg1, arg2})
+	fake.upsertMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeACLPoliciesClient) UpsertCallCount() int {
+	fake.upsertMutex.RLock()
+	defer fake.upsertMutex.RUnlock()
+	return len(fake.upsertArgsForCall)
+}
+
+func (fake *FakeACLPoliciesClient) UpsertCalls(stub func(*api.ACLPolicy, *api.WriteOptions) (*api.WriteMeta, error)) {
+	fake.upsertMutex.Lock()
+	defer fake.upsertMutex.Unlock()
+	fake.UpsertStub = stub
+}
+
+func (fake *FakeACLPoliciesClient) UpsertArgsForCall(i int) (*api.ACLPolicy, *api.WriteOptions) {
+	fake.upsertMutex.RLock()
+	defer fake.upsertMutex.RUnlock()
+	argsForCall := fake.upsertArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeACLPoliciesClient) UpsertReturns(result1 *api.WriteMeta, result2 error) {
+	fake.upsertMutex.Lock()
+	defer fake.upsertMutex.Unlock()
+	fake.UpsertStub = nil
+	fake.upsertReturns = struct {
+		result1 *api.WriteMeta
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeACLPoliciesClient) UpsertReturnsOnCall(i int, result1 *api.WriteMeta, result2 error) {
+	fake.upsertMutex.Lock()
+	defer fake.upsertMutex.Unlock()
+	fake.UpsertStub = nil
+	if fake.upsertReturnsOnCall == nil {
+		fake.upsertReturnsOnCall = make(map[int]struct {
+			result1 *api.WriteMeta
+			result2 error
+		})
+	}
+	fake.upsertReturnsOnCall[i] = struct {
+		result1 *api.WriteMeta
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeACLPoliciesClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.upsertMutex.RLock()
+	defer fake.upsertMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeACLPoliciesClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.ACLPoliciesClient = new(FakeACLPoliciesClient)
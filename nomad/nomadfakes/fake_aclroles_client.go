@@ -0,0 +1,209 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeACLRolesClient struct {
+	GetStub        func(string, *api.QueryOptions) (*api.ACLRole, *api.QueryMeta, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	getReturns struct {
+		result1 *api.ACLRole
+		result2 *api.QueryMeta
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *api.ACLRole
+		result2 *api.QueryMeta
+		result3 error
+	}
+	ListStub        func(*api.QueryOptions) ([]*api.ACLRoleListStub, *api.QueryMeta, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	listReturns struct {
+		result1 []*api.ACLRoleListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []*api.ACLRoleListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeACLRolesClient) Get(arg1 string, arg2 *api.QueryOptions) (*api.ACLRole, *api.QueryMeta, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeACLRolesClient) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeACLRolesClient) GetCalls(stub func(string, *api.QueryOptions) (*api.ACLRole, *api.QueryMeta, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeACLRolesClient) GetArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeACLRolesClient) GetReturns(result1 *api.ACLRole, result2 *api.QueryMeta, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *api.ACLRole
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLRolesClient) GetReturnsOnCall(i int, result1 *api.ACLRole, result2 *api.QueryMeta, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *api.ACLRole
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *api.ACLRole
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLRolesClient) List(arg1 *api.QueryOptions) ([]*api.ACLRoleListStub, *api.QueryMeta, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeACLRolesClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeACLRolesClient) ListCalls(stub func(*api.QueryOptions) ([]*api.ACLRoleListStub, *api.QueryMeta, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeACLRolesClient) ListArgsForCall(i int) *api.QueryOptions {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeACLRolesClient) ListReturns(result1 []*api.ACLRoleListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []*api.ACLRoleListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLRolesClient) ListReturnsOnCall(i int, result1 []*api.ACLRoleListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []*api.ACLRoleListStub
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []*api.ACLRoleListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLRolesClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeACLRolesClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.ACLRolesClient = new(FakeACLRolesClient)
@@ -0,0 +1,288 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeACLTokensClient struct {
+	DeleteStub        func(string, *api.WriteOptions) (*api.WriteMeta, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 *api.WriteOptions
+	}
+	deleteReturns struct {
+		result1 *api.WriteMeta
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 *api.WriteMeta
+		result2 error
+	}
+	ListStub        func(*api.QueryOptions) ([]*api.ACLTokenListStub, *api.QueryMeta, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	listReturns struct {
+		result1 []*api.ACLTokenListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []*api.ACLTokenListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	SelfStub        func(*api.QueryOptions) (*api.ACLToken, *api.QueryMeta, error)
+	selfMutex       sync.RWMutex
+	selfArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	selfReturns struct {
+		result1 *api.ACLToken
+		result2 *api.QueryMeta
+		result3 error
+	}
+	selfReturnsOnCall map[int]struct {
+		result1 *api.ACLToken
+		result2 *api.QueryMeta
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeACLTokensClient) Delete(arg1 string, arg2 *api.WriteOptions) (*api.WriteMeta, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 *api.WriteOptions
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeACLTokensClient) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeACLTokensClient) DeleteCalls(stub func(string, *api.WriteOptions) (*api.WriteMeta, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeACLTokensClient) DeleteArgsForCall(i int) (string, *api.WriteOptions) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeACLTokensClient) DeleteReturns(result1 *api.WriteMeta, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 *api.WriteMeta
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeACLTokensClient) DeleteReturnsOnCall(i int, result1 *api.WriteMeta, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 *api.WriteMeta
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 *api.WriteMeta
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeACLTokensClient) List(arg1 *api.QueryOptions) ([]*api.ACLTokenListStub, *api.QueryMeta, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeACLTokensClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeACLTokensClient) ListCalls(stub func(*api.QueryOptions) ([]*api.ACLTokenListStub, *api.QueryMeta, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeACLTokensClient) ListArgsForCall(i int) *api.QueryOptions {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeACLTokensClient) ListReturns(result1 []*api.ACLTokenListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []*api.ACLTokenListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLTokensClient) ListReturnsOnCall(i int, result1 []*api.ACLTokenListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []*api.ACLTokenListStub
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []*api.ACLTokenListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLTokensClient) Self(arg1 *api.QueryOptions) (*api.ACLToken, *api.QueryMeta, error) {
+	fake.selfMutex.Lock()
+	ret, specificReturn := fake.selfReturnsOnCall[len(fake.selfArgsForCall)]
+	fake.selfArgsForCall = append(fake.selfArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.SelfStub
+	fakeReturns := fake.selfReturns
+	fake.recordInvocation("Self", []interface{}{arg1})
+	fake.selfMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeACLTokensClient) SelfCallCount() int {
+	fake.selfMutex.RLock()
+	defer fake.selfMutex.RUnlock()
+	return len(fake.selfArgsForCall)
+}
+
+func (fake *FakeACLTokensClient) SelfCalls(stub func(*api.QueryOptions) (*api.ACLToken, *api.QueryMeta, error)) {
+	fake.selfMutex.Lock()
+	defer fake.selfMutex.Unlock()
+	fake.SelfStub = stub
+}
+
+func (fake *FakeACLTokensClient) SelfArgsForCall(i int) *api.QueryOptions {
+	fake.selfMutex.RLock()
+	defer fake.selfMutex.RUnlock()
+	argsForCall := fake.selfArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeACLTokensClient) SelfReturns(result1 *api.ACLToken, result2 *api.QueryMeta, result3 error) {
+	fake.selfMutex.Lock()
+	defer fake.selfMutex.Unlock()
+	fake.SelfStub = nil
+	fake.selfReturns = struct {
+		result1 *api.ACLToken
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLTokensClient) SelfReturnsOnCall(i int, result1 *api.ACLToken, result2 *api.QueryMeta, result3 error) {
+	fake.selfMutex.Lock()
+	defer fake.selfMutex.Unlock()
+	fake.SelfStub = nil
+	if fake.selfReturnsOnCall == nil {
+		fake.selfReturnsOnCall = make(map[int]struct {
+			result1 *api.ACLToken
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.selfReturnsOnCall[i] = struct {
+		result1 *api.ACLToken
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeACLTokensClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.selfMutex.RLock()
+	defer fake.selfMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeACLTokensClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.ACLTokensClient = new(FakeACLTokensClient)
@@ -2,6 +2,7 @@
 package nomadfakes
 
 import (
+	"io"
 	"sync"
 
 	"github.com/hashicorp/nomad/api"
@@ -9,6 +10,38 @@ import (
 )
 
 type FakeAllocFSClient struct {
+	CatStub        func(*api.Allocation, string, *api.QueryOptions) (io.ReadCloser, error)
+	catMutex       sync.RWMutex
+	catArgsForCall []struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 *api.QueryOptions
+	}
+	catReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	catReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	ListStub        func(*api.Allocation, string, *api.QueryOptions) ([]*api.AllocFileInfo, *api.QueryMeta, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 *api.QueryOptions
+	}
+	listReturns struct {
+		result1 []*api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []*api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}
 	LogsStub        func(*api.Allocation, bool, string, string, string, int64, <-chan struct{}, *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error)
 	logsMutex       sync.RWMutex
 	logsArgsForCall []struct {
@@ -29,10 +62,197 @@ type FakeAllocFSClient struct {
 		result1 <-chan *api.StreamFrame
 		result2 <-chan error
 	}
+	ReadAtStub        func(*api.Allocation, string, int64, int64, *api.QueryOptions) (io.ReadCloser, error)
+	readAtMutex       sync.RWMutex
+	readAtArgsForCall []struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 int64
+		arg4 int64
+		arg5 *api.QueryOptions
+	}
+	readAtReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	readAtReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	StatStub        func(*api.Allocation, string, *api.QueryOptions) (*api.AllocFileInfo, *api.QueryMeta, error)
+	statMutex       sync.RWMutex
+	statArgsForCall []struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 *api.QueryOptions
+	}
+	statReturns struct {
+		result1 *api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}
+	statReturnsOnCall map[int]struct {
+		result1 *api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}
+	StreamStub        func(*api.Allocation, string, string, int64, <-chan struct{}, *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error)
+	streamMutex       sync.RWMutex
+	streamArgsForCall []struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 string
+		arg4 int64
+		arg5 <-chan struct{}
+		arg6 *api.QueryOptions
+	}
+	streamReturns struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}
+	streamReturnsOnCall map[int]struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAllocFSClient) Cat(arg1 *api.Allocation, arg2 string, arg3 *api.QueryOptions) (io.ReadCloser, error) {
+	fake.catMutex.Lock()
+	ret, specificReturn := fake.catReturnsOnCall[len(fake.catArgsForCall)]
+	fake.catArgsForCall = append(fake.catArgsForCall, struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 *api.QueryOptions
+	}{arg1, arg2, arg3})
+	stub := fake.CatStub
+	fakeReturns := fake.catReturns
+	fake.recordInvocation("Cat", []interface{}{arg1, arg2, arg3})
+	fake.catMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAllocFSClient) CatCallCount() int {
+	fake.catMutex.RLock()
+	defer fake.catMutex.RUnlock()
+	return len(fake.catArgsForCall)
+}
+
+func (fake *FakeAllocFSClient) CatCalls(stub func(*api.Allocation, string, *api.QueryOptions) (io.ReadCloser, error)) {
+	fake.catMutex.Lock()
+	defer fake.catMutex.Unlock()
+	fake.CatStub = stub
+}
+
+func (fake *FakeAllocFSClient) CatArgsForCall(i int) (*api.Allocation, string, *api.QueryOptions) {
+	fake.catMutex.RLock()
+	defer fake.catMutex.RUnlock()
+	argsForCall := fake.catArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAllocFSClient) CatReturns(result1 io.ReadCloser, result2 error) {
+	fake.catMutex.Lock()
+	defer fake.catMutex.Unlock()
+	fake.CatStub = nil
+	fake.catReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocFSClient) CatReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.catMutex.Lock()
+	defer fake.catMutex.Unlock()
+	fake.CatStub = nil
+	if fake.catReturnsOnCall == nil {
+		fake.catReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.catReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocFSClient) List(arg1 *api.Allocation, arg2 string, arg3 *api.QueryOptions) ([]*api.AllocFileInfo, *api.QueryMeta, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 *api.QueryOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2, arg3})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAllocFSClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeAllocFSClient) ListCalls(stub func(*api.Allocation, string, *api.QueryOptions) ([]*api.AllocFileInfo, *api.QueryMeta, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeAllocFSClient) ListArgsForCall(i int) (*api.Allocation, string, *api.QueryOptions) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAllocFSClient) ListReturns(result1 []*api.AllocFileInfo, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []*api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAllocFSClient) ListReturnsOnCall(i int, result1 []*api.AllocFileInfo, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []*api.AllocFileInfo
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []*api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeAllocFSClient) Logs(arg1 *api.Allocation, arg2 bool, arg3 string, arg4 string, arg5 string, arg6 int64, arg7 <-chan struct{}, arg8 *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error) {
 	fake.logsMutex.Lock()
 	ret, specificReturn := fake.logsReturnsOnCall[len(fake.logsArgsForCall)]
@@ -104,11 +324,227 @@ func (fake *FakeAllocFSClient) LogsReturnsOnCall(i int, result1 <-chan *api.Stre
 	}{result1, result2}
 }
 
+func (fake *FakeAllocFSClient) ReadAt(arg1 *api.Allocation, arg2 string, arg3 int64, arg4 int64, arg5 *api.QueryOptions) (io.ReadCloser, error) {
+	fake.readAtMutex.Lock()
+	ret, specificReturn := fake.readAtReturnsOnCall[len(fake.readAtArgsForCall)]
+	fake.readAtArgsForCall = append(fake.readAtArgsForCall, struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 int64
+		arg4 int64
+		arg5 *api.QueryOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ReadAtStub
+	fakeReturns := fake.readAtReturns
+	fake.recordInvocation("ReadAt", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.readAtMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAllocFSClient) ReadAtCallCount() int {
+	fake.readAtMutex.RLock()
+	defer fake.readAtMutex.RUnlock()
+	return len(fake.readAtArgsForCall)
+}
+
+func (fake *FakeAllocFSClient) ReadAtCalls(stub func(*api.Allocation, string, int64, int64, *api.QueryOptions) (io.ReadCloser, error)) {
+	fake.readAtMutex.Lock()
+	defer fake.readAtMutex.Unlock()
+	fake.ReadAtStub = stub
+}
+
+func (fake *FakeAllocFSClient) ReadAtArgsForCall(i int) (*api.Allocation, string, int64, int64, *api.QueryOptions) {
+	fake.readAtMutex.RLock()
+	defer fake.readAtMutex.RUnlock()
+	argsForCall := fake.readAtArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAllocFSClient) ReadAtReturns(result1 io.ReadCloser, result2 error) {
+	fake.readAtMutex.Lock()
+	defer fake.readAtMutex.Unlock()
+	fake.ReadAtStub = nil
+	fake.readAtReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocFSClient) ReadAtReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.readAtMutex.Lock()
+	defer fake.readAtMutex.Unlock()
+	fake.ReadAtStub = nil
+	if fake.readAtReturnsOnCall == nil {
+		fake.readAtReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.readAtReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocFSClient) Stat(arg1 *api.Allocation, arg2 string, arg3 *api.QueryOptions) (*api.AllocFileInfo, *api.QueryMeta, error) {
+	fake.statMutex.Lock()
+	ret, specificReturn := fake.statReturnsOnCall[len(fake.statArgsForCall)]
+	fake.statArgsForCall = append(fake.statArgsForCall, struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 *api.QueryOptions
+	}{arg1, arg2, arg3})
+	stub := fake.StatStub
+	fakeReturns := fake.statReturns
+	fake.recordInvocation("Stat", []interface{}{arg1, arg2, arg3})
+	fake.statMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAllocFSClient) StatCallCount() int {
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	return len(fake.statArgsForCall)
+}
+
+func (fake *FakeAllocFSClient) StatCalls(stub func(*api.Allocation, string, *api.QueryOptions) (*api.AllocFileInfo, *api.QueryMeta, error)) {
+	fake.statMutex.Lock()
+	defer fake.statMutex.Unlock()
+	fake.StatStub = stub
+}
+
+func (fake *FakeAllocFSClient) StatArgsForCall(i int) (*api.Allocation, string, *api.QueryOptions) {
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	argsForCall := fake.statArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAllocFSClient) StatReturns(result1 *api.AllocFileInfo, result2 *api.QueryMeta, result3 error) {
+	fake.statMutex.Lock()
+	defer fake.statMutex.Unlock()
+	fake.StatStub = nil
+	fake.statReturns = struct {
+		result1 *api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAllocFSClient) StatReturnsOnCall(i int, result1 *api.AllocFileInfo, result2 *api.QueryMeta, result3 error) {
+	fake.statMutex.Lock()
+	defer fake.statMutex.Unlock()
+	fake.StatStub = nil
+	if fake.statReturnsOnCall == nil {
+		fake.statReturnsOnCall = make(map[int]struct {
+			result1 *api.AllocFileInfo
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.statReturnsOnCall[i] = struct {
+		result1 *api.AllocFileInfo
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAllocFSClient) Stream(arg1 *api.Allocation, arg2 string, arg3 string, arg4 int64, arg5 <-chan struct{}, arg6 *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error) {
+	fake.streamMutex.Lock()
+	ret, specificReturn := fake.streamReturnsOnCall[len(fake.streamArgsForCall)]
+	fake.streamArgsForCall = append(fake.streamArgsForCall, struct {
+		arg1 *api.Allocation
+		arg2 string
+		arg3 string
+		arg4 int64
+		arg5 <-chan struct{}
+		arg6 *api.QueryOptions
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StreamStub
+	fakeReturns := fake.streamReturns
+	fake.recordInvocation("Stream", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.streamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAllocFSClient) StreamCallCount() int {
+	fake.streamMutex.RLock()
+	defer fake.streamMutex.RUnlock()
+	return len(fake.streamArgsForCall)
+}
+
+func (fake *FakeAllocFSClient) StreamCalls(stub func(*api.Allocation, string, string, int64, <-chan struct{}, *api.QueryOptions) (<-chan *api.StreamFrame, <-chan error)) {
+	fake.streamMutex.Lock()
+	defer fake.streamMutex.Unlock()
+	fake.StreamStub = stub
+}
+
+func (fake *FakeAllocFSClient) StreamArgsForCall(i int) (*api.Allocation, string, string, int64, <-chan struct{}, *api.QueryOptions) {
+	fake.streamMutex.RLock()
+	defer fake.streamMutex.RUnlock()
+	argsForCall := fake.streamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeAllocFSClient) StreamReturns(result1 <-chan *api.StreamFrame, result2 <-chan error) {
+	fake.streamMutex.Lock()
+	defer fake.streamMutex.Unlock()
+	fake.StreamStub = nil
+	fake.streamReturns = struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocFSClient) StreamReturnsOnCall(i int, result1 <-chan *api.StreamFrame, result2 <-chan error) {
+	fake.streamMutex.Lock()
+	defer fake.streamMutex.Unlock()
+	fake.StreamStub = nil
+	if fake.streamReturnsOnCall == nil {
+		fake.streamReturnsOnCall = make(map[int]struct {
+			result1 <-chan *api.StreamFrame
+			result2 <-chan error
+		})
+	}
+	fake.streamReturnsOnCall[i] = struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}{result1, result2}
+}
+
 func (fake *FakeAllocFSClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.catMutex.RLock()
+	defer fake.catMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
 	fake.logsMutex.RLock()
 	defer fake.logsMutex.RUnlock()
+	fake.readAtMutex.RLock()
+	defer fake.readAtMutex.RUnlock()
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	fake.streamMutex.RLock()
+	defer fake.streamMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -10,6 +10,20 @@ import (
 )
 
 type FakeAllocationsClient struct {
+	ChecksStub        func(string, *api.QueryOptions) (api.AllocCheckStatuses, error)
+	checksMutex       sync.RWMutex
+	checksArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	checksReturns struct {
+		result1 api.AllocCheckStatuses
+		result2 error
+	}
+	checksReturnsOnCall map[int]struct {
+		result1 api.AllocCheckStatuses
+		result2 error
+	}
 	InfoStub        func(string, *api.QueryOptions) (*api.Allocation, *api.QueryMeta, error)
 	infoMutex       sync.RWMutex
 	infoArgsForCall []struct {
@@ -41,10 +55,89 @@ type FakeAllocationsClient struct {
 		result2 *api.QueryMeta
 		result3 error
 	}
+	StatsStub        func(*api.Allocation, *api.QueryOptions) (*api.AllocResourceUsage, error)
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct {
+		arg1 *api.Allocation
+		arg2 *api.QueryOptions
+	}
+	statsReturns struct {
+		result1 *api.AllocResourceUsage
+		result2 error
+	}
+	statsReturnsOnCall map[int]struct {
+		result1 *api.AllocResourceUsage
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAllocationsClient) Checks(arg1 string, arg2 *api.QueryOptions) (api.AllocCheckStatuses, error) {
+	fake.checksMutex.Lock()
+	ret, specificReturn := fake.checksReturnsOnCall[len(fake.checksArgsForCall)]
+	fake.checksArgsForCall = append(fake.checksArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.ChecksStub
+	fakeReturns := fake.checksReturns
+	fake.recordInvocation("Checks", []interface{}{arg1, arg2})
+	fake.checksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAllocationsClient) ChecksCallCount() int {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	return len(fake.checksArgsForCall)
+}
+
+func (fake *FakeAllocationsClient) ChecksCalls(stub func(string, *api.QueryOptions) (api.AllocCheckStatuses, error)) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = stub
+}
+
+func (fake *FakeAllocationsClient) ChecksArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	argsForCall := fake.checksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAllocationsClient) ChecksReturns(result1 api.AllocCheckStatuses, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	fake.checksReturns = struct {
+		result1 api.AllocCheckStatuses
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocationsClient) ChecksReturnsOnCall(i int, result1 api.AllocCheckStatuses, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	if fake.checksReturnsOnCall == nil {
+		fake.checksReturnsOnCall = make(map[int]struct {
+			result1 api.AllocCheckStatuses
+			result2 error
+		})
+	}
+	fake.checksReturnsOnCall[i] = struct {
+		result1 api.AllocCheckStatuses
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAllocationsClient) Info(arg1 string, arg2 *api.QueryOptions) (*api.Allocation, *api.QueryMeta, error) {
 	fake.infoMutex.Lock()
 	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
@@ -180,13 +273,82 @@ func (fake *FakeAllocationsClient) ListReturnsOnCall(i int, result1 []*api.Alloc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeAllocationsClient) Stats(arg1 *api.Allocation, arg2 *api.QueryOptions) (*api.AllocResourceUsage, error) {
+	fake.statsMutex.Lock()
+	ret, specificReturn := fake.statsReturnsOnCall[len(fake.statsArgsForCall)]
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct {
+		arg1 *api.Allocation
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.StatsStub
+	fakeReturns := fake.statsReturns
+	fake.recordInvocation("Stats", []interface{}{arg1, arg2})
+	fake.statsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAllocationsClient) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeAllocationsClient) StatsCalls(stub func(*api.Allocation, *api.QueryOptions) (*api.AllocResourceUsage, error)) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = stub
+}
+
+func (fake *FakeAllocationsClient) StatsArgsForCall(i int) (*api.Allocation, *api.QueryOptions) {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	argsForCall := fake.statsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAllocationsClient) StatsReturns(result1 *api.AllocResourceUsage, result2 error) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 *api.AllocResourceUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAllocationsClient) StatsReturnsOnCall(i int, result1 *api.AllocResourceUsage, result2 error) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	if fake.statsReturnsOnCall == nil {
+		fake.statsReturnsOnCall = make(map[int]struct {
+			result1 *api.AllocResourceUsage
+			result2 error
+		})
+	}
+	fake.statsReturnsOnCall[i] = struct {
+		result1 *api.AllocResourceUsage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAllocationsClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
 	fake.infoMutex.RLock()
 	defer fake.infoMutex.RUnlock()
 	fake.listMutex.RLock()
 	defer fake.listMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
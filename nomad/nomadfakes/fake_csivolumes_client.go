@@ -0,0 +1,287 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeCSIVolumesClient struct {
+	DetachStub        func(string, string, *api.WriteOptions) error
+	detachMutex       sync.RWMutex
+	detachArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *api.WriteOptions
+	}
+	detachReturns struct {
+		result1 error
+	}
+	detachReturnsOnCall map[int]struct {
+		result1 error
+	}
+	InfoStub        func(string, *api.QueryOptions) (*api.CSIVolume, *api.QueryMeta, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	infoReturns struct {
+		result1 *api.CSIVolume
+		result2 *api.QueryMeta
+		result3 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 *api.CSIVolume
+		result2 *api.QueryMeta
+		result3 error
+	}
+	ListStub        func(*api.QueryOptions) ([]*api.CSIVolumeListStub, *api.QueryMeta, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	listReturns struct {
+		result1 []*api.CSIVolumeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []*api.CSIVolumeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCSIVolumesClient) Detach(arg1 string, arg2 string, arg3 *api.WriteOptions) error {
+	fake.detachMutex.Lock()
+	ret, specificReturn := fake.detachReturnsOnCall[len(fake.detachArgsForCall)]
+	fake.detachArgsForCall = append(fake.detachArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *api.WriteOptions
+	}{arg1, arg2, arg3})
+	stub := fake.DetachStub
+	fakeReturns := fake.detachReturns
+	fake.recordInvocation("Detach", []interface{}{arg1, arg2, arg3})
+	fake.detachMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCSIVolumesClient) DetachCallCount() int {
+	fake.detachMutex.RLock()
+	defer fake.detachMutex.RUnlock()
+	return len(fake.detachArgsForCall)
+}
+
+func (fake *FakeCSIVolumesClient) DetachCalls(stub func(string, string, *api.WriteOptions) error) {
+	fake.detachMutex.Lock()
+	defer fake.detachMutex.Unlock()
+	fake.DetachStub = stub
+}
+
+func (fake *FakeCSIVolumesClient) DetachArgsForCall(i int) (string, string, *api.WriteOptions) {
+	fake.detachMutex.RLock()
+	defer fake.detachMutex.RUnlock()
+	argsForCall := fake.detachArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCSIVolumesClient) DetachReturns(result1 error) {
+	fake.detachMutex.Lock()
+	defer fake.detachMutex.Unlock()
+	fake.DetachStub = nil
+	fake.detachReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCSIVolumesClient) DetachReturnsOnCall(i int, result1 error) {
+	fake.detachMutex.Lock()
+	defer fake.detachMutex.Unlock()
+	fake.DetachStub = nil
+	if fake.detachReturnsOnCall == nil {
+		fake.detachReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.detachReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCSIVolumesClient) Info(arg1 string, arg2 *api.QueryOptions) (*api.CSIVolume, *api.QueryMeta, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.InfoStub
+	fakeReturns := fake.infoReturns
+	fake.recordInvocation("Info", []interface{}{arg1, arg2})
+	fake.infoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCSIVolumesClient) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeCSIVolumesClient) InfoCalls(stub func(string, *api.QueryOptions) (*api.CSIVolume, *api.QueryMeta, error)) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = stub
+}
+
+func (fake *FakeCSIVolumesClient) InfoArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	argsForCall := fake.infoArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCSIVolumesClient) InfoReturns(result1 *api.CSIVolume, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 *api.CSIVolume
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCSIVolumesClient) InfoReturnsOnCall(i int, result1 *api.CSIVolume, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 *api.CSIVolume
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 *api.CSIVolume
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCSIVolumesClient) List(arg1 *api.QueryOptions) ([]*api.CSIVolumeListStub, *api.QueryMeta, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCSIVolumesClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeCSIVolumesClient) ListCalls(stub func(*api.QueryOptions) ([]*api.CSIVolumeListStub, *api.QueryMeta, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeCSIVolumesClient) ListArgsForCall(i int) *api.QueryOptions {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCSIVolumesClient) ListReturns(result1 []*api.CSIVolumeListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []*api.CSIVolumeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCSIVolumesClient) ListReturnsOnCall(i int, result1 []*api.CSIVolumeListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []*api.CSIVolumeListStub
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []*api.CSIVolumeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCSIVolumesClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.detachMutex.RLock()
+	defer fake.detachMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCSIVolumesClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.CSIVolumesClient = new(FakeCSIVolumesClient)
@@ -9,6 +9,22 @@ import (
 )
 
 type FakeDeploymentClient struct {
+	InfoStub        func(string, *api.QueryOptions) (*api.Deployment, *api.QueryMeta, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	infoReturns struct {
+		result1 *api.Deployment
+		result2 *api.QueryMeta
+		result3 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 *api.Deployment
+		result2 *api.QueryMeta
+		result3 error
+	}
 	ListStub        func(*api.QueryOptions) ([]*api.Deployment, *api.QueryMeta, error)
 	listMutex       sync.RWMutex
 	listArgsForCall []struct {
@@ -28,6 +44,74 @@ type FakeDeploymentClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDeploymentClient) Info(arg1 string, arg2 *api.QueryOptions) (*api.Deployment, *api.QueryMeta, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.InfoStub
+	fakeReturns := fake.infoReturns
+	fake.recordInvocation("Info", []interface{}{arg1, arg2})
+	fake.infoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentClient) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeDeploymentClient) InfoCalls(stub func(string, *api.QueryOptions) (*api.Deployment, *api.QueryMeta, error)) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = stub
+}
+
+func (fake *FakeDeploymentClient) InfoArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	argsForCall := fake.infoArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeploymentClient) InfoReturns(result1 *api.Deployment, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 *api.Deployment
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentClient) InfoReturnsOnCall(i int, result1 *api.Deployment, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 *api.Deployment
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 *api.Deployment
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDeploymentClient) List(arg1 *api.QueryOptions) ([]*api.Deployment, *api.QueryMeta, error) {
 	fake.listMutex.Lock()
 	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
@@ -98,6 +182,8 @@ func (fake *FakeDeploymentClient) ListReturnsOnCall(i int, result1 []*api.Deploy
 func (fake *FakeDeploymentClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
 	fake.listMutex.RLock()
 	defer fake.listMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -90,6 +90,44 @@ type FakeJobClient struct {
 		result2 *api.WriteMeta
 		result3 error
 	}
+	ScaleStub        func(string, string, *int, string, bool, map[string]interface{}, *api.WriteOptions) (*api.JobRegisterResponse, *api.WriteMeta, error)
+	scaleMutex       sync.RWMutex
+	scaleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *int
+		arg4 string
+		arg5 bool
+		arg6 map[string]interface{}
+		arg7 *api.WriteOptions
+	}
+	scaleReturns struct {
+		result1 *api.JobRegisterResponse
+		result2 *api.WriteMeta
+		result3 error
+	}
+	scaleReturnsOnCall map[int]struct {
+		result1 *api.JobRegisterResponse
+		result2 *api.WriteMeta
+		result3 error
+	}
+	SubmissionStub        func(string, int, *api.QueryOptions) (*api.JobSubmission, *api.QueryMeta, error)
+	submissionMutex       sync.RWMutex
+	submissionArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 *api.QueryOptions
+	}
+	submissionReturns struct {
+		result1 *api.JobSubmission
+		result2 *api.QueryMeta
+		result3 error
+	}
+	submissionReturnsOnCall map[int]struct {
+		result1 *api.JobSubmission
+		result2 *api.QueryMeta
+		result3 error
+	}
 	SummaryStub        func(string, *api.QueryOptions) (*api.JobSummary, *api.QueryMeta, error)
 	summaryMutex       sync.RWMutex
 	summaryArgsForCall []struct {
@@ -106,6 +144,25 @@ type FakeJobClient struct {
 		result2 *api.QueryMeta
 		result3 error
 	}
+	VersionsStub        func(string, bool, *api.QueryOptions) ([]*api.Job, []*api.JobDiff, *api.QueryMeta, error)
+	versionsMutex       sync.RWMutex
+	versionsArgsForCall []struct {
+		arg1 string
+		arg2 bool
+		arg3 *api.QueryOptions
+	}
+	versionsReturns struct {
+		result1 []*api.Job
+		result2 []*api.JobDiff
+		result3 *api.QueryMeta
+		result4 error
+	}
+	versionsReturnsOnCall map[int]struct {
+		result1 []*api.Job
+		result2 []*api.JobDiff
+		result3 *api.QueryMeta
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -451,6 +508,148 @@ func (fake *FakeJobClient) RegisterReturnsOnCall(i int, result1 *api.JobRegister
 	}{result1, result2, result3}
 }
 
+func (fake *FakeJobClient) Scale(arg1 string, arg2 string, arg3 *int, arg4 string, arg5 bool, arg6 map[string]interface{}, arg7 *api.WriteOptions) (*api.JobRegisterResponse, *api.WriteMeta, error) {
+	fake.scaleMutex.Lock()
+	ret, specificReturn := fake.scaleReturnsOnCall[len(fake.scaleArgsForCall)]
+	fake.scaleArgsForCall = append(fake.scaleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *int
+		arg4 string
+		arg5 bool
+		arg6 map[string]interface{}
+		arg7 *api.WriteOptions
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.ScaleStub
+	fakeReturns := fake.scaleReturns
+	fake.recordInvocation("Scale", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.scaleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJobClient) ScaleCallCount() int {
+	fake.scaleMutex.RLock()
+	defer fake.scaleMutex.RUnlock()
+	return len(fake.scaleArgsForCall)
+}
+
+func (fake *FakeJobClient) ScaleCalls(stub func(string, string, *int, string, bool, map[string]interface{}, *api.WriteOptions) (*api.JobRegisterResponse, *api.WriteMeta, error)) {
+	fake.scaleMutex.Lock()
+	defer fake.scaleMutex.Unlock()
+	fake.ScaleStub = stub
+}
+
+func (fake *FakeJobClient) ScaleArgsForCall(i int) (string, string, *int, string, bool, map[string]interface{}, *api.WriteOptions) {
+	fake.scaleMutex.RLock()
+	defer fake.scaleMutex.RUnlock()
+	argsForCall := fake.scaleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeJobClient) ScaleReturns(result1 *api.JobRegisterResponse, result2 *api.WriteMeta, result3 error) {
+	fake.scaleMutex.Lock()
+	defer fake.scaleMutex.Unlock()
+	fake.ScaleStub = nil
+	fake.scaleReturns = struct {
+		result1 *api.JobRegisterResponse
+		result2 *api.WriteMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobClient) ScaleReturnsOnCall(i int, result1 *api.JobRegisterResponse, result2 *api.WriteMeta, result3 error) {
+	fake.scaleMutex.Lock()
+	defer fake.scaleMutex.Unlock()
+	fake.ScaleStub = nil
+	if fake.scaleReturnsOnCall == nil {
+		fake.scaleReturnsOnCall = make(map[int]struct {
+			result1 *api.JobRegisterResponse
+			result2 *api.WriteMeta
+			result3 error
+		})
+	}
+	fake.scaleReturnsOnCall[i] = struct {
+		result1 *api.JobRegisterResponse
+		result2 *api.WriteMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobClient) Submission(arg1 string, arg2 int, arg3 *api.QueryOptions) (*api.JobSubmission, *api.QueryMeta, error) {
+	fake.submissionMutex.Lock()
+	ret, specificReturn := fake.submissionReturnsOnCall[len(fake.submissionArgsForCall)]
+	fake.submissionArgsForCall = append(fake.submissionArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 *api.QueryOptions
+	}{arg1, arg2, arg3})
+	stub := fake.SubmissionStub
+	fakeReturns := fake.submissionReturns
+	fake.recordInvocation("Submission", []interface{}{arg1, arg2, arg3})
+	fake.submissionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJobClient) SubmissionCallCount() int {
+	fake.submissionMutex.RLock()
+	defer fake.submissionMutex.RUnlock()
+	return len(fake.submissionArgsForCall)
+}
+
+func (fake *FakeJobClient) SubmissionCalls(stub func(string, int, *api.QueryOptions) (*api.JobSubmission, *api.QueryMeta, error)) {
+	fake.submissionMutex.Lock()
+	defer fake.submissionMutex.Unlock()
+	fake.SubmissionStub = stub
+}
+
+func (fake *FakeJobClient) SubmissionArgsForCall(i int) (string, int, *api.QueryOptions) {
+	fake.submissionMutex.RLock()
+	defer fake.submissionMutex.RUnlock()
+	argsForCall := fake.submissionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJobClient) SubmissionReturns(result1 *api.JobSubmission, result2 *api.QueryMeta, result3 error) {
+	fake.submissionMutex.Lock()
+	defer fake.submissionMutex.Unlock()
+	fake.SubmissionStub = nil
+	fake.submissionReturns = struct {
+		result1 *api.JobSubmission
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJobClient) SubmissionReturnsOnCall(i int, result1 *api.JobSubmission, result2 *api.QueryMeta, result3 error) {
+	fake.submissionMutex.Lock()
+	defer fake.submissionMutex.Unlock()
+	fake.SubmissionStub = nil
+	if fake.submissionReturnsOnCall == nil {
+		fake.submissionReturnsOnCall = make(map[int]struct {
+			result1 *api.JobSubmission
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.submissionReturnsOnCall[i] = struct {
+		result1 *api.JobSubmission
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJobClient) Summary(arg1 string, arg2 *api.QueryOptions) (*api.JobSummary, *api.QueryMeta, error) {
 	fake.summaryMutex.Lock()
 	ret, specificReturn := fake.summaryReturnsOnCall[len(fake.summaryArgsForCall)]
@@ -519,6 +718,78 @@ func (fake *FakeJobClient) SummaryReturnsOnCall(i int, result1 *api.JobSummary,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeJobClient) Versions(arg1 string, arg2 bool, arg3 *api.QueryOptions) ([]*api.Job, []*api.JobDiff, *api.QueryMeta, error) {
+	fake.versionsMutex.Lock()
+	ret, specificReturn := fake.versionsReturnsOnCall[len(fake.versionsArgsForCall)]
+	fake.versionsArgsForCall = append(fake.versionsArgsForCall, struct {
+		arg1 string
+		arg2 bool
+		arg3 *api.QueryOptions
+	}{arg1, arg2, arg3})
+	stub := fake.VersionsStub
+	fakeReturns := fake.versionsReturns
+	fake.recordInvocation("Versions", []interface{}{arg1, arg2, arg3})
+	fake.versionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeJobClient) VersionsCallCount() int {
+	fake.versionsMutex.RLock()
+	defer fake.versionsMutex.RUnlock()
+	return len(fake.versionsArgsForCall)
+}
+
+func (fake *FakeJobClient) VersionsCalls(stub func(string, bool, *api.QueryOptions) ([]*api.Job, []*api.JobDiff, *api.QueryMeta, error)) {
+	fake.versionsMutex.Lock()
+	defer fake.versionsMutex.Unlock()
+	fake.VersionsStub = stub
+}
+
+func (fake *FakeJobClient) VersionsArgsForCall(i int) (string, bool, *api.QueryOptions) {
+	fake.versionsMutex.RLock()
+	defer fake.versionsMutex.RUnlock()
+	argsForCall := fake.versionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJobClient) VersionsReturns(result1 []*api.Job, result2 []*api.JobDiff, result3 *api.QueryMeta, result4 error) {
+	fake.versionsMutex.Lock()
+	defer fake.versionsMutex.Unlock()
+	fake.VersionsStub = nil
+	fake.versionsReturns = struct {
+		result1 []*api.Job
+		result2 []*api.JobDiff
+		result3 *api.QueryMeta
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeJobClient) VersionsReturnsOnCall(i int, result1 []*api.Job, result2 []*api.JobDiff, result3 *api.QueryMeta, result4 error) {
+	fake.versionsMutex.Lock()
+	defer fake.versionsMutex.Unlock()
+	fake.VersionsStub = nil
+	if fake.versionsReturnsOnCall == nil {
+		fake.versionsReturnsOnCall = make(map[int]struct {
+			result1 []*api.Job
+			result2 []*api.JobDiff
+			result3 *api.QueryMeta
+			result4 error
+		})
+	}
+	fake.versionsReturnsOnCall[i] = struct {
+		result1 []*api.Job
+		result2 []*api.JobDiff
+		result3 *api.QueryMeta
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeJobClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -532,8 +803,14 @@ func (fake *FakeJobClient) Invocations() map[string][][]interface{} {
 	defer fake.listMutex.RUnlock()
 	fake.registerMutex.RLock()
 	defer fake.registerMutex.RUnlock()
+	fake.scaleMutex.RLock()
+	defer fake.scaleMutex.RUnlock()
+	fake.submissionMutex.RLock()
+	defer fake.submissionMutex.RUnlock()
 	fake.summaryMutex.RLock()
 	defer fake.summaryMutex.RUnlock()
+	fake.versionsMutex.RLock()
+	defer fake.versionsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,295 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeNodesClient struct {
+	AllocationsStub        func(string, *api.QueryOptions) ([]*api.Allocation, *api.QueryMeta, error)
+	allocationsMutex       sync.RWMutex
+	allocationsArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	allocationsReturns struct {
+		result1 []*api.Allocation
+		result2 *api.QueryMeta
+		result3 error
+	}
+	allocationsReturnsOnCall map[int]struct {
+		result1 []*api.Allocation
+		result2 *api.QueryMeta
+		result3 error
+	}
+	InfoStub        func(string, *api.QueryOptions) (*api.Node, *api.QueryMeta, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	infoReturns struct {
+		result1 *api.Node
+		result2 *api.QueryMeta
+		result3 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 *api.Node
+		result2 *api.QueryMeta
+		result3 error
+	}
+	ListStub        func(*api.QueryOptions) ([]*api.NodeListStub, *api.QueryMeta, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	listReturns struct {
+		result1 []*api.NodeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []*api.NodeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNodesClient) Allocations(arg1 string, arg2 *api.QueryOptions) ([]*api.Allocation, *api.QueryMeta, error) {
+	fake.allocationsMutex.Lock()
+	ret, specificReturn := fake.allocationsReturnsOnCall[len(fake.allocationsArgsForCall)]
+	fake.allocationsArgsForCall = append(fake.allocationsArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.AllocationsStub
+	fakeReturns := fake.allocationsReturns
+	fake.recordInvocation("Allocations", []interface{}{arg1, arg2})
+	fake.allocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNodesClient) AllocationsCallCount() int {
+	fake.allocationsMutex.RLock()
+	defer fake.allocationsMutex.RUnlock()
+	return len(fake.allocationsArgsForCall)
+}
+
+func (fake *FakeNodesClient) AllocationsCalls(stub func(string, *api.QueryOptions) ([]*api.Allocation, *api.QueryMeta, error)) {
+	fake.allocationsMutex.Lock()
+	defer fake.allocationsMutex.Unlock()
+	fake.AllocationsStub = stub
+}
+
+func (fake *FakeNodesClient) AllocationsArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.allocationsMutex.RLock()
+	defer fake.allocationsMutex.RUnlock()
+	argsForCall := fake.allocationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNodesClient) AllocationsReturns(result1 []*api.Allocation, result2 *api.QueryMeta, result3 error) {
+	fake.allocationsMutex.Lock()
+	defer fake.allocationsMutex.Unlock()
+	fake.AllocationsStub = nil
+	fake.allocationsReturns = struct {
+		result1 []*api.Allocation
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNodesClient) AllocationsReturnsOnCall(i int, result1 []*api.Allocation, result2 *api.QueryMeta, result3 error) {
+	fake.allocationsMutex.Lock()
+	defer fake.allocationsMutex.Unlock()
+	fake.AllocationsStub = nil
+	if fake.allocationsReturnsOnCall == nil {
+		fake.allocationsReturnsOnCall = make(map[int]struct {
+			result1 []*api.Allocation
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.allocationsReturnsOnCall[i] = struct {
+		result1 []*api.Allocation
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNodesClient) Info(arg1 string, arg2 *api.QueryOptions) (*api.Node, *api.QueryMeta, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.InfoStub
+	fakeReturns := fake.infoReturns
+	fake.recordInvocation("Info", []interface{}{arg1, arg2})
+	fake.infoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNodesClient) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeNodesClient) InfoCalls(stub func(string, *api.QueryOptions) (*api.Node, *api.QueryMeta, error)) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = stub
+}
+
+func (fake *FakeNodesClient) InfoArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	argsForCall := fake.infoArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNodesClient) InfoReturns(result1 *api.Node, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 *api.Node
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNodesClient) InfoReturnsOnCall(i int, result1 *api.Node, result2 *api.QueryMeta, result3 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 *api.Node
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 *api.Node
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNodesClient) List(arg1 *api.QueryOptions) ([]*api.NodeListStub, *api.QueryMeta, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNodesClient) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeNodesClient) ListCalls(stub func(*api.QueryOptions) ([]*api.NodeListStub, *api.QueryMeta, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeNodesClient) ListArgsForCall(i int) *api.QueryOptions {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNodesClient) ListReturns(result1 []*api.NodeListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []*api.NodeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNodesClient) ListReturnsOnCall(i int, result1 []*api.NodeListStub, result2 *api.QueryMeta, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []*api.NodeListStub
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []*api.NodeListStub
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNodesClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.allocationsMutex.RLock()
+	defer fake.allocationsMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNodesClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.NodesClient = new(FakeNodesClient)
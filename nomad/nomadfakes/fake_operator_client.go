@@ -0,0 +1,202 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeOperatorClient struct {
+	AutopilotServerHealthStub        func(*api.QueryOptions) (*api.OperatorHealthReply, *api.QueryMeta, error)
+	autopilotServerHealthMutex       sync.RWMutex
+	autopilotServerHealthArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	autopilotServerHealthReturns struct {
+		result1 *api.OperatorHealthReply
+		result2 *api.QueryMeta
+		result3 error
+	}
+	autopilotServerHealthReturnsOnCall map[int]struct {
+		result1 *api.OperatorHealthReply
+		result2 *api.QueryMeta
+		result3 error
+	}
+	RaftGetConfigurationStub        func(*api.QueryOptions) (*api.RaftConfiguration, error)
+	raftGetConfigurationMutex       sync.RWMutex
+	raftGetConfigurationArgsForCall []struct {
+		arg1 *api.QueryOptions
+	}
+	raftGetConfigurationReturns struct {
+		result1 *api.RaftConfiguration
+		result2 error
+	}
+	raftGetConfigurationReturnsOnCall map[int]struct {
+		result1 *api.RaftConfiguration
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOperatorClient) AutopilotServerHealth(arg1 *api.QueryOptions) (*api.OperatorHealthReply, *api.QueryMeta, error) {
+	fake.autopilotServerHealthMutex.Lock()
+	ret, specificReturn := fake.autopilotServerHealthReturnsOnCall[len(fake.autopilotServerHealthArgsForCall)]
+	fake.autopilotServerHealthArgsForCall = append(fake.autopilotServerHealthArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.AutopilotServerHealthStub
+	fakeReturns := fake.autopilotServerHealthReturns
+	fake.recordInvocation("AutopilotServerHealth", []interface{}{arg1})
+	fake.autopilotServerHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeOperatorClient) AutopilotServerHealthCallCount() int {
+	fake.autopilotServerHealthMutex.RLock()
+	defer fake.autopilotServerHealthMutex.RUnlock()
+	return len(fake.autopilotServerHealthArgsForCall)
+}
+
+func (fake *FakeOperatorClient) AutopilotServerHealthCalls(stub func(*api.QueryOptions) (*api.OperatorHealthReply, *api.QueryMeta, error)) {
+	fake.autopilotServerHealthMutex.Lock()
+	defer fake.autopilotServerHealthMutex.Unlock()
+	fake.AutopilotServerHealthStub = stub
+}
+
+func (fake *FakeOperatorClient) AutopilotServerHealthArgsForCall(i int) *api.QueryOptions {
+	fake.autopilotServerHealthMutex.RLock()
+	defer fake.autopilotServerHealthMutex.RUnlock()
+	argsForCall := fake.autopilotServerHealthArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOperatorClient) AutopilotServerHealthReturns(result1 *api.OperatorHealthReply, result2 *api.QueryMeta, result3 error) {
+	fake.autopilotServerHealthMutex.Lock()
+	defer fake.autopilotServerHealthMutex.Unlock()
+	fake.AutopilotServerHealthStub = nil
+	fake.autopilotServerHealthReturns = struct {
+		result1 *api.OperatorHealthReply
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOperatorClient) AutopilotServerHealthReturnsOnCall(i int, result1 *api.OperatorHealthReply, result2 *api.QueryMeta, result3 error) {
+	fake.autopilotServerHealthMutex.Lock()
+	defer fake.autopilotServerHealthMutex.Unlock()
+	fake.AutopilotServerHealthStub = nil
+	if fake.autopilotServerHealthReturnsOnCall == nil {
+		fake.autopilotServerHealthReturnsOnCall = make(map[int]struct {
+			result1 *api.OperatorHealthReply
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.autopilotServerHealthReturnsOnCall[i] = struct {
+		result1 *api.OperatorHealthReply
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOperatorClient) RaftGetConfiguration(arg1 *api.QueryOptions) (*api.RaftConfiguration, error) {
+	fake.raftGetConfigurationMutex.Lock()
+	ret, specificReturn := fake.raftGetConfigurationReturnsOnCall[len(fake.raftGetConfigurationArgsForCall)]
+	fake.raftGetConfigurationArgsForCall = append(fake.raftGetConfigurationArgsForCall, struct {
+		arg1 *api.QueryOptions
+	}{arg1})
+	stub := fake.RaftGetConfigurationStub
+	fakeReturns := fake.raftGetConfigurationReturns
+	fake.recordInvocation("RaftGetConfiguration", []interface{}{arg1})
+	fake.raftGetConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOperatorClient) RaftGetConfigurationCallCount() int {
+	fake.raftGetConfigurationMutex.RLock()
+	defer fake.raftGetConfigurationMutex.RUnlock()
+	return len(fake.raftGetConfigurationArgsForCall)
+}
+
+func (fake *FakeOperatorClient) RaftGetConfigurationCalls(stub func(*api.QueryOptions) (*api.RaftConfiguration, error)) {
+	fake.raftGetConfigurationMutex.Lock()
+	defer fake.raftGetConfigurationMutex.Unlock()
+	fake.RaftGetConfigurationStub = stub
+}
+
+func (fake *FakeOperatorClient) RaftGetConfigurationArgsForCall(i int) *api.QueryOptions {
+	fake.raftGetConfigurationMutex.RLock()
+	defer fake.raftGetConfigurationMutex.RUnlock()
+	argsForCall := fake.raftGetConfigurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOperatorClient) RaftGetConfigurationReturns(result1 *api.RaftConfiguration, result2 error) {
+	fake.raftGetConfigurationMutex.Lock()
+	defer fake.raftGetConfigurationMutex.Unlock()
+	fake.RaftGetConfigurationStub = nil
+	fake.raftGetConfigurationReturns = struct {
+		result1 *api.RaftConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOperatorClient) RaftGetConfigurationReturnsOnCall(i int, result1 *api.RaftConfiguration, result2 error) {
+	fake.raftGetConfigurationMutex.Lock()
+	defer fake.raftGetConfigurationMutex.Unlock()
+	fake.RaftGetConfigurationStub = nil
+	if fake.raftGetConfigurationReturnsOnCall == nil {
+		fake.raftGetConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *api.RaftConfiguration
+			result2 error
+		})
+	}
+	fake.raftGetConfigurationReturnsOnCall[i] = struct {
+		result1 *api.RaftConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOperatorClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.autopilotServerHealthMutex.RLock()
+	defer fake.autopilotServerHealthMutex.RUnlock()
+	fake.raftGetConfigurationMutex.RLock()
+	defer fake.raftGetConfigurationMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOperatorClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.OperatorClient = new(FakeOperatorClient)
@@ -0,0 +1,466 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package nomadfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/nomad"
+)
+
+type FakeVariablesClient struct {
+	CheckedCreateStub        func(*api.Variable, *api.WriteOptions) (*api.Variable, *api.WriteMeta, error)
+	checkedCreateMutex       sync.RWMutex
+	checkedCreateArgsForCall []struct {
+		arg1 *api.Variable
+		arg2 *api.WriteOptions
+	}
+	checkedCreateReturns struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}
+	checkedCreateReturnsOnCall map[int]struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}
+	CheckedDeleteStub        func(string, uint64, *api.WriteOptions) (*api.WriteMeta, error)
+	checkedDeleteMutex       sync.RWMutex
+	checkedDeleteArgsForCall []struct {
+		arg1 string
+		arg2 uint64
+		arg3 *api.WriteOptions
+	}
+	checkedDeleteReturns struct {
+		result1 *api.WriteMeta
+		result2 error
+	}
+	checkedDeleteReturnsOnCall map[int]struct {
+		result1 *api.WriteMeta
+		result2 error
+	}
+	CheckedUpdateStub        func(*api.Variable, *api.WriteOptions) (*api.Variable, *api.WriteMeta, error)
+	checkedUpdateMutex       sync.RWMutex
+	checkedUpdateArgsForCall []struct {
+		arg1 *api.Variable
+		arg2 *api.WriteOptions
+	}
+	checkedUpdateReturns struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}
+	checkedUpdateReturnsOnCall map[int]struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}
+	PrefixListStub        func(string, *api.QueryOptions) ([]*api.VariableMetadata, *api.QueryMeta, error)
+	prefixListMutex       sync.RWMutex
+	prefixListArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	prefixListReturns struct {
+		result1 []*api.VariableMetadata
+		result2 *api.QueryMeta
+		result3 error
+	}
+	prefixListReturnsOnCall map[int]struct {
+		result1 []*api.VariableMetadata
+		result2 *api.QueryMeta
+		result3 error
+	}
+	ReadStub        func(string, *api.QueryOptions) (*api.Variable, *api.QueryMeta, error)
+	readMutex       sync.RWMutex
+	readArgsForCall []struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}
+	readReturns struct {
+		result1 *api.Variable
+		result2 *api.QueryMeta
+		result3 error
+	}
+	readReturnsOnCall map[int]struct {
+		result1 *api.Variable
+		result2 *api.QueryMeta
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeVariablesClient) CheckedCreate(arg1 *api.Variable, arg2 *api.WriteOptions) (*api.Variable, *api.WriteMeta, error) {
+	fake.checkedCreateMutex.Lock()
+	ret, specificReturn := fake.checkedCreateReturnsOnCall[len(fake.checkedCreateArgsForCall)]
+	fake.checkedCreateArgsForCall = append(fake.checkedCreateArgsForCall, struct {
+		arg1 *api.Variable
+		arg2 *api.WriteOptions
+	}{arg1, arg2})
+	stub := fake.CheckedCreateStub
+	fakeReturns := fake.checkedCreateReturns
+	fake.recordInvocation("CheckedCreate", []interface{}{arg1, arg2})
+	fake.checkedCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVariablesClient) CheckedCreateCallCount() int {
+	fake.checkedCreateMutex.RLock()
+	defer fake.checkedCreateMutex.RUnlock()
+	return len(fake.checkedCreateArgsForCall)
+}
+
+func (fake *FakeVariablesClient) CheckedCreateCalls(stub func(*api.Variable, *api.WriteOptions) (*api.Variable, *api.WriteMeta, error)) {
+	fake.checkedCreateMutex.Lock()
+	defer fake.checkedCreateMutex.Unlock()
+	fake.CheckedCreateStub = stub
+}
+
+func (fake *FakeVariablesClient) CheckedCreateArgsForCall(i int) (*api.Variable, *api.WriteOptions) {
+	fake.checkedCreateMutex.RLock()
+	defer fake.checkedCreateMutex.RUnlock()
+	argsForCall := fake.checkedCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVariablesClient) CheckedCreateReturns(result1 *api.Variable, result2 *api.WriteMeta, result3 error) {
+	fake.checkedCreateMutex.Lock()
+	defer fake.checkedCreateMutex.Unlock()
+	fake.CheckedCreateStub = nil
+	fake.checkedCreateReturns = struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) CheckedCreateReturnsOnCall(i int, result1 *api.Variable, result2 *api.WriteMeta, result3 error) {
+	fake.checkedCreateMutex.Lock()
+	defer fake.checkedCreateMutex.Unlock()
+	fake.CheckedCreateStub = nil
+	if fake.checkedCreateReturnsOnCall == nil {
+		fake.checkedCreateReturnsOnCall = make(map[int]struct {
+			result1 *api.Variable
+			result2 *api.WriteMeta
+			result3 error
+		})
+	}
+	fake.checkedCreateReturnsOnCall[i] = struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) CheckedDelete(arg1 string, arg2 uint64, arg3 *api.WriteOptions) (*api.WriteMeta, error) {
+	fake.checkedDeleteMutex.Lock()
+	ret, specificReturn := fake.checkedDeleteReturnsOnCall[len(fake.checkedDeleteArgsForCall)]
+	fake.checkedDeleteArgsForCall = append(fake.checkedDeleteArgsForCall, struct {
+		arg1 string
+		arg2 uint64
+		arg3 *api.WriteOptions
+	}{arg1, arg2, arg3})
+	stub := fake.CheckedDeleteStub
+	fakeReturns := fake.checkedDeleteReturns
+	fake.recordInvocation("CheckedDelete", []interface{}{arg1, arg2, arg3})
+	fake.checkedDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVariablesClient) CheckedDeleteCallCount() int {
+	fake.checkedDeleteMutex.RLock()
+	defer fake.checkedDeleteMutex.RUnlock()
+	return len(fake.checkedDeleteArgsForCall)
+}
+
+func (fake *FakeVariablesClient) CheckedDeleteCalls(stub func(string, uint64, *api.WriteOptions) (*api.WriteMeta, error)) {
+	fake.checkedDeleteMutex.Lock()
+	defer fake.checkedDeleteMutex.Unlock()
+	fake.CheckedDeleteStub = stub
+}
+
+func (fake *FakeVariablesClient) CheckedDeleteArgsForCall(i int) (string, uint64, *api.WriteOptions) {
+	fake.checkedDeleteMutex.RLock()
+	defer fake.checkedDeleteMutex.RUnlock()
+	argsForCall := fake.checkedDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeVariablesClient) CheckedDeleteReturns(result1 *api.WriteMeta, result2 error) {
+	fake.checkedDeleteMutex.Lock()
+	defer fake.checkedDeleteMutex.Unlock()
+	fake.CheckedDeleteStub = nil
+	fake.checkedDeleteReturns = struct {
+		result1 *api.WriteMeta
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVariablesClient) CheckedDeleteReturnsOnCall(i int, result1 *api.WriteMeta, result2 error) {
+	fake.checkedDeleteMutex.Lock()
+	defer fake.checkedDeleteMutex.Unlock()
+	fake.CheckedDeleteStub = nil
+	if fake.checkedDeleteReturnsOnCall == nil {
+		fake.checkedDeleteReturnsOnCall = make(map[int]struct {
+			result1 *api.WriteMeta
+			result2 error
+		})
+	}
+	fake.checkedDeleteReturnsOnCall[i] = struct {
+		result1 *api.WriteMeta
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVariablesClient) CheckedUpdate(arg1 *api.Variable, arg2 *api.WriteOptions) (*api.Variable, *api.WriteMeta, error) {
+	fake.checkedUpdateMutex.Lock()
+	ret, specificReturn := fake.checkedUpdateReturnsOnCall[len(fake.checkedUpdateArgsForCall)]
+	fake.checkedUpdateArgsForCall = append(fake.checkedUpdateArgsForCall, struct {
+		arg1 *api.Variable
+		arg2 *api.WriteOptions
+	}{arg1, arg2})
+	stub := fake.CheckedUpdateStub
+	fakeReturns := fake.checkedUpdateReturns
+	fake.recordInvocation("CheckedUpdate", []interface{}{arg1, arg2})
+	fake.checkedUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVariablesClient) CheckedUpdateCallCount() int {
+	fake.checkedUpdateMutex.RLock()
+	defer fake.checkedUpdateMutex.RUnlock()
+	return len(fake.checkedUpdateArgsForCall)
+}
+
+func (fake *FakeVariablesClient) CheckedUpdateCalls(stub func(*api.Variable, *api.WriteOptions) (*api.Variable, *api.WriteMeta, error)) {
+	fake.checkedUpdateMutex.Lock()
+	defer fake.checkedUpdateMutex.Unlock()
+	fake.CheckedUpdateStub = stub
+}
+
+func (fake *FakeVariablesClient) CheckedUpdateArgsForCall(i int) (*api.Variable, *api.WriteOptions) {
+	fake.checkedUpdateMutex.RLock()
+	defer fake.checkedUpdateMutex.RUnlock()
+	argsForCall := fake.checkedUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVariablesClient) CheckedUpdateReturns(result1 *api.Variable, result2 *api.WriteMeta, result3 error) {
+	fake.checkedUpdateMutex.Lock()
+	defer fake.checkedUpdateMutex.Unlock()
+	fake.CheckedUpdateStub = nil
+	fake.checkedUpdateReturns = struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) CheckedUpdateReturnsOnCall(i int, result1 *api.Variable, result2 *api.WriteMeta, result3 error) {
+	fake.checkedUpdateMutex.Lock()
+	defer fake.checkedUpdateMutex.Unlock()
+	fake.CheckedUpdateStub = nil
+	if fake.checkedUpdateReturnsOnCall == nil {
+		fake.checkedUpdateReturnsOnCall = make(map[int]struct {
+			result1 *api.Variable
+			result2 *api.WriteMeta
+			result3 error
+		})
+	}
+	fake.checkedUpdateReturnsOnCall[i] = struct {
+		result1 *api.Variable
+		result2 *api.WriteMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) PrefixList(arg1 string, arg2 *api.QueryOptions) ([]*api.VariableMetadata, *api.QueryMeta, error) {
+	fake.prefixListMutex.Lock()
+	ret, specificReturn := fake.prefixListReturnsOnCall[len(fake.prefixListArgsForCall)]
+	fake.prefixListArgsForCall = append(fake.prefixListArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.PrefixListStub
+	fakeReturns := fake.prefixListReturns
+	fake.recordInvocation("PrefixList", []interface{}{arg1, arg2})
+	fake.prefixListMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVariablesClient) PrefixListCallCount() int {
+	fake.prefixListMutex.RLock()
+	defer fake.prefixListMutex.RUnlock()
+	return len(fake.prefixListArgsForCall)
+}
+
+func (fake *FakeVariablesClient) PrefixListCalls(stub func(string, *api.QueryOptions) ([]*api.VariableMetadata, *api.QueryMeta, error)) {
+	fake.prefixListMutex.Lock()
+	defer fake.prefixListMutex.Unlock()
+	fake.PrefixListStub = stub
+}
+
+func (fake *FakeVariablesClient) PrefixListArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.prefixListMutex.RLock()
+	defer fake.prefixListMutex.RUnlock()
+	argsForCall := fake.prefixListArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVariablesClient) PrefixListReturns(result1 []*api.VariableMetadata, result2 *api.QueryMeta, result3 error) {
+	fake.prefixListMutex.Lock()
+	defer fake.prefixListMutex.Unlock()
+	fake.PrefixListStub = nil
+	fake.prefixListReturns = struct {
+		result1 []*api.VariableMetadata
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) PrefixListReturnsOnCall(i int, result1 []*api.VariableMetadata, result2 *api.QueryMeta, result3 error) {
+	fake.prefixListMutex.Lock()
+	defer fake.prefixListMutex.Unlock()
+	fake.PrefixListStub = nil
+	if fake.prefixListReturnsOnCall == nil {
+		fake.prefixListReturnsOnCall = make(map[int]struct {
+			result1 []*api.VariableMetadata
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.prefixListReturnsOnCall[i] = struct {
+		result1 []*api.VariableMetadata
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) Read(arg1 string, arg2 *api.QueryOptions) (*api.Variable, *api.QueryMeta, error) {
+	fake.readMutex.Lock()
+	ret, specificReturn := fake.readReturnsOnCall[len(fake.readArgsForCall)]
+	fake.readArgsForCall = append(fake.readArgsForCall, struct {
+		arg1 string
+		arg2 *api.QueryOptions
+	}{arg1, arg2})
+	stub := fake.ReadStub
+	fakeReturns := fake.readReturns
+	fake.recordInvocation("Read", []interface{}{arg1, arg2})
+	fake.readMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVariablesClient) ReadCallCount() int {
+	fake.readMutex.RLock()
+	defer fake.readMutex.RUnlock()
+	return len(fake.readArgsForCall)
+}
+
+func (fake *FakeVariablesClient) ReadCalls(stub func(string, *api.QueryOptions) (*api.Variable, *api.QueryMeta, error)) {
+	fake.readMutex.Lock()
+	defer fake.readMutex.Unlock()
+	fake.ReadStub = stub
+}
+
+func (fake *FakeVariablesClient) ReadArgsForCall(i int) (string, *api.QueryOptions) {
+	fake.readMutex.RLock()
+	defer fake.readMutex.RUnlock()
+	argsForCall := fake.readArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVariablesClient) ReadReturns(result1 *api.Variable, result2 *api.QueryMeta, result3 error) {
+	fake.readMutex.Lock()
+	defer fake.readMutex.Unlock()
+	fake.ReadStub = nil
+	fake.readReturns = struct {
+		result1 *api.Variable
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) ReadReturnsOnCall(i int, result1 *api.Variable, result2 *api.QueryMeta, result3 error) {
+	fake.readMutex.Lock()
+	defer fake.readMutex.Unlock()
+	fake.ReadStub = nil
+	if fake.readReturnsOnCall == nil {
+		fake.readReturnsOnCall = make(map[int]struct {
+			result1 *api.Variable
+			result2 *api.QueryMeta
+			result3 error
+		})
+	}
+	fake.readReturnsOnCall[i] = struct {
+		result1 *api.Variable
+		result2 *api.QueryMeta
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVariablesClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkedCreateMutex.RLock()
+	defer fake.checkedCreateMutex.RUnlock()
+	fake.checkedDeleteMutex.RLock()
+	defer fake.checkedDeleteMutex.RUnlock()
+	fake.checkedUpdateMutex.RLock()
+	defer fake.checkedUpdateMutex.RUnlock()
+	fake.prefixListMutex.RLock()
+	defer fake.prefixListMutex.RUnlock()
+	fake.readMutex.RLock()
+	defer fake.readMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeVariablesClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ nomad.VariablesClient = new(FakeVariablesClient)
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// Services lists the services registered with the Nomad service
+// provider, sorted by namespace and name.
+func (n *Nomad) Services(so *SearchOptions) ([]*models.Service, error) {
+	if so == nil {
+		so = &SearchOptions{}
+	}
+
+	list, _, err := n.SvcClient.List(&api.QueryOptions{
+		Namespace: so.Namespace,
+		Region:    so.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	services := []*models.Service{}
+	for _, ns := range list {
+		for _, s := range ns.Services {
+			services = append(services, &models.Service{
+				Name:      s.ServiceName,
+				Namespace: ns.Namespace,
+				Tags:      s.Tags,
+			})
+		}
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].Namespace != services[j].Namespace {
+			return services[i].Namespace < services[j].Namespace
+		}
+
+		return services[i].Name < services[j].Name
+	})
+
+	return services, nil
+}
+
+// ServiceInstances returns the instances of the service with the
+// results of their health checks. The checks are read from the
+// clients of the allocations, an allocation whose checks can't be
+// read doesn't fail the instances.
+func (n *Nomad) ServiceInstances(namespace, name string) ([]*models.ServiceInstance, error) {
+	regs, _, err := n.SvcClient.Get(name, &api.QueryOptions{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	checks := map[string]api.AllocCheckStatuses{}
+	checkErrors := map[string]error{}
+
+	instances := make([]*models.ServiceInstance, 0, len(regs))
+	for _, r := range regs {
+		instance := &models.ServiceInstance{
+			ID:          r.ID,
+			ServiceName: r.ServiceName,
+			Namespace:   r.Namespace,
+			NodeID:      r.NodeID,
+			Datacenter:  r.Datacenter,
+			JobID:       r.JobID,
+			AllocID:     r.AllocID,
+			Tags:        r.Tags,
+			Address:     r.Address,
+			Port:        r.Port,
+		}
+
+		statuses, ok := checks[r.AllocID]
+		if !ok && checkErrors[r.AllocID] == nil {
+			statuses, err = n.AllocClient.Checks(r.AllocID, &api.QueryOptions{Namespace: r.Namespace})
+			if err != nil {
+				checkErrors[r.AllocID] = err
+			} else {
+				checks[r.AllocID] = statuses
+			}
+		}
+
+		if err := checkErrors[r.AllocID]; err != nil {
+			instance.CheckError = err.Error()
+		}
+
+		instance.Checks = toServiceChecks(statuses, r.ServiceName)
+		instances = append(instances, instance)
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i].Address != instances[j].Address {
+			return instances[i].Address < instances[j].Address
+		}
+
+		return instances[i].Port < instances[j].Port
+	})
+
+	return instances, nil
+}
+
+// toServiceChecks returns the checks of the service, sorted by name.
+func toServiceChecks(statuses api.AllocCheckStatuses, service string) []*models.ServiceCheck {
+	checks := []*models.ServiceCheck{}
+	for _, s := range statuses {
+		if s.Service != service {
+			continue
+		}
+
+		checks = append(checks, &models.ServiceCheck{
+			Name:       s.Check,
+			Task:       s.Task,
+			Mode:       s.Mode,
+			Status:     s.Status,
+			StatusCode: s.StatusCode,
+			Output:     s.Output,
+			Time:       time.Unix(s.Timestamp, 0),
+		})
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Name < checks[j].Name
+	})
+
+	return checks
+}
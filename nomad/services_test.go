@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestServices(t *testing.T) {
+	t.Run("It lists the services sorted by namespace and name", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeSvcClient := &nomadfakes.FakeServicesClient{}
+		client := &nomad.Nomad{SvcClient: fakeSvcClient}
+
+		fakeSvcClient.ListReturns([]*api.ServiceRegistrationListStub{
+			{
+				Namespace: "default",
+				Services: []*api.ServiceRegistrationStub{
+					{ServiceName: "web", Tags: []string{"http"}},
+					{ServiceName: "db"},
+				},
+			},
+			{
+				Namespace: "apps",
+				Services: []*api.ServiceRegistrationStub{
+					{ServiceName: "api"},
+				},
+			},
+		}, nil, nil)
+
+		services, err := client.Services(&nomad.SearchOptions{Namespace: "*"})
+		r.NoError(err)
+
+		r.Equal("*", fakeSvcClient.ListArgsForCall(0).Namespace)
+		r.Equal([]*models.Service{
+			{Name: "api", Namespace: "apps"},
+			{Name: "db", Namespace: "default"},
+			{Name: "web", Namespace: "default", Tags: []string{"http"}},
+		}, services)
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeSvcClient := &nomadfakes.FakeServicesClient{}
+		client := &nomad.Nomad{SvcClient: fakeSvcClient}
+
+		fakeSvcClient.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Services(nil)
+		r.Error(err)
+	})
+}
+
+func TestServiceInstances(t *testing.T) {
+	t.Run("It returns the instances with their checks", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeSvcClient := &nomadfakes.FakeServicesClient{}
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{SvcClient: fakeSvcClient, AllocClient: fakeAllocClient}
+
+		fakeSvcClient.GetReturns([]*api.ServiceRegistration{
+			{ID: "b", ServiceName: "web", Namespace: "default", AllocID: "alloc-2", NodeID: "node-2", Address: "10.0.0.2", Port: 8080},
+			{ID: "a", ServiceName: "web", Namespace: "default", AllocID: "alloc-1", NodeID: "node-1", Address: "10.0.0.1", Port: 8080},
+		}, nil, nil)
+
+		fakeAllocClient.ChecksStub = func(allocID string, _ *api.QueryOptions) (api.AllocCheckStatuses, error) {
+			if allocID == "alloc-2" {
+				return nil, errors.New("no route to host")
+			}
+
+			return api.AllocCheckStatuses{
+				"c1": {Check: "ready", Service: "web", Mode: "readiness", Status: "pending", Timestamp: 100},
+				"c2": {Check: "alive", Service: "web", Mode: "healthiness", Status: "success", StatusCode: 200, Output: "ok", Timestamp: 100},
+				"c3": {Check: "alive", Service: "metrics", Mode: "healthiness", Status: "failure", Timestamp: 100},
+			}, nil
+		}
+
+		instances, err := client.ServiceInstances("default", "web")
+		r.NoError(err)
+
+		name, qo := fakeSvcClient.GetArgsForCall(0)
+		r.Equal("web", name)
+		r.Equal("default", qo.Namespace)
+
+		r.Len(instances, 2)
+
+		// It sorts the instances by address and port
+		r.Equal("a", instances[0].ID)
+		r.Equal("alloc-1", instances[0].AllocID)
+		r.Empty(instances[0].CheckError)
+
+		// It only keeps the checks of the service
+		r.Equal([]*models.ServiceCheck{
+			{Name: "alive", Mode: "healthiness", Status: "success", StatusCode: 200, Output: "ok", Time: time.Unix(100, 0)},
+			{Name: "ready", Mode: "readiness", Status: "pending", Time: time.Unix(100, 0)},
+		}, instances[0].Checks)
+
+		// It keeps the instance if its checks can't be read
+		r.Equal("b", instances[1].ID)
+		r.Equal("no route to host", instances[1].CheckError)
+		r.Empty(instances[1].Checks)
+	})
+
+	t.Run("It reads the checks of an allocation once", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeSvcClient := &nomadfakes.FakeServicesClient{}
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{SvcClient: fakeSvcClient, AllocClient: fakeAllocClient}
+
+		fakeSvcClient.GetReturns([]*api.ServiceRegistration{
+			{ID: "a", ServiceName: "web", AllocID: "alloc-1", Port: 8080},
+			{ID: "b", ServiceName: "web", AllocID: "alloc-1", Port: 8081},
+		}, nil, nil)
+
+		_, err := client.ServiceInstances("default", "web")
+		r.NoError(err)
+		r.Equal(1, fakeAllocClient.ChecksCallCount())
+	})
+
+	t.Run("When reading the service fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeSvcClient := &nomadfakes.FakeServicesClient{}
+		client := &nomad.Nomad{SvcClient: fakeSvcClient}
+
+		fakeSvcClient.GetReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.ServiceInstances("default", "web")
+		r.Error(err)
+	})
+}
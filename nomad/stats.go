@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// AllocStats returns the current resource usage of the allocation
+// and each of its tasks, as reported by the client it runs on.
+func (n *Nomad) AllocStats(allocID string) (*models.AllocUsage, error) {
+	stats, err := n.AllocClient.Stats(&api.Allocation{ID: allocID}, &api.QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &models.AllocUsage{
+		ResourceUsage: toResourceUsage(stats.ResourceUsage, stats.Timestamp),
+		Tasks:         map[string]models.ResourceUsage{},
+	}
+
+	for name, task := range stats.Tasks {
+		if task == nil {
+			continue
+		}
+
+		usage.Tasks[name] = toResourceUsage(task.ResourceUsage, task.Timestamp)
+	}
+
+	return usage, nil
+}
+
+// toResourceUsage converts the usage reported by the client. The
+// memory is the resident set size, or the total usage on platforms
+// which don't measure the RSS (eg cgroups v2).
+func toResourceUsage(ru *api.ResourceUsage, timestamp int64) models.ResourceUsage {
+	usage := models.ResourceUsage{
+		Time: time.Unix(0, timestamp),
+	}
+
+	if ru == nil {
+		return usage
+	}
+
+	if ru.CpuStats != nil {
+		usage.CPU = ru.CpuStats.TotalTicks
+	}
+
+	if ru.MemoryStats != nil {
+		usage.MemoryBytes = ru.MemoryStats.RSS
+		if usage.MemoryBytes == 0 {
+			usage.MemoryBytes = ru.MemoryStats.Usage
+		}
+	}
+
+	return usage
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestAllocStats(t *testing.T) {
+	t.Run("It returns the usage of the allocation and its tasks", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+		now := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+		fakeAllocClient.StatsReturns(&api.AllocResourceUsage{
+			Timestamp: now.UnixNano(),
+			ResourceUsage: &api.ResourceUsage{
+				CpuStats:    &api.CpuStats{TotalTicks: 250},
+				MemoryStats: &api.MemoryStats{RSS: 64 << 20, Usage: 80 << 20},
+			},
+			Tasks: map[string]*api.TaskResourceUsage{
+				"web": {
+					Timestamp: now.UnixNano(),
+					ResourceUsage: &api.ResourceUsage{
+						CpuStats:    &api.CpuStats{TotalTicks: 200},
+						MemoryStats: &api.MemoryStats{RSS: 48 << 20},
+					},
+				},
+				"sidecar": {
+					Timestamp: now.UnixNano(),
+					ResourceUsage: &api.ResourceUsage{
+						CpuStats: &api.CpuStats{TotalTicks: 50},
+						// RSS isn't measured with cgroups v2
+						MemoryStats: &api.MemoryStats{Usage: 16 << 20},
+					},
+				},
+				"init": nil,
+			},
+		}, nil)
+
+		usage, err := client.AllocStats("moon")
+		r.NoError(err)
+
+		alloc, _ := fakeAllocClient.StatsArgsForCall(0)
+		r.Equal(&api.Allocation{ID: "moon"}, alloc)
+
+		r.True(now.Equal(usage.Time))
+		r.Equal(float64(250), usage.CPU)
+		r.Equal(uint64(64<<20), usage.MemoryBytes)
+
+		r.Len(usage.Tasks, 2)
+		r.Equal(float64(200), usage.Tasks["web"].CPU)
+		r.Equal(uint64(48<<20), usage.Tasks["web"].MemoryBytes)
+		r.Equal(uint64(16<<20), usage.Tasks["sidecar"].MemoryBytes)
+	})
+
+	t.Run("It ignores missing stats", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+		fakeAllocClient.StatsReturns(&api.AllocResourceUsage{}, nil)
+
+		usage, err := client.AllocStats("moon")
+		r.NoError(err)
+		r.Equal(models.ResourceUsage{Time: time.Unix(0, 0)}, usage.ResourceUsage)
+		r.Empty(usage.Tasks)
+	})
+
+	t.Run("When the stats can't be fetched", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeAllocClient := &nomadfakes.FakeAllocationsClient{}
+		client := &nomad.Nomad{AllocClient: fakeAllocClient}
+
+		fakeAllocClient.StatsReturns(nil, errors.New("argh"))
+
+		_, err := client.AllocStats("moon")
+		r.Error(err)
+	})
+}
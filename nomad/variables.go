@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// ErrVariableConflict is returned when a variable was changed or
+// created by someone else since it was read.
+const ErrVariableConflict = models.Sentinel("variable was changed since it was read")
+
+// Variables lists the variables of the namespace, sorted by
+// namespace and path. Their items aren't part of the list.
+func (n *Nomad) Variables(so *SearchOptions) ([]*models.Variable, error) {
+	if so == nil {
+		so = &SearchOptions{}
+	}
+
+	list, _, err := n.VarClient.PrefixList("", &api.QueryOptions{
+		Namespace: so.Namespace,
+		Region:    so.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make([]*models.Variable, 0, len(list))
+	for _, v := range list {
+		vars = append(vars, &models.Variable{
+			Namespace:   v.Namespace,
+			Path:        v.Path,
+			CreateIndex: v.CreateIndex,
+			ModifyIndex: v.ModifyIndex,
+			Modified:    time.Unix(0, v.ModifyTime),
+		})
+	}
+
+	sort.Slice(vars, func(i, j int) bool {
+		if vars[i].Namespace != vars[j].Namespace {
+			return vars[i].Namespace < vars[j].Namespace
+		}
+
+		return vars[i].Path < vars[j].Path
+	})
+
+	return vars, nil
+}
+
+// Variable reads the variable at the path with its items.
+func (n *Nomad) Variable(namespace, path string) (*models.Variable, error) {
+	v, _, err := n.VarClient.Read(path, &api.QueryOptions{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	return toVariable(v), nil
+}
+
+// PutVariable writes the variable using check-and-set: a variable
+// without a modify index is created and fails if the path exists,
+// while an update fails if the variable was modified since it was
+// read. Both fail with ErrVariableConflict.
+func (n *Nomad) PutVariable(v *models.Variable) (*models.Variable, error) {
+	in := &api.Variable{
+		Namespace:   v.Namespace,
+		Path:        v.Path,
+		Items:       v.Items,
+		ModifyIndex: v.ModifyIndex,
+	}
+
+	wo := &api.WriteOptions{Namespace: v.Namespace}
+
+	var out *api.Variable
+	var err error
+	if v.ModifyIndex == 0 {
+		out, _, err = n.VarClient.CheckedCreate(in, wo)
+	} else {
+		out, _, err = n.VarClient.CheckedUpdate(in, wo)
+	}
+
+	if err != nil {
+		return nil, casError(err)
+	}
+
+	return toVariable(out), nil
+}
+
+// DeleteVariable deletes the variable at the path, unless it was
+// modified since it was read at the modify index.
+func (n *Nomad) DeleteVariable(namespace, path string, modifyIndex uint64) error {
+	_, err := n.VarClient.CheckedDelete(path, modifyIndex, &api.WriteOptions{Namespace: namespace})
+	return casError(err)
+}
+
+func casError(err error) error {
+	var conflict api.ErrCASConflict
+	if errors.As(err, &conflict) {
+		index := uint64(0)
+		if conflict.Conflict != nil {
+			index = conflict.Conflict.ModifyIndex
+		}
+
+		return fmt.Errorf("%w (read at index %d, now at index %d)", ErrVariableConflict, conflict.CheckIndex, index)
+	}
+
+	return err
+}
+
+func toVariable(v *api.Variable) *models.Variable {
+	return &models.Variable{
+		Namespace:   v.Namespace,
+		Path:        v.Path,
+		Items:       v.Items,
+		CreateIndex: v.CreateIndex,
+		ModifyIndex: v.ModifyIndex,
+		Modified:    time.Unix(0, v.ModifyTime),
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestVariables(t *testing.T) {
+	t.Run("It lists the variables sorted by namespace and path", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVarClient := &nomadfakes.FakeVariablesClient{}
+		client := &nomad.Nomad{VarClient: fakeVarClient}
+
+		fakeVarClient.PrefixListReturns([]*api.VariableMetadata{
+			{Namespace: "default", Path: "nomad/jobs/web", ModifyIndex: 12, ModifyTime: 200},
+			{Namespace: "apps", Path: "config", ModifyIndex: 10, ModifyTime: 100},
+			{Namespace: "default", Path: "config", ModifyIndex: 11, ModifyTime: 100},
+		}, nil, nil)
+
+		vars, err := client.Variables(&nomad.SearchOptions{Namespace: "*"})
+		r.NoError(err)
+
+		prefix, qo := fakeVarClient.PrefixListArgsForCall(0)
+		r.Equal("", prefix)
+		r.Equal("*", qo.Namespace)
+
+		r.Equal([]*models.Variable{
+			{Namespace: "apps", Path: "config", ModifyIndex: 10, Modified: time.Unix(0, 100)},
+			{Namespace: "default", Path: "config", ModifyIndex: 11, Modified: time.Unix(0, 100)},
+			{Namespace: "default", Path: "nomad/jobs/web", ModifyIndex: 12, Modified: time.Unix(0, 200)},
+		}, vars)
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVarClient := &nomadfakes.FakeVariablesClient{}
+		client := &nomad.Nomad{VarClient: fakeVarClient}
+
+		fakeVarClient.PrefixListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Variables(nil)
+		r.Error(err)
+	})
+}
+
+func TestVariable(t *testing.T) {
+	r := require.New(t)
+
+	fakeVarClient := &nomadfakes.FakeVariablesClient{}
+	client := &nomad.Nomad{VarClient: fakeVarClient}
+
+	fakeVarClient.ReadReturns(&api.Variable{
+		Namespace:   "apps",
+		Path:        "config",
+		Items:       api.VariableItems{"db_password": "hunter2"},
+		ModifyIndex: 10,
+	}, nil, nil)
+
+	v, err := client.Variable("apps", "config")
+	r.NoError(err)
+	r.Equal(map[string]string{"db_password": "hunter2"}, v.Items)
+	r.Equal(uint64(10), v.ModifyIndex)
+
+	path, qo := fakeVarClient.ReadArgsForCall(0)
+	r.Equal("config", path)
+	r.Equal("apps", qo.Namespace)
+}
+
+func TestPutVariable(t *testing.T) {
+	t.Run("It creates a variable without a modify index", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVarClient := &nomadfakes.FakeVariablesClient{}
+		client := &nomad.Nomad{VarClient: fakeVarClient}
+
+		fakeVarClient.CheckedCreateReturns(&api.Variable{Path: "config", ModifyIndex: 1}, nil, nil)
+
+		v, err := client.PutVariable(&models.Variable{
+			Namespace: "apps",
+			Path:      "config",
+			Items:     map[string]string{"port": "8080"},
+		})
+		r.NoError(err)
+		r.Equal(uint64(1), v.ModifyIndex)
+
+		in, wo := fakeVarClient.CheckedCreateArgsForCall(0)
+		r.Equal("config", in.Path)
+		r.Equal(api.VariableItems{"port": "8080"}, in.Items)
+		r.Equal("apps", wo.Namespace)
+		r.Equal(0, fakeVarClient.CheckedUpdateCallCount())
+	})
+
+	t.Run("It updates a variable at its modify index", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVarClient := &nomadfakes.FakeVariablesClient{}
+		client := &nomad.Nomad{VarClient: fakeVarClient}
+
+		fakeVarClient.CheckedUpdateReturns(&api.Variable{Path: "config", ModifyIndex: 11}, nil, nil)
+
+		_, err := client.PutVariable(&models.Variable{Path: "config", ModifyIndex: 10})
+		r.NoError(err)
+
+		in, _ := fakeVarClient.CheckedUpdateArgsForCall(0)
+		r.Equal(uint64(10), in.ModifyIndex)
+		r.Equal(0, fakeVarClient.CheckedCreateCallCount())
+	})
+
+	t.Run("When the variable changed in the meantime", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVarClient := &nomadfakes.FakeVariablesClient{}
+		client := &nomad.Nomad{VarClient: fakeVarClient}
+
+		fakeVarClient.CheckedUpdateReturns(nil, nil, api.ErrCASConflict{
+			CheckIndex: 10,
+			Conflict:   &api.Variable{ModifyIndex: 12},
+		})
+
+		_, err := client.PutVariable(&models.Variable{Path: "config", ModifyIndex: 10})
+		r.ErrorIs(err, nomad.ErrVariableConflict)
+		r.Contains(err.Error(), "read at index 10, now at index 12")
+	})
+}
+
+func TestDeleteVariable(t *testing.T) {
+	r := require.New(t)
+
+	fakeVarClient := &nomadfakes.FakeVariablesClient{}
+	client := &nomad.Nomad{VarClient: fakeVarClient}
+
+	err := client.DeleteVariable("apps", "config", 10)
+	r.NoError(err)
+
+	path, index, wo := fakeVarClient.CheckedDeleteArgsForCall(0)
+	r.Equal("config", path)
+	r.Equal(uint64(10), index)
+	r.Equal("apps", wo.Namespace)
+
+	fakeVarClient.CheckedDeleteReturns(nil, errors.New("argh"))
+	r.Error(client.DeleteVariable("apps", "config", 10))
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"sort"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// Volumes lists the CSI volumes, sorted by namespace and ID.
+func (n *Nomad) Volumes(so *SearchOptions) ([]*models.Volume, error) {
+	if so == nil {
+		so = &SearchOptions{}
+	}
+
+	list, _, err := n.VolClient.List(&api.QueryOptions{
+		Namespace: so.Namespace,
+		Region:    so.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]*models.Volume, 0, len(list))
+	for _, v := range list {
+		volumes = append(volumes, &models.Volume{
+			ID:                  v.ID,
+			Name:                v.Name,
+			Namespace:           v.Namespace,
+			ExternalID:          v.ExternalID,
+			PluginID:            v.PluginID,
+			Provider:            v.Provider,
+			AccessMode:          string(v.AccessMode),
+			AttachmentMode:      string(v.AttachmentMode),
+			Schedulable:         v.Schedulable,
+			Readers:             v.CurrentReaders,
+			Writers:             v.CurrentWriters,
+			ControllerRequired:  v.ControllerRequired,
+			ControllersHealthy:  v.ControllersHealthy,
+			ControllersExpected: v.ControllersExpected,
+			NodesHealthy:        v.NodesHealthy,
+			NodesExpected:       v.NodesExpected,
+		})
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		if volumes[i].Namespace != volumes[j].Namespace {
+			return volumes[i].Namespace < volumes[j].Namespace
+		}
+
+		return volumes[i].ID < volumes[j].ID
+	})
+
+	return volumes, nil
+}
+
+// Volume reads the CSI volume with the claims of the allocations
+// using it, writers first.
+func (n *Nomad) Volume(namespace, id string) (*models.Volume, error) {
+	v, _, err := n.VolClient.Info(id, &api.QueryOptions{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	volume := &models.Volume{
+		ID:                  v.ID,
+		Name:                v.Name,
+		Namespace:           v.Namespace,
+		ExternalID:          v.ExternalID,
+		PluginID:            v.PluginID,
+		Provider:            v.Provider,
+		AccessMode:          string(v.AccessMode),
+		AttachmentMode:      string(v.AttachmentMode),
+		Schedulable:         v.Schedulable,
+		Readers:             len(v.ReadAllocs),
+		Writers:             len(v.WriteAllocs),
+		ControllerRequired:  v.ControllerRequired,
+		ControllersHealthy:  v.ControllersHealthy,
+		ControllersExpected: v.ControllersExpected,
+		NodesHealthy:        v.NodesHealthy,
+		NodesExpected:       v.NodesExpected,
+		Claims:              []*models.VolumeClaim{},
+	}
+
+	for _, a := range v.Allocations {
+		mode := models.ClaimModeRead
+		if _, ok := v.WriteAllocs[a.ID]; ok {
+			mode = models.ClaimModeWrite
+		}
+
+		volume.Claims = append(volume.Claims, &models.VolumeClaim{
+			AllocID:       a.ID,
+			AllocName:     a.Name,
+			JobID:         a.JobID,
+			NodeID:        a.NodeID,
+			NodeName:      a.NodeName,
+			Mode:          mode,
+			DesiredStatus: a.DesiredStatus,
+			ClientStatus:  a.ClientStatus,
+		})
+	}
+
+	sort.Slice(volume.Claims, func(i, j int) bool {
+		ci, cj := volume.Claims[i], volume.Claims[j]
+		if ci.Mode != cj.Mode {
+			return ci.Mode == models.ClaimModeWrite
+		}
+
+		return ci.AllocName < cj.AllocName
+	})
+
+	return volume, nil
+}
+
+// DetachVolume detaches the CSI volume from the node, such that
+// the claims of allocations on a lost node are released.
+func (n *Nomad) DetachVolume(namespace, volumeID, nodeID string) error {
+	return n.VolClient.Detach(volumeID, nodeID, &api.WriteOptions{Namespace: namespace})
+}
+
+// CSIPlugins lists the CSI plugins, sorted by ID.
+func (n *Nomad) CSIPlugins(so *SearchOptions) ([]*models.CSIPlugin, error) {
+	if so == nil {
+		so = &SearchOptions{}
+	}
+
+	list, _, err := n.PluginClient.List(&api.QueryOptions{
+		Namespace: so.Namespace,
+		Region:    so.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := make([]*models.CSIPlugin, 0, len(list))
+	for _, p := range list {
+		plugins = append(plugins, &models.CSIPlugin{
+			ID:                  p.ID,
+			Provider:            p.Provider,
+			ControllerRequired:  p.ControllerRequired,
+			ControllersHealthy:  p.ControllersHealthy,
+			ControllersExpected: p.ControllersExpected,
+			NodesHealthy:        p.NodesHealthy,
+			NodesExpected:       p.NodesExpected,
+		})
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].ID < plugins[j].ID
+	})
+
+	return plugins, nil
+}
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+	"github.com/hcjulz/damon/nomad/nomadfakes"
+)
+
+func TestVolumes(t *testing.T) {
+	t.Run("It lists the volumes sorted by namespace and ID", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVolClient := &nomadfakes.FakeCSIVolumesClient{}
+		client := &nomad.Nomad{VolClient: fakeVolClient}
+
+		fakeVolClient.ListReturns([]*api.CSIVolumeListStub{
+			{
+				ID:                  "pg",
+				Namespace:           "default",
+				PluginID:            "ebs",
+				AccessMode:          api.CSIVolumeAccessModeSingleNodeWriter,
+				AttachmentMode:      api.CSIVolumeAttachmentModeFilesystem,
+				CurrentWriters:      1,
+				Schedulable:         true,
+				ControllersHealthy:  1,
+				ControllersExpected: 1,
+				NodesHealthy:        2,
+				NodesExpected:       3,
+			},
+			{ID: "cache", Namespace: "apps"},
+		}, nil, nil)
+
+		volumes, err := client.Volumes(&nomad.SearchOptions{Namespace: "*"})
+		r.NoError(err)
+
+		r.Equal("*", fakeVolClient.ListArgsForCall(0).Namespace)
+		r.Equal([]*models.Volume{
+			{ID: "cache", Namespace: "apps"},
+			{
+				ID:                  "pg",
+				Namespace:           "default",
+				PluginID:            "ebs",
+				AccessMode:          "single-node-writer",
+				AttachmentMode:      "file-system",
+				Writers:             1,
+				Schedulable:         true,
+				ControllersHealthy:  1,
+				ControllersExpected: 1,
+				NodesHealthy:        2,
+				NodesExpected:       3,
+			},
+		}, volumes)
+	})
+
+	t.Run("When listing fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVolClient := &nomadfakes.FakeCSIVolumesClient{}
+		client := &nomad.Nomad{VolClient: fakeVolClient}
+
+		fakeVolClient.ListReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Volumes(nil)
+		r.Error(err)
+	})
+}
+
+func TestVolume(t *testing.T) {
+	t.Run("It returns the claims of the volume, writers first", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVolClient := &nomadfakes.FakeCSIVolumesClient{}
+		client := &nomad.Nomad{VolClient: fakeVolClient}
+
+		fakeVolClient.InfoReturns(&api.CSIVolume{
+			ID:          "pg",
+			Namespace:   "default",
+			ReadAllocs:  map[string]*api.Allocation{"a1": nil},
+			WriteAllocs: map[string]*api.Allocation{"a2": nil},
+			Allocations: []*api.AllocationListStub{
+				{ID: "a1", Name: "db.replica[0]", JobID: "db", NodeID: "n1", NodeName: "moon", ClientStatus: "running"},
+				{ID: "a2", Name: "db.primary[0]", JobID: "db", NodeID: "n2", NodeName: "sun", ClientStatus: "lost"},
+			},
+		}, nil, nil)
+
+		volume, err := client.Volume("default", "pg")
+		r.NoError(err)
+
+		id, qo := fakeVolClient.InfoArgsForCall(0)
+		r.Equal("pg", id)
+		r.Equal("default", qo.Namespace)
+
+		r.Equal(1, volume.Readers)
+		r.Equal(1, volume.Writers)
+		r.Equal([]*models.VolumeClaim{
+			{AllocID: "a2", AllocName: "db.primary[0]", JobID: "db", NodeID: "n2", NodeName: "sun", Mode: "write", ClientStatus: "lost"},
+			{AllocID: "a1", AllocName: "db.replica[0]", JobID: "db", NodeID: "n1", NodeName: "moon", Mode: "read", ClientStatus: "running"},
+		}, volume.Claims)
+	})
+
+	t.Run("When reading fails", func(t *testing.T) {
+		r := require.New(t)
+
+		fakeVolClient := &nomadfakes.FakeCSIVolumesClient{}
+		client := &nomad.Nomad{VolClient: fakeVolClient}
+
+		fakeVolClient.InfoReturns(nil, nil, errors.New("argh"))
+
+		_, err := client.Volume("default", "pg")
+		r.Error(err)
+	})
+}
+
+func TestDetachVolume(t *testing.T) {
+	r := require.New(t)
+
+	fakeVolClient := &nomadfakes.FakeCSIVolumesClient{}
+	client := &nomad.Nomad{VolClient: fakeVolClient}
+
+	err := client.DetachVolume("default", "pg", "n2")
+	r.NoError(err)
+
+	volID, nodeID, wo := fakeVolClient.DetachArgsForCall(0)
+	r.Equal("pg", volID)
+	r.Equal("n2", nodeID)
+	r.Equal("default", wo.Namespace)
+}
+
+func TestCSIPlugins(t *testing.T) {
+	r := require.New(t)
+
+	fakePluginClient := &nomadfakes.FakeCSIPluginsClient{}
+	client := &nomad.Nomad{PluginClient: fakePluginClient}
+
+	fakePluginClient.ListReturns([]*api.CSIPluginListStub{
+		{ID: "nfs", NodesHealthy: 2, NodesExpected: 2},
+		{ID: "ebs", ControllerRequired: true, ControllersHealthy: 0, ControllersExpected: 1},
+	}, nil, nil)
+
+	plugins, err := client.CSIPlugins(nil)
+	r.NoError(err)
+	r.Equal([]*models.CSIPlugin{
+		{ID: "ebs", ControllerRequired: true, ControllersHealthy: 0, ControllersExpected: 1},
+		{ID: "nfs", NodesHealthy: 2, NodesExpected: 2},
+	}, plugins)
+
+	fakePluginClient.ListReturns(nil, nil, errors.New("argh"))
+	_, err = client.CSIPlugins(nil)
+	r.Error(err)
+}
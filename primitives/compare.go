@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package primitives
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindNumber
+	kindCount
+	kindDuration
+	kindTime
+	kindVersion
+)
+
+var (
+	rxCount   = regexp.MustCompile(`^(\d+)\s*/\s*(\d+)$`)
+	rxDays    = regexp.MustCompile(`^(\d+(?:\.\d+)?)d$`)
+	rxVersion = regexp.MustCompile(`^v?(\d+(?:\.\d+)+)(?:[-+].*)?$`)
+
+	timeLayouts = []string{
+		time.RFC3339,
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04:05",
+	}
+)
+
+// compareValues compares two cell values and returns a negative
+// number if a sorts before b, a positive number if a sorts after b
+// and zero if they are equal. Numbers, counts (e.g. "3/4"),
+// durations (e.g. "5m" or "1h2m3s ago"), timestamps and versions
+// (e.g. "1.2.10") are compared by their value. Values of different
+// kinds are compared as case-insensitive strings.
+func compareValues(a, b string) int {
+	kindA, keyA := parseValue(a)
+	kindB, keyB := parseValue(b)
+
+	if kindA == kindB && kindA != kindString {
+		return compareKeys(keyA, keyB)
+	}
+
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func parseValue(v string) (valueKind, []float64) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return kindString, nil
+	}
+
+	if n, err := strconv.ParseFloat(v, 64); err == nil {
+		return kindNumber, []float64{n}
+	}
+
+	if m := rxCount.FindStringSubmatch(v); m != nil {
+		num, _ := strconv.ParseFloat(m[1], 64)
+		den, _ := strconv.ParseFloat(m[2], 64)
+
+		ratio := 1.0
+		if den > 0 {
+			ratio = num / den
+		}
+
+		return kindCount, []float64{ratio, num, den}
+	}
+
+	if d, ok := parseDuration(v); ok {
+		return kindDuration, []float64{float64(d)}
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return kindTime, []float64{float64(t.UnixNano())}
+		}
+	}
+
+	if m := rxVersion.FindStringSubmatch(v); m != nil {
+		var key []float64
+		for _, part := range strings.Split(m[1], ".") {
+			n, _ := strconv.ParseFloat(part, 64)
+			key = append(key, n)
+		}
+
+		return kindVersion, key
+	}
+
+	return kindString, nil
+}
+
+func parseDuration(v string) (time.Duration, bool) {
+	v = strings.TrimSuffix(v, " ago")
+
+	if m := rxDays.FindStringSubmatch(v); m != nil {
+		days, _ := strconv.ParseFloat(m[1], 64)
+		return time.Duration(days * float64(24*time.Hour)), true
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, false
+	}
+
+	return d, true
+}
+
+func compareKeys(a, b []float64) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+
+	return len(a) - len(b)
+}
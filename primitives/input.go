@@ -4,6 +4,8 @@
 package primitives
 
 import (
+	"fmt"
+
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 
@@ -11,21 +13,25 @@ import (
 )
 
 type InputField struct {
-	primitive *tview.InputField
+	primitive   *tview.InputField
+	borderColor tcell.Color
 }
 
 func NewInputField(label, placeholder string) *InputField {
 	i := tview.NewInputField()
 	i.SetLabel(label)
 	i.SetFieldWidth(0)
-	i.SetAcceptanceFunc(tview.InputFieldMaxLength(40))
+	i.SetAcceptanceFunc(tview.InputFieldMaxLength(100))
 	i.SetPlaceholder(placeholder)
 	i.SetBorder(true)
 	i.SetFieldBackgroundColor(styles.TcellBackgroundColor)
 	i.SetBackgroundColor(styles.TcellBackgroundColor)
 	i.SetBorderAttributes(tcell.AttrDim)
 
-	return &InputField{i}
+	return &InputField{
+		primitive:   i,
+		borderColor: i.GetBorderColor(),
+	}
 }
 
 func (i *InputField) SetDoneFunc(handler func(k tcell.Key)) {
@@ -44,6 +50,21 @@ func (i *InputField) GetText() string {
 	return i.primitive.GetText()
 }
 
+// SetError shows the message in the border of the input field.
+// An empty message removes the error.
+func (i *InputField) SetError(msg string) {
+	if msg == "" {
+		i.primitive.SetTitle("")
+		i.primitive.SetBorderColor(i.borderColor)
+		return
+	}
+
+	i.primitive.SetTitle(fmt.Sprintf(" %s ", msg))
+	i.primitive.SetTitleColor(styles.TcellColorAttention)
+	i.primitive.SetTitleAlign(tview.AlignLeft)
+	i.primitive.SetBorderColor(styles.TcellColorAttention)
+}
+
 func (i *InputField) SetAutocompleteFunc(callback func(currentText string) (entries []string)) {
 	i.primitive.SetAutocompleteFunc(callback)
 }
@@ -50,6 +50,22 @@ func TestInputField_SetAutocompleteFunc(t *testing.T) {
 	r.Equal(text, "test")
 }
 
+func TestInputField_SetError(t *testing.T) {
+	r := require.New(t)
+
+	i := primitives.NewInputField("test", "input-field")
+	p := i.Primitive().(*tview.InputField)
+	borderColor := p.GetBorderColor()
+
+	i.SetError("invalid")
+	r.Equal(" invalid ", p.GetTitle())
+	r.Equal(styles.TcellColorAttention, p.GetBorderColor())
+
+	i.SetError("")
+	r.Equal("", p.GetTitle())
+	r.Equal(borderColor, p.GetBorderColor())
+}
+
 func TestInputField_SetChangedFunc(t *testing.T) {
 	r := require.New(t)
 
@@ -5,6 +5,7 @@ package primitives
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -12,15 +13,53 @@ import (
 	"github.com/hcjulz/damon/styles"
 )
 
+const (
+	KeySortColumn    = 'o'
+	KeySortDirection = 'O'
+
+	sortIndicatorAsc  = "▲"
+	sortIndicatorDesc = "▼"
+
+	// HighlightDuration is how long rows which changed
+	// during a refresh are highlighted.
+	HighlightDuration = 3 * time.Second
+)
+
 // Table is a wrapper of a tview.Table primitive.
 // It applies the damon look to the tviw.Table.
+//
+// Rows can be sorted by any column. The sort column and
+// direction are kept when the table is cleared and rendered
+// again, such that the order survives refreshes.
+//
+// Rows are identified by their first column. Once the rows of a
+// render are committed, the selection and scroll position stick
+// to the selected row and changed rows are highlighted briefly.
 type Table struct {
 	primitive *tview.Table
+	content   *sortedContent
 	color     tcell.Color
+
+	header   []string
+	capture  func(event *tcell.EventKey) *tcell.EventKey
+	redraw   func()
+	unsorted bool
+
+	selectedKey    string
+	selectedOffset int
+
+	// highlightTimer redraws the table once
+	// the highlight of changed rows expired.
+	highlightTimer *time.Timer
 }
 
 func NewTable() *Table {
+	content := newSortedContent()
+	content.highlightDuration = HighlightDuration
+	content.highlightColor = styles.TcellColorChanged
+
 	t := tview.NewTable()
+	t.SetContent(content)
 	t.SetBorder(true)
 	t.SetTitleColor(styles.TcellColorHighlighPrimary)
 	t.SetSelectable(true, false)
@@ -28,14 +67,31 @@ func NewTable() *Table {
 	t.SetBorderPadding(0, 0, 1, 1)
 	t.SetBorderColor(styles.TcellColorStandard)
 
-	return &Table{
+	table := &Table{
 		primitive: t,
+		content:   content,
 	}
+
+	t.SetInputCapture(table.handleInput)
+
+	return table
 }
 
 func (t *Table) RenderHeader(data []string) {
+	t.header = data
+
 	for i, h := range data {
 		c := tcell.GetColor(styles.StandardColorHex)
+
+		if i == t.content.column {
+			indicator := sortIndicatorAsc
+			if t.content.descending {
+				indicator = sortIndicatorDesc
+			}
+
+			h = fmt.Sprintf("%s %s", h, indicator)
+		}
+
 		t.primitive.SetCell(0, i, tview.NewTableCell(h).
 			SetTextColor(c).
 			SetSelectable(false),
@@ -57,9 +113,17 @@ func (t *Table) GetSelection() (row, column int) {
 }
 
 func (t *Table) Clear() {
+	t.rememberSelection()
 	t.primitive.Clear()
 }
 
+// SetRedrawFunc sets a function which is called when the
+// highlighting of changed rows expires, such that the
+// table can be drawn again without the highlight.
+func (t *Table) SetRedrawFunc(fn func()) {
+	t.redraw = fn
+}
+
 func (t *Table) RenderRow(data []string, index int, c tcell.Color) {
 	for i, r := range data {
 		t.primitive.SetCell(index, i,
@@ -68,14 +132,201 @@ func (t *Table) RenderRow(data []string, index int, c tcell.Color) {
 	}
 }
 
+// Commit ends a render once all rows are rendered: rows which
+// changed compared to the previous render are highlighted and
+// the selection is moved back to the row it was on.
+func (t *Table) Commit() {
+	if !t.content.commit() {
+		return
+	}
+
+	t.restoreSelection()
+	t.scheduleRedraw()
+}
+
+// SetVolatileColumns sets the columns whose values change all the
+// time, such as live usage. Changes in those columns don't highlight
+// the row.
+func (t *Table) SetVolatileColumns(columns ...int) {
+	t.content.volatile = make(map[int]bool, len(columns))
+	for _, column := range columns {
+		t.content.volatile[column] = true
+	}
+}
+
+// SetKeyColumns sets the columns which identify a row, for tables
+// whose first column isn't unique. The selection and the highlight
+// of changed rows follow the rows by their key.
+func (t *Table) SetKeyColumns(columns ...int) {
+	if len(columns) == 0 {
+		columns = []int{0}
+	}
+
+	t.content.keyColumns = columns
+	t.content.keys = nil
+}
+
+// SortBy sorts the rows by the given column. A negative
+// column restores the order in which the rows were rendered.
+func (t *Table) SortBy(column int, descending bool) {
+	if len(t.header) > 0 && column >= len(t.header) {
+		column = -1
+	}
+
+	t.rememberSelection()
+	t.content.sortBy(column, descending)
+
+	if len(t.header) > 0 {
+		t.RenderHeader(t.header)
+	}
+
+	// rows which aren't committed yet are
+	// restored by the commit of the render
+	if !t.content.rendered {
+		t.restoreSelection()
+	}
+}
+
+// SortColumn returns the column the rows are sorted by
+// (-1 if unsorted) and whether the order is descending.
+func (t *Table) SortColumn() (int, bool) {
+	return t.content.column, t.content.descending
+}
+
+// SetSortable sets whether the rows can be sorted with the
+// sort keys. Tables are sortable by default; tables whose rows
+// only make sense in the order they are rendered turn it off.
+func (t *Table) SetSortable(sortable bool) {
+	t.unsorted = !sortable
+	if t.unsorted {
+		t.SortBy(-1, false)
+	}
+}
+
+// CycleSortColumn sorts the rows by the next column in
+// ascending order. After the last column the rows are
+// shown unsorted again. Tables without a header or which
+// aren't sortable can't be sorted.
+func (t *Table) CycleSortColumn() {
+	if len(t.header) == 0 || t.unsorted {
+		return
+	}
+
+	column := t.content.column + 1
+	if column >= len(t.header) {
+		column = -1
+	}
+
+	t.SortBy(column, false)
+}
+
+// ToggleSortDirection switches between ascending and
+// descending order for the current sort column.
+func (t *Table) ToggleSortDirection() {
+	if t.content.column < 0 {
+		return
+	}
+
+	t.SortBy(t.content.column, !t.content.descending)
+}
+
 func (t *Table) SetSelectedFunc(fn func(row, column int)) {
 	t.primitive.SetSelectedFunc(fn)
 }
 
+func (t *Table) SetSelectionChangedFunc(fn func(row, column int)) {
+	t.primitive.SetSelectionChangedFunc(fn)
+}
+
+// SetInputCapture sets a function which captures key events
+// before they are handled by the table. The sort keys are
+// always handled by the table itself.
 func (t *Table) SetInputCapture(capture func(event *tcell.EventKey) *tcell.EventKey) {
-	t.primitive.SetInputCapture(capture)
+	t.capture = capture
 }
 
 func (t *Table) Primitive() tview.Primitive {
 	return t.primitive
 }
+
+// rememberSelection stores the key of the selected row and
+// its position on the screen, such that the selection can be
+// restored once the rows got rendered again.
+func (t *Table) rememberSelection() {
+	if t.content.rendered {
+		// the previous render hasn't been committed yet
+		return
+	}
+
+	row, _ := t.primitive.GetSelection()
+	if row < 1 || row >= len(t.content.cells) {
+		t.selectedKey = ""
+		return
+	}
+
+	rowOffset, _ := t.primitive.GetOffset()
+	t.selectedKey = t.content.key(t.content.renderedRow(row))
+	t.selectedOffset = row - rowOffset
+}
+
+// restoreSelection selects the row with the remembered key and
+// scrolls, such that it stays at the same position on the screen.
+func (t *Table) restoreSelection() {
+	if t.selectedKey == "" {
+		return
+	}
+
+	row := t.content.displayedRow(t.selectedKey)
+	if row < 0 {
+		return
+	}
+
+	selectedRow, column := t.primitive.GetSelection()
+	_, columnOffset := t.primitive.GetOffset()
+
+	if row != selectedRow {
+		t.primitive.Select(row, column)
+	}
+
+	rowOffset := row - t.selectedOffset
+	if rowOffset < 0 {
+		rowOffset = 0
+	}
+
+	t.primitive.SetOffset(rowOffset, columnOffset)
+}
+
+// scheduleRedraw redraws the table when the highlight of the
+// rows which just changed expires. Each table runs one timer at
+// most, which is pushed back by later changes.
+func (t *Table) scheduleRedraw() {
+	if t.redraw == nil || !t.content.hasHighlights() {
+		return
+	}
+
+	if t.highlightTimer == nil {
+		t.highlightTimer = time.AfterFunc(HighlightDuration, t.redraw)
+		return
+	}
+
+	t.highlightTimer.Reset(HighlightDuration)
+}
+
+func (t *Table) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyRune && len(t.header) > 0 && !t.unsorted {
+		switch event.Rune() {
+		case KeySortColumn:
+			t.CycleSortColumn()
+			return nil
+		case KeySortDirection:
+			t.ToggleSortDirection()
+			return nil
+		}
+	}
+
+	if t.capture != nil {
+		return t.capture(event)
+	}
+
+	return event
+}
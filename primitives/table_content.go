@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package primitives
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// sortedContent holds the cells of a Table and presents its rows
+// sorted by the current sort column. The first row is the header
+// and never moves.
+//
+// Rows passed to SetCell, RemoveRow and InsertRow address the rows
+// in the order they were rendered, while GetCell addresses the rows
+// in the order they are displayed.
+//
+// Rows are identified by the content of their key columns, the first
+// column unless set otherwise. Rows with the same key are told apart
+// by the order in which they were rendered. When
+// the content gets cleared and rendered again, rows which are new
+// or changed compared to the previous render are highlighted for
+// the highlight duration once the render is committed. Reading the
+// content has no side effects, as tview reads it while drawing.
+type sortedContent struct {
+	cells      [][]*tview.TableCell
+	lastColumn int
+
+	// column is the column the rows are sorted by; -1 keeps
+	// the rows in the order they were rendered.
+	column     int
+	descending bool
+
+	// order maps a displayed row to the rendered row.
+	order []int
+	dirty bool
+
+	// rendered is true when rows were rendered
+	// since the last render was committed.
+	rendered bool
+
+	// keyColumns are the columns which identify a row and
+	// keys caches the key of each rendered row.
+	keyColumns []int
+	keys       []string
+
+	previous          map[string]string
+	changed           map[string]time.Time
+	volatile          map[int]bool
+	highlightDuration time.Duration
+	highlightColor    tcell.Color
+}
+
+func newSortedContent() *sortedContent {
+	return &sortedContent{
+		lastColumn: -1,
+		column:     -1,
+		keyColumns: []int{0},
+		changed:    map[string]time.Time{},
+	}
+}
+
+func (c *sortedContent) GetCell(row, column int) *tview.TableCell {
+	row = c.renderedRow(row)
+	if row < 0 || row >= len(c.cells) || column < 0 || column >= len(c.cells[row]) {
+		return nil
+	}
+
+	cell := c.cells[row][column]
+	if row > 0 && cell != nil && c.isHighlighted(c.key(row)) {
+		highlighted := *cell
+		highlighted.SetBackgroundColor(c.highlightColor)
+		return &highlighted
+	}
+
+	return cell
+}
+
+func (c *sortedContent) GetRowCount() int {
+	return len(c.cells)
+}
+
+func (c *sortedContent) GetColumnCount() int {
+	return c.lastColumn + 1
+}
+
+func (c *sortedContent) SetCell(row, column int, cell *tview.TableCell) {
+	if row < 0 || column < 0 {
+		return
+	}
+
+	if row >= len(c.cells) {
+		c.cells = append(c.cells, make([][]*tview.TableCell, row-len(c.cells)+1)...)
+	}
+
+	rowLen := len(c.cells[row])
+	if column >= rowLen {
+		c.cells[row] = append(c.cells[row], make([]*tview.TableCell, column-rowLen+1)...)
+		for col := rowLen; col < column; col++ {
+			c.cells[row][col] = &tview.TableCell{}
+		}
+	}
+
+	c.cells[row][column] = cell
+	if column > c.lastColumn {
+		c.lastColumn = column
+	}
+
+	c.dirty = true
+	c.keys = nil
+	if row > 0 {
+		c.rendered = true
+	}
+}
+
+func (c *sortedContent) RemoveRow(row int) {
+	if row < 0 || row >= len(c.cells) {
+		return
+	}
+
+	c.cells = append(c.cells[:row], c.cells[row+1:]...)
+	c.dirty = true
+	c.keys = nil
+}
+
+func (c *sortedContent) RemoveColumn(column int) {
+	for row := range c.cells {
+		if column < 0 || column >= len(c.cells[row]) {
+			continue
+		}
+
+		c.cells[row] = append(c.cells[row][:column], c.cells[row][column+1:]...)
+	}
+
+	if column >= 0 && column <= c.lastColumn {
+		c.lastColumn--
+	}
+
+	c.dirty = true
+	c.keys = nil
+}
+
+func (c *sortedContent) InsertRow(row int) {
+	if row < 0 || row >= len(c.cells) {
+		return
+	}
+
+	c.cells = append(c.cells, nil)
+	copy(c.cells[row+1:], c.cells[row:])
+	c.cells[row] = nil
+	c.dirty = true
+	c.keys = nil
+}
+
+func (c *sortedContent) InsertColumn(column int) {
+	for row := range c.cells {
+		if column < 0 || column >= len(c.cells[row]) {
+			continue
+		}
+
+		c.cells[row] = append(c.cells[row], nil)
+		copy(c.cells[row][column+1:], c.cells[row][column:])
+		c.cells[row][column] = &tview.TableCell{}
+	}
+
+	c.lastColumn++
+	c.dirty = true
+	c.keys = nil
+}
+
+func (c *sortedContent) Clear() {
+	if len(c.cells) > 1 {
+		c.previous = c.snapshot()
+	}
+
+	c.cells = nil
+	c.lastColumn = -1
+	c.order = nil
+	c.dirty = false
+	c.keys = nil
+}
+
+// commit compares the rows rendered since the last commit to the
+// previous render to detect changes. It reports false if no rows
+// were rendered.
+func (c *sortedContent) commit() bool {
+	if !c.rendered {
+		return false
+	}
+
+	c.detectChanges()
+	c.rendered = false
+
+	return true
+}
+
+func (c *sortedContent) snapshot() map[string]string {
+	rows := make(map[string]string, len(c.cells))
+	for row := 1; row < len(c.cells); row++ {
+		rows[c.key(row)] = c.rowText(row)
+	}
+
+	return rows
+}
+
+// detectChanges marks rows as changed which are new or differ from
+// the previous render. If none of the rows existed before, the table
+// shows a different data set and nothing is marked.
+func (c *sortedContent) detectChanges() {
+	now := time.Now()
+	for key, at := range c.changed {
+		if now.Sub(at) > c.highlightDuration {
+			delete(c.changed, key)
+		}
+	}
+
+	if len(c.previous) == 0 || c.highlightDuration <= 0 {
+		return
+	}
+
+	var overlap bool
+	changed := []string{}
+	for row := 1; row < len(c.cells); row++ {
+		key := c.key(row)
+		text, ok := c.previous[key]
+		if ok {
+			overlap = true
+		}
+
+		if !ok || text != c.rowText(row) {
+			changed = append(changed, key)
+		}
+	}
+
+	if !overlap {
+		return
+	}
+
+	for _, key := range changed {
+		c.changed[key] = now
+	}
+}
+
+func (c *sortedContent) isHighlighted(key string) bool {
+	at, ok := c.changed[key]
+	return ok && time.Since(at) <= c.highlightDuration
+}
+
+func (c *sortedContent) hasHighlights() bool {
+	for key := range c.changed {
+		if c.isHighlighted(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// displayedRow returns the displayed row for the given key
+// or -1 if there is no such row.
+func (c *sortedContent) displayedRow(key string) int {
+	for row := 1; row < len(c.cells); row++ {
+		if c.key(c.renderedRow(row)) == key {
+			return row
+		}
+	}
+
+	return -1
+}
+
+func (c *sortedContent) key(row int) string {
+	if len(c.keys) != len(c.cells) {
+		c.keys = c.rowKeys()
+	}
+
+	return c.keys[row]
+}
+
+// rowKeys returns the key of each rendered row. Rows whose key
+// columns repeat the ones of an earlier row get a suffix with
+// the number of the repetition.
+func (c *sortedContent) rowKeys() []string {
+	keys := make([]string, len(c.cells))
+	seen := map[string]int{}
+	for row := 1; row < len(c.cells); row++ {
+		texts := make([]string, 0, len(c.keyColumns))
+		for _, column := range c.keyColumns {
+			if column < len(c.cells[row]) && c.cells[row][column] != nil {
+				texts = append(texts, c.cells[row][column].Text)
+			} else {
+				texts = append(texts, "")
+			}
+		}
+
+		key := strings.Join(texts, "\x00")
+		if n := seen[key]; n > 0 {
+			seen[key] = n + 1
+			key = fmt.Sprintf("%s\x00#%d", key, n)
+		} else {
+			seen[key] = 1
+		}
+
+		keys[row] = key
+	}
+
+	return keys
+}
+
+func (c *sortedContent) rowText(row int) string {
+	texts := make([]string, 0, len(c.cells[row]))
+	for column, cell := range c.cells[row] {
+		if cell != nil && !c.volatile[column] {
+			texts = append(texts, cell.Text)
+		}
+	}
+
+	return strings.Join(texts, "\x00")
+}
+
+// sortBy sorts the rows by the given column. A negative
+// column restores the order in which the rows were rendered.
+func (c *sortedContent) sortBy(column int, descending bool) {
+	c.column = column
+	c.descending = descending
+	c.dirty = true
+}
+
+func (c *sortedContent) renderedRow(row int) int {
+	if c.column < 0 || row < 1 || row >= len(c.cells) {
+		return row
+	}
+
+	if c.dirty || len(c.order) != len(c.cells) {
+		c.sort()
+	}
+
+	return c.order[row]
+}
+
+func (c *sortedContent) sort() {
+	order := make([]int, len(c.cells))
+	for i := range order {
+		order[i] = i
+	}
+
+	rows := order[1:]
+	sort.SliceStable(rows, func(i, j int) bool {
+		cmp := compareValues(c.text(rows[i]), c.text(rows[j]))
+		if c.descending {
+			return cmp > 0
+		}
+
+		return cmp < 0
+	})
+
+	c.order = order
+	c.dirty = false
+}
+
+func (c *sortedContent) text(row int) string {
+	if c.column >= len(c.cells[row]) || c.cells[row][c.column] == nil {
+		return ""
+	}
+
+	return c.cells[row][c.column].Text
+}
@@ -4,7 +4,10 @@
 package primitives_test
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -116,3 +119,368 @@ func TestTable_SetTitle(t *testing.T) {
 
 	r.Equal(p.GetTitle(), "test")
 }
+
+func TestTable_SetSelectionChangedFunc(t *testing.T) {
+	r := require.New(t)
+
+	tb := primitives.NewTable()
+	p := tb.Primitive().(*tview.Table)
+
+	tb.RenderHeader([]string{"col1"})
+	tb.RenderRow([]string{"row1"}, 1, tcell.ColorWhite)
+	tb.RenderRow([]string{"row2"}, 2, tcell.ColorWhite)
+
+	var selected string
+	tb.SetSelectionChangedFunc(func(row, column int) {
+		selected = tb.GetCellContent(row, column)
+	})
+
+	p.Select(2, 0)
+
+	r.Equal(selected, "row2")
+}
+
+func TestTable_Sort(t *testing.T) {
+	r := require.New(t)
+
+	render := func(tb *primitives.Table, rows ...[]string) {
+		tb.Clear()
+		tb.RenderHeader([]string{"name", "value"})
+		for i, row := range rows {
+			tb.RenderRow(row, i+1, tcell.ColorWhite)
+		}
+		tb.Commit()
+	}
+
+	column := func(tb *primitives.Table, col int) []string {
+		p := tb.Primitive().(*tview.Table)
+		var result []string
+		for row := 1; row < p.GetRowCount(); row++ {
+			result = append(result, tb.GetCellContent(row, col))
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		values   []string
+		expected []string
+	}{
+		{"strings", []string{"b", "C", "a"}, []string{"a", "b", "C"}},
+		{"numbers", []string{"10", "9", "100"}, []string{"9", "10", "100"}},
+		{"counts", []string{"3/4", "1/4", "2/2", "0/1"}, []string{"0/1", "1/4", "3/4", "2/2"}},
+		{"durations", []string{"2h", "3d", "45s", "5m"}, []string{"45s", "5m", "2h", "3d"}},
+		{"durations ago", []string{"1h0m0s ago", "10s ago", "2m3s ago"}, []string{"10s ago", "2m3s ago", "1h0m0s ago"}},
+		{"times", []string{"2021-05-12T14:27:03+02:00", "2020-01-01T00:00:00Z", "2021-05-12T14:28:37+02:00"}, []string{"2020-01-01T00:00:00Z", "2021-05-12T14:27:03+02:00", "2021-05-12T14:28:37+02:00"}},
+		{"versions", []string{"1.2.10", "1.2.9", "v1.10.0", "1.3.0-beta"}, []string{"1.2.9", "1.2.10", "1.3.0-beta", "v1.10.0"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := primitives.NewTable()
+
+			var rows [][]string
+			for i, v := range tc.values {
+				rows = append(rows, []string{fmt.Sprint(i), v})
+			}
+
+			render(tb, rows...)
+			tb.SortBy(1, false)
+			r.Equal(tc.expected, column(tb, 1))
+
+			tb.ToggleSortDirection()
+			reversed := make([]string, len(tc.expected))
+			for i, v := range tc.expected {
+				reversed[len(tc.expected)-1-i] = v
+			}
+			r.Equal(reversed, column(tb, 1))
+		})
+	}
+
+	t.Run("It keeps the sort order when rendered again", func(t *testing.T) {
+		tb := primitives.NewTable()
+
+		render(tb, []string{"b", "1"}, []string{"a", "2"})
+		tb.SortBy(0, false)
+		r.Equal([]string{"a", "b"}, column(tb, 0))
+
+		render(tb, []string{"d", "1"}, []string{"c", "2"}, []string{"e", "3"})
+		r.Equal([]string{"c", "d", "e"}, column(tb, 0))
+	})
+
+	t.Run("It shows the sort indicator in the header", func(t *testing.T) {
+		tb := primitives.NewTable()
+
+		render(tb, []string{"b", "1"})
+
+		tb.CycleSortColumn()
+		r.Equal("name ▲", tb.GetCellContent(0, 0))
+		r.Equal("value", tb.GetCellContent(0, 1))
+
+		tb.ToggleSortDirection()
+		r.Equal("name ▼", tb.GetCellContent(0, 0))
+
+		tb.CycleSortColumn()
+		r.Equal("name", tb.GetCellContent(0, 0))
+		r.Equal("value ▲", tb.GetCellContent(0, 1))
+
+		// after the last column the table is unsorted
+		tb.CycleSortColumn()
+		col, _ := tb.SortColumn()
+		r.Equal(-1, col)
+		r.Equal("value", tb.GetCellContent(0, 1))
+	})
+
+	t.Run("It restores the rendered order when unsorted", func(t *testing.T) {
+		tb := primitives.NewTable()
+
+		render(tb, []string{"b", "1"}, []string{"a", "2"})
+		tb.SortBy(0, false)
+		tb.SortBy(-1, false)
+
+		r.Equal([]string{"b", "a"}, column(tb, 0))
+	})
+}
+
+func TestTable_SortKeys(t *testing.T) {
+	r := require.New(t)
+
+	tb := primitives.NewTable()
+	p := tb.Primitive().(*tview.Table)
+
+	var captured []rune
+	tb.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		captured = append(captured, event.Rune())
+		return event
+	})
+
+	tb.RenderHeader([]string{"col1", "col2"})
+
+	capture := p.GetInputCapture()
+
+	// The sort keys are handled by the table
+	r.Nil(capture(tcell.NewEventKey(tcell.KeyRune, primitives.KeySortColumn, tcell.ModNone)))
+	col, desc := tb.SortColumn()
+	r.Equal(0, col)
+	r.False(desc)
+
+	r.Nil(capture(tcell.NewEventKey(tcell.KeyRune, primitives.KeySortDirection, tcell.ModNone)))
+	_, desc = tb.SortColumn()
+	r.True(desc)
+
+	// Other keys are passed to the custom capture
+	event := capture(tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone))
+	r.NotNil(event)
+	r.Equal([]rune{'e'}, captured)
+
+	// Tables which aren't sortable pass the sort keys on
+	// and show the rows in the order they were rendered
+	tb.SetSortable(false)
+	col, _ = tb.SortColumn()
+	r.Equal(-1, col)
+
+	event = capture(tcell.NewEventKey(tcell.KeyRune, primitives.KeySortColumn, tcell.ModNone))
+	r.NotNil(event)
+	col, _ = tb.SortColumn()
+	r.Equal(-1, col)
+	r.Equal([]rune{'e', primitives.KeySortColumn}, captured)
+
+	tb.CycleSortColumn()
+	col, _ = tb.SortColumn()
+	r.Equal(-1, col)
+}
+
+func TestTable_Refresh(t *testing.T) {
+	r := require.New(t)
+
+	render := func(tb *primitives.Table, rows ...[]string) {
+		tb.Clear()
+		tb.RenderHeader([]string{"id", "status"})
+		for i, row := range rows {
+			tb.RenderRow(row, i+1, tcell.ColorWhite)
+		}
+		tb.Commit()
+	}
+
+	t.Run("The selection sticks to the same row", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "running"}, []string{"b", "running"}, []string{"c", "running"})
+		p.Select(2, 0)
+		r.Equal("b", tb.GetCellContent(2, 0))
+
+		// a new row is added on top
+		render(tb, []string{"z", "pending"}, []string{"a", "running"}, []string{"b", "running"}, []string{"c", "running"})
+
+		row, _ := tb.GetSelection()
+		r.Equal(3, row)
+		r.Equal("b", tb.GetCellContent(row, 0))
+	})
+
+	t.Run("Reading the rows doesn't move the selection", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "running"}, []string{"b", "running"})
+		p.Select(2, 0)
+
+		var changed int
+		tb.SetSelectionChangedFunc(func(row, column int) {
+			changed++
+		})
+
+		// tview reads the rows while drawing, before the render is committed
+		tb.Clear()
+		tb.RenderHeader([]string{"id", "status"})
+		tb.RenderRow([]string{"z", "pending"}, 1, tcell.ColorWhite)
+		tb.RenderRow([]string{"a", "running"}, 2, tcell.ColorWhite)
+		tb.RenderRow([]string{"b", "running"}, 3, tcell.ColorWhite)
+
+		r.Equal(4, p.GetRowCount())
+		r.Equal("a", p.GetCell(2, 0).Text)
+
+		row, _ := tb.GetSelection()
+		r.Equal(2, row)
+		r.Equal(0, changed)
+
+		tb.Commit()
+
+		row, _ = tb.GetSelection()
+		r.Equal(3, row)
+		r.Equal(1, changed)
+
+		// committing again without new rows changes nothing
+		tb.Commit()
+		r.Equal(1, changed)
+	})
+
+	t.Run("The selection sticks to the same row when sorting", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "3"}, []string{"b", "2"}, []string{"c", "1"})
+		p.Select(1, 0)
+
+		tb.SortBy(1, false)
+
+		row, _ := tb.GetSelection()
+		r.Equal(3, row)
+		r.Equal("a", tb.GetCellContent(row, 0))
+	})
+
+	t.Run("The selection stays in place when the row is gone", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "running"}, []string{"b", "running"})
+		p.Select(2, 0)
+
+		render(tb, []string{"a", "running"}, []string{"c", "running"})
+
+		row, _ := tb.GetSelection()
+		r.Equal(2, row)
+	})
+
+	t.Run("The selection sticks to the same row by its key columns", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+		tb.SetKeyColumns(0, 1)
+
+		render(tb, []string{"a", "80"}, []string{"a", "443"}, []string{"b", "80"})
+		p.Select(2, 0)
+
+		// a row with the same first column is added on top
+		render(tb, []string{"a", "8080"}, []string{"a", "80"}, []string{"a", "443"}, []string{"b", "80"})
+
+		row, _ := tb.GetSelection()
+		r.Equal(3, row)
+		r.Equal("443", tb.GetCellContent(row, 1))
+
+		// only the new row is highlighted
+		r.Equal(styles.TcellColorChanged, p.GetCell(1, 0).BackgroundColor)
+		r.NotEqual(styles.TcellColorChanged, p.GetCell(2, 0).BackgroundColor)
+		r.NotEqual(styles.TcellColorChanged, p.GetCell(3, 0).BackgroundColor)
+	})
+
+	t.Run("Rows with the same key are told apart by their order", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "running"}, []string{"a", "pending"}, []string{"b", "running"})
+		p.Select(2, 0)
+
+		render(tb, []string{"a", "running"}, []string{"a", "dead"}, []string{"b", "running"})
+
+		row, _ := tb.GetSelection()
+		r.Equal(2, row)
+
+		r.NotEqual(styles.TcellColorChanged, p.GetCell(1, 0).BackgroundColor)
+		r.Equal(styles.TcellColorChanged, p.GetCell(2, 0).BackgroundColor)
+	})
+
+	t.Run("Changed rows are highlighted", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "running"}, []string{"b", "running"})
+
+		render(tb, []string{"a", "running"}, []string{"b", "dead"}, []string{"c", "pending"})
+
+		bgA := p.GetCell(1, 0).BackgroundColor
+		bgB := p.GetCell(2, 0).BackgroundColor
+		bgC := p.GetCell(3, 1).BackgroundColor
+
+		r.NotEqual(styles.TcellColorChanged, bgA)
+		r.Equal(styles.TcellColorChanged, bgB)
+		r.Equal(styles.TcellColorChanged, bgC)
+	})
+
+	t.Run("Changes in volatile columns are not highlighted", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+		tb.SetVolatileColumns(1)
+
+		render(tb, []string{"a", "10MiB"}, []string{"b", "20MiB"})
+
+		render(tb, []string{"a", "12MiB"}, []string{"b", "20MiB"})
+
+		bg := p.GetCell(1, 0).BackgroundColor
+		r.NotEqual(styles.TcellColorChanged, bg)
+	})
+
+	t.Run("Rows of a different data set are not highlighted", func(t *testing.T) {
+		tb := primitives.NewTable()
+		p := tb.Primitive().(*tview.Table)
+
+		render(tb, []string{"a", "running"})
+
+		render(tb, []string{"x", "running"}, []string{"y", "running"})
+
+		bg := p.GetCell(1, 0).BackgroundColor
+		r.NotEqual(styles.TcellColorChanged, bg)
+	})
+
+	t.Run("The table is redrawn once the highlight expired", func(t *testing.T) {
+		tb := primitives.NewTable()
+
+		var mutex sync.Mutex
+		var redraws int
+		tb.SetRedrawFunc(func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			redraws++
+		})
+
+		render(tb, []string{"a", "running"}, []string{"b", "running"})
+		render(tb, []string{"a", "dead"}, []string{"b", "running"})
+		render(tb, []string{"a", "dead"}, []string{"b", "dead"})
+
+		// the commits share a single timer
+		time.Sleep(primitives.HighlightDuration + 500*time.Millisecond)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		r.Equal(1, redraws)
+	})
+}
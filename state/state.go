@@ -4,23 +4,53 @@
 package state
 
 import (
+	"sync"
+
 	"github.com/hashicorp/nomad/api"
 	"github.com/rivo/tview"
 
+	"github.com/hcjulz/damon/logbuffer"
 	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/statsbuffer"
 )
 
 type State struct {
 	NomadAddress      string
 	CurrentSubscriber api.Topic
 
+	// Token is the ACL token used to talk to Nomad.
+	Token *models.Token
+
 	Jobs        []*models.Job
 	Deployments []*models.Deployment
 	TaskGroups  []*models.TaskGroup
 	Allocations []*models.Alloc
 	Namespaces  []*models.Namespace
-	Logs        []byte
+	LogBuffer   *logbuffer.Buffer
+	LogStart    *models.LogStart
 	JobStatus   *models.JobStatus
+	JobVersions []*models.JobVersion
+	AllocDetail *models.AllocDetail
+	AllocStats  *statsbuffer.Buffer
+	Variables   []*models.Variable
+	Services    []*models.Service
+	Volumes     []*models.Volume
+	CSIPlugins  []*models.CSIPlugin
+	Volume      *models.Volume
+	ACLPolicies []*models.ACLPolicy
+	ACLRoles    []*models.ACLRole
+	ACLTokens   []*models.ACLToken
+	Cluster     *models.Cluster
+	Nodes       []*models.Node
+	NodeDetail  *models.NodeDetail
+	NodePools   []*models.NodePool
+	Evaluations []*models.Evaluation
+
+	// DeploymentDetail is the deployment a detail view shows.
+	DeploymentDetail *models.DeploymentDetail
+
+	// ServiceInstances is nil until the instances were read.
+	ServiceInstances []*models.ServiceInstance
 
 	SelectedNamespace string
 	SelectedRegion    string
@@ -30,6 +60,27 @@ type State struct {
 	Elements *Elements
 
 	Toggle *Toggle
+
+	// allocsMutex guards Allocations, as streams of
+	// the watcher read them from their own goroutines.
+	allocsMutex sync.RWMutex
+}
+
+// SetAllocations replaces the allocations.
+func (s *State) SetAllocations(allocs []*models.Alloc) {
+	s.allocsMutex.Lock()
+	defer s.allocsMutex.Unlock()
+
+	s.Allocations = allocs
+}
+
+// AllocationsSnapshot returns the allocations, such that
+// they can be read while the watcher replaces them.
+func (s *State) AllocationsSnapshot() []*models.Alloc {
+	s.allocsMutex.RLock()
+	defer s.allocsMutex.RUnlock()
+
+	return s.Allocations
 }
 
 type Filter struct {
@@ -43,6 +94,21 @@ type Filter struct {
 	Namespaces  string
 	Allocations string
 	TaskGroups  string
+	Tasks       string
+	TaskEvents  string
+	Files       string
+	Variables   string
+	Services    string
+	Instances   string
+	Volumes     string
+	Claims      string
+	Plugins     string
+	ACLPolicies string
+	ACLRoles    string
+	ACLTokens   string
+	Nodes       string
+	NodePools   string
+	Evaluations string
 }
 
 type Toggle struct {
@@ -50,6 +116,12 @@ type Toggle struct {
 	Search       bool
 	LogSearch    bool
 	LogHighlight bool
+	LogFind      bool
+	LogSave      bool
+	LogStart     bool
+	Bookmark     bool
+	Scale        bool
+	SpecFind     bool
 }
 
 type Elements struct {
@@ -59,8 +131,10 @@ type Elements struct {
 
 func New() *State {
 	return &State{
-		Filter:   &Filter{},
-		Elements: &Elements{},
-		Toggle:   &Toggle{},
+		LogBuffer:  logbuffer.New(logbuffer.DefaultLimit),
+		AllocStats: statsbuffer.New(statsbuffer.DefaultLimit),
+		Filter:     &Filter{},
+		Elements:   &Elements{},
+		Toggle:     &Toggle{},
 	}
 }
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+// Package statsbuffer keeps the recent resource usage samples of
+// allocations, such that usage can be graphed over time while
+// using a constant amount of memory per allocation.
+package statsbuffer
+
+import (
+	"sync"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// DefaultLimit is the number of samples kept per allocation by default.
+const DefaultLimit = 30
+
+// Buffer holds the latest samples of every allocation it
+// was given usage for. It is written by the watcher while
+// the views read from it.
+type Buffer struct {
+	mutex sync.RWMutex
+
+	limit int
+	stats map[string]*models.AllocStats
+}
+
+// New returns a buffer which keeps up to limit samples per allocation.
+func New(limit int) *Buffer {
+	if limit < 1 {
+		limit = DefaultLimit
+	}
+
+	return &Buffer{
+		limit: limit,
+		stats: map[string]*models.AllocStats{},
+	}
+}
+
+// Add appends a sample to the history of the allocation,
+// dropping the oldest sample when the history is full.
+func (b *Buffer) Add(allocID string, usage *models.AllocUsage) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	stats, ok := b.stats[allocID]
+	if !ok {
+		stats = &models.AllocStats{Tasks: map[string][]models.ResourceUsage{}}
+		b.stats[allocID] = stats
+	}
+
+	stats.Usage = b.append(stats.Usage, usage.ResourceUsage)
+	for task, u := range usage.Tasks {
+		stats.Tasks[task] = b.append(stats.Tasks[task], u)
+	}
+}
+
+func (b *Buffer) append(samples []models.ResourceUsage, usage models.ResourceUsage) []models.ResourceUsage {
+	samples = append(samples, usage)
+	if len(samples) > b.limit {
+		samples = samples[len(samples)-b.limit:]
+	}
+
+	return samples
+}
+
+// Get returns a copy of the history of the allocation,
+// nil if there are no samples for it.
+func (b *Buffer) Get(allocID string) *models.AllocStats {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	stats, ok := b.stats[allocID]
+	if !ok {
+		return nil
+	}
+
+	result := &models.AllocStats{
+		Usage: append([]models.ResourceUsage{}, stats.Usage...),
+		Tasks: make(map[string][]models.ResourceUsage, len(stats.Tasks)),
+	}
+
+	for task, samples := range stats.Tasks {
+		result.Tasks[task] = append([]models.ResourceUsage{}, samples...)
+	}
+
+	return result
+}
+
+// Retain drops the history of all allocations but the given ones.
+func (b *Buffer) Retain(allocIDs []string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	keep := make(map[string]bool, len(allocIDs))
+	for _, id := range allocIDs {
+		keep[id] = true
+	}
+
+	for id := range b.stats {
+		if !keep[id] {
+			delete(b.stats, id)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package statsbuffer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/statsbuffer"
+)
+
+func sample(cpu float64, task float64) *models.AllocUsage {
+	return &models.AllocUsage{
+		ResourceUsage: models.ResourceUsage{CPU: cpu},
+		Tasks: map[string]models.ResourceUsage{
+			"web": {CPU: task},
+		},
+	}
+}
+
+func cpus(samples []models.ResourceUsage) []float64 {
+	result := []float64{}
+	for _, s := range samples {
+		result = append(result, s.CPU)
+	}
+
+	return result
+}
+
+func TestBuffer(t *testing.T) {
+	r := require.New(t)
+
+	b := statsbuffer.New(3)
+	r.Nil(b.Get("moon"))
+
+	// It keeps samples in order
+	b.Add("moon", sample(1, 10))
+	b.Add("moon", sample(2, 20))
+	stats := b.Get("moon")
+	r.Equal([]float64{1, 2}, cpus(stats.Usage))
+	r.Equal([]float64{10, 20}, cpus(stats.Tasks["web"]))
+
+	// It drops the oldest samples when full
+	b.Add("moon", sample(3, 30))
+	b.Add("moon", sample(4, 40))
+	stats = b.Get("moon")
+	r.Equal([]float64{2, 3, 4}, cpus(stats.Usage))
+	r.Equal([]float64{20, 30, 40}, cpus(stats.Tasks["web"]))
+
+	// It returns a copy
+	stats.Usage[0].CPU = 42
+	r.Equal([]float64{2, 3, 4}, cpus(b.Get("moon").Usage))
+
+	// It keeps allocations apart and drops the ones not retained
+	b.Add("sun", sample(5, 50))
+	r.Equal([]float64{5}, cpus(b.Get("sun").Usage))
+
+	b.Retain([]string{"sun"})
+	r.Nil(b.Get("moon"))
+	r.NotNil(b.Get("sun"))
+}
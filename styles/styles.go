@@ -24,6 +24,8 @@ var (
 	ColorLightGreyHex     = "#cccccc"
 	ColorModalInfoHex     = "#61877f"
 	ColorAttentionHex     = "#d98b6a"
+	ColorChangedHex       = "#3d4a52"
+	ColorWarningHex       = "#ffd126"
 
 	StandardColorTag      = fmt.Sprintf("[%s]", StandardColorHex)
 	HighlightPrimaryTag   = fmt.Sprintf("[%s]", HighlightPrimaryHex)
@@ -31,6 +33,7 @@ var (
 	ColorActiveTag        = fmt.Sprintf("[%s]", ColorActiveHex)
 	ColorWhiteTag         = fmt.Sprintf("[%s]", ColorWhiteHex)
 	ColorLighGreyTag      = fmt.Sprintf("[%s]", ColorLightGreyHex)
+	ColorAttentionTag     = fmt.Sprintf("[%s]", ColorAttentionHex)
 
 	TcellColorHighlighPrimary   = tcell.GetColor(HighlightPrimaryHex)
 	TcellColorHighlighSecondary = tcell.GetColor(HighlightSecondaryHex)
@@ -38,4 +41,5 @@ var (
 	TcellColorActive            = tcell.GetColor(ColorActiveHex)
 	TcellColorModalInfo         = tcell.GetColor(ColorModalInfoHex)
 	TcellColorAttention         = tcell.GetColor(ColorAttentionHex)
+	TcellColorChanged           = tcell.GetColor(ColorChangedHex)
 )
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/styles"
+)
+
+// tokenInfo describes the ACL token for the cluster info.
+func tokenInfo(token *models.Token) string {
+	switch {
+	case token == nil || token.Disabled:
+		return "ACLs disabled"
+	case token.AccessorID == "" && token.Unresolved:
+		return "unknown"
+	}
+
+	name := token.Name
+	if name == "" {
+		name = token.AccessorID
+	}
+
+	info := fmt.Sprintf("%s (%s)", name, token.Type)
+	if token.Unresolved {
+		info += fmt.Sprintf(" %spolicies unknown%s", styles.ColorLighGreyTag, styles.StandardColorTag)
+	}
+
+	return info
+}
+
+// restrict annotates the commands the token has
+// no capability for in the selected namespace.
+func (v *View) restrict(commands []string, capabilities map[string]string) []string {
+	return component.Restrict(commands, capabilities, func(capability string) bool {
+		return v.state.Token.Allowed(v.state.SelectedNamespace, capability)
+	})
+}
+
+// permitted reports whether the token has the capability in the
+// namespace. If it hasn't, the missing capability is explained.
+func (v *View) permitted(namespace, capability string) bool {
+	if v.state.Token.Allowed(namespace, capability) {
+		return true
+	}
+
+	v.handleDenied("%s", v.permissionError(namespace, capability).Error())
+	return false
+}
+
+// explain replaces a permission denial of Nomad with an
+// error naming the capability the action needs.
+func (v *View) explain(err error, namespace, capability string) error {
+	if !permissionDenied(err) {
+		return err
+	}
+
+	return v.permissionError(namespace, capability)
+}
+
+func (v *View) permissionError(namespace, capability string) *models.PermissionError {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	return &models.PermissionError{
+		Token:      v.tokenName(),
+		Namespace:  namespace,
+		Capability: capability,
+	}
+}
+
+func (v *View) tokenName() string {
+	if t := v.state.Token; t != nil && t.Name != "" {
+		return t.Name
+	}
+
+	return "anonymous"
+}
+
+// allocNamespace returns the namespace of the allocation,
+// or the selected namespace if the allocation isn't known.
+func (v *View) allocNamespace(allocID string) string {
+	if alloc, ok := v.getAllocation(allocID); ok {
+		return alloc.Namespace
+	}
+
+	return v.state.SelectedNamespace
+}
+
+func permissionDenied(err error) bool {
+	var respErr api.UnexpectedResponseError
+	if errors.As(err, &respErr) && respErr.StatusCode() == http.StatusForbidden {
+		return true
+	}
+
+	return err != nil && strings.Contains(err.Error(), "Permission denied")
+}
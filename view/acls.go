@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+const (
+	policyNameKey        = "# Name:"
+	policyDescriptionKey = "# Description:"
+	policyHelp           = "#\n# The name and description are read from the lines above,\n# everything else are the rules of the policy."
+
+	// policyTemplate are the rules a new policy starts with.
+	policyTemplate = `namespace "default" {
+  policy = "read"
+}
+`
+)
+
+// ACLPolicies lists the ACL policies.
+func (v *View) ACLPolicies() {
+	if !v.managingACL() {
+		return
+	}
+
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleACLPolicies)
+
+	v.Layout.Container.SetInputCapture(v.InputACLPolicies)
+	v.components.Commands.Update(component.ACLPolicyCommands)
+
+	search := v.components.Search
+	table := v.components.ACLPolicyTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterACLPolicies()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.ACLPolicies = text
+		update()
+	}
+
+	v.Watcher.SubscribeToACL(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.ACLPolicies()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicACL, v.ACLPolicies)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterACLPolicies() []*models.ACLPolicy {
+	query := v.parseFilter(v.state.Filter.ACLPolicies, aclPolicySchema)
+
+	result := []*models.ACLPolicy{}
+	for _, policy := range v.state.ACLPolicies {
+		if query == nil || query.Match(aclPolicyFields(policy)) {
+			result = append(result, policy)
+		}
+	}
+
+	return result
+}
+
+// ACLPolicy shows the rules of an ACL policy.
+func (v *View) ACLPolicy(name string) {
+	v.viewSwitch()
+	v.Watcher.Unsubscribe()
+	v.Layout.Body.SetTitle(titleACLPolicy)
+	v.Layout.Body.Clear()
+
+	v.Layout.Container.SetInputCapture(v.InputACLPolicy)
+	v.components.Commands.Update(component.ACLPolicyRuleCommands)
+
+	rules := v.components.ACLPolicyRules
+	rules.Props.Data = nil
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicACL, func() {
+		v.ACLPolicy(name)
+	})
+
+	policy, err := v.Client.ACLPolicy(name)
+	if err != nil {
+		v.handleError("Failed to read ACL policy %s: %s", name, err.Error())
+		return
+	}
+
+	rules.Props.Data = policy
+	rules.Render()
+
+	v.Layout.Container.SetFocus(rules.TextView.Primitive())
+}
+
+// ACLRoles lists the ACL roles with their policies.
+func (v *View) ACLRoles() {
+	if !v.managingACL() {
+		return
+	}
+
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleACLRoles)
+
+	v.Layout.Container.SetInputCapture(v.InputACLRoles)
+	v.components.Commands.Update(component.ACLRoleCommands)
+
+	search := v.components.Search
+	table := v.components.ACLRoleTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterACLRoles()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.ACLRoles = text
+		update()
+	}
+
+	v.Watcher.SubscribeToACL(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.ACLRoles()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicACL, v.ACLRoles)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterACLRoles() []*models.ACLRole {
+	query := v.parseFilter(v.state.Filter.ACLRoles, aclRoleSchema)
+
+	result := []*models.ACLRole{}
+	for _, role := range v.state.ACLRoles {
+		if query == nil || query.Match(aclRoleFields(role)) {
+			result = append(result, role)
+		}
+	}
+
+	return result
+}
+
+// ACLTokens lists the ACL tokens.
+func (v *View) ACLTokens() {
+	if !v.managingACL() {
+		return
+	}
+
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleACLTokens)
+
+	v.Layout.Container.SetInputCapture(v.InputACLTokens)
+	v.components.Commands.Update(component.ACLTokenCommands)
+
+	search := v.components.Search
+	table := v.components.ACLTokenTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterACLTokens()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.ACLTokens = text
+		update()
+	}
+
+	v.Watcher.SubscribeToACL(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.ACLTokens()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicACL, v.ACLTokens)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterACLTokens() []*models.ACLToken {
+	query := v.parseFilter(v.state.Filter.ACLTokens, aclTokenSchema)
+
+	result := []*models.ACLToken{}
+	for _, token := range v.state.ACLTokens {
+		if query == nil || query.Match(aclTokenFields(token)) {
+			result = append(result, token)
+		}
+	}
+
+	return result
+}
+
+func (v *View) InputACLPolicies(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'c':
+		v.writeACLPolicy(&models.ACLPolicy{Rules: policyTemplate})
+		return nil
+
+	case 'e':
+		if name := v.components.ACLPolicyTable.GetNameForSelection(); name != "" {
+			v.editACLPolicy(name)
+		}
+		return nil
+	}
+
+	return v.inputACL(event)
+}
+
+func (v *View) InputACLPolicy(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'e' {
+		if policy := v.components.ACLPolicyRules.Props.Data; policy != nil {
+			v.writeACLPolicy(policy)
+		}
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) InputACLRoles(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	return v.inputACL(event)
+}
+
+func (v *View) InputACLTokens(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'd' {
+		if token := v.components.ACLTokenTable.GetTokenForSelection(); token != nil {
+			v.revokeToken(token)
+		}
+		return nil
+	}
+
+	return v.inputACL(event)
+}
+
+// inputACL switches between the policies, roles and tokens.
+func (v *View) inputACL(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Rune() {
+	case 'p':
+		v.ACLPolicies()
+		return nil
+	case 'r':
+		v.ACLRoles()
+		return nil
+	case 't':
+		v.ACLTokens()
+		return nil
+	}
+
+	return event
+}
+
+// managingACL reports whether the ACL views can be opened. Listing
+// ACL objects needs a management token; if the token couldn't be
+// read Nomad decides.
+func (v *View) managingACL() bool {
+	token := v.state.Token
+	switch {
+	case token == nil || token.Unresolved || token.Type == models.TokenTypeManagement:
+		return true
+	case token.Disabled:
+		v.handleDenied("ACLs are disabled in this cluster.")
+		return false
+	}
+
+	v.handleDenied(
+		"Managing ACLs needs a management token, but the ACL token %q is a %s token.",
+		v.tokenName(),
+		token.Type,
+	)
+	return false
+}
+
+// editACLPolicy reads the policy, opens it in the editor and updates it.
+func (v *View) editACLPolicy(name string) {
+	policy, err := v.Client.ACLPolicy(name)
+	if err != nil {
+		v.handleError("Failed to read ACL policy %s: %s", name, err.Error())
+		return
+	}
+
+	v.writeACLPolicy(policy)
+}
+
+// writeACLPolicy opens the policy in the editor and writes it. A
+// policy without a modify index is new and mustn't exist yet.
+func (v *View) writeACLPolicy(policy *models.ACLPolicy) {
+	doc := policyDocument(policy)
+
+	edited, err := v.edit("damon-policy-*.hcl", doc)
+	if err != nil {
+		v.handleError("Failed to edit ACL policy: %s", err.Error())
+		return
+	}
+
+	// nothing to write if the editor was closed without changes
+	if bytes.Equal(bytes.TrimSpace(edited), bytes.TrimSpace(doc)) {
+		return
+	}
+
+	out := parsePolicyDocument(edited)
+	switch {
+	case out.Name == "":
+		v.handleError("Invalid ACL policy: the name is missing")
+		return
+	case strings.TrimSpace(out.Rules) == "":
+		v.handleError("Invalid ACL policy: the rules are missing")
+		return
+	case policy.ModifyIndex != 0 && out.Name != policy.Name:
+		v.handleError("Invalid ACL policy: the policy %s can't be renamed", policy.Name)
+		return
+	case policy.ModifyIndex == 0 && v.aclPolicyExists(out.Name):
+		v.handleError("Invalid ACL policy: the policy %s exists already", out.Name)
+		return
+	}
+
+	if err := v.Client.PutACLPolicy(out); err != nil {
+		v.handleError("Failed to write ACL policy %s: %s", out.Name, err.Error())
+		return
+	}
+
+	v.ACLPolicy(out.Name)
+}
+
+func (v *View) aclPolicyExists(name string) bool {
+	for _, p := range v.state.ACLPolicies {
+		if p.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// revokeToken asks for confirmation and deletes the token.
+func (v *View) revokeToken(token *models.ACLToken) {
+	msg := fmt.Sprintf("Do you really want to revoke the token %s (%s)? Everything using it loses access right away.", token.Name, token.AccessorID)
+	if self := v.state.Token; self != nil && self.AccessorID == token.AccessorID {
+		msg = fmt.Sprintf("%s\n\nThis is the token Damon uses!", msg)
+	}
+
+	v.components.Confirm.Props.Done = func(index int, text string) {
+		v.closeConfirmModal()
+
+		if index == 1 {
+			err := v.Client.RevokeToken(token.AccessorID)
+			v.err(err, "Failed to revoke token")
+		}
+	}
+
+	v.components.Confirm.Render(msg)
+	v.Layout.Container.SetFocus(v.components.Confirm.Modal.Primitive())
+}
+
+// policyDocument is the document a policy is edited as: its rules
+// preceded by comment lines holding its name and description.
+func policyDocument(policy *models.ACLPolicy) []byte {
+	return []byte(fmt.Sprintf(
+		"%s %s\n%s %s\n%s\n\n%s",
+		policyNameKey,
+		policy.Name,
+		policyDescriptionKey,
+		policy.Description,
+		policyHelp,
+		policy.Rules,
+	))
+}
+
+func parsePolicyDocument(doc []byte) *models.ACLPolicy {
+	policy := &models.ACLPolicy{}
+	help := strings.Split(policyHelp, "\n")
+
+	var rules []string
+	for _, line := range strings.Split(string(doc), "\n") {
+		switch {
+		case strings.HasPrefix(line, policyNameKey):
+			policy.Name = strings.TrimSpace(strings.TrimPrefix(line, policyNameKey))
+		case strings.HasPrefix(line, policyDescriptionKey):
+			policy.Description = strings.TrimSpace(strings.TrimPrefix(line, policyDescriptionKey))
+		case len(help) > 0 && line == help[0]:
+			help = help[1:]
+		default:
+			rules = append(rules, line)
+		}
+	}
+
+	policy.Rules = strings.TrimLeft(strings.Join(rules, "\n"), "\n")
+	return policy
+}
@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// filePageSize is the number of bytes of a file read per page.
+const filePageSize = 64 << 10
+
+// filePage is the page of a file the file view shows.
+type filePage struct {
+	allocID, path string
+	size          int64
+
+	// offset and end are the bytes the page spans.
+	offset, end int64
+
+	// previous holds the offsets of the pages before,
+	// such that paging back shows the same pages.
+	previous []int64
+}
+
+// AllocFS lists a directory in the filesystem of an allocation.
+// The root directory holds the shared alloc directory and a
+// directory for every task.
+func (v *View) AllocFS(allocID, dir string) {
+	if !v.permitted(v.allocNamespace(allocID), models.CapabilityReadFS) {
+		return
+	}
+
+	v.viewSwitch()
+	v.Watcher.Unsubscribe()
+	v.Layout.Body.SetTitle(titleAllocFS)
+
+	v.Layout.Container.SetInputCapture(v.InputAllocFS)
+	v.components.Commands.Update(component.AllocFSCommands)
+
+	search := v.components.Search
+	table := v.components.AllocFSTable
+	table.Props.AllocID = allocID
+	table.Props.Path = dir
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicLog, func() {
+		v.AllocFS(allocID, dir)
+	})
+
+	files, err := v.Client.ListFiles(allocID, dir)
+	if err != nil {
+		err = v.explain(err, v.allocNamespace(allocID), models.CapabilityReadFS)
+		v.handleError("Failed to list %s: %s", dir, err.Error())
+		return
+	}
+
+	update := func() {
+		table.Props.Data = v.filterFiles(files)
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Files = text
+		update()
+	}
+
+	update()
+
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterFiles(data []*models.AllocFile) []*models.AllocFile {
+	query := v.parseFilter(v.state.Filter.Files, fileSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*models.AllocFile{}
+	for _, file := range data {
+		if query.Match(fileFields(file)) {
+			result = append(result, file)
+		}
+	}
+
+	return result
+}
+
+// File shows a file of an allocation in the log view, one page at a
+// time. The log filter, search and highlight apply to the page.
+func (v *View) File(allocID, file string) {
+	if !v.permitted(v.allocNamespace(allocID), models.CapabilityReadFS) {
+		return
+	}
+
+	v.viewSwitch()
+	v.Watcher.Unsubscribe()
+	v.logTarget = logTarget{
+		title:   fmt.Sprintf("%s %s", titleFile, file),
+		allocID: allocID,
+		path:    file,
+	}
+	v.filePage = &filePage{allocID: allocID, path: file}
+
+	v.openLogView(v.InputFile, component.FileCommands)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicLog, func() {
+		v.File(allocID, file)
+	})
+
+	info, err := v.Client.StatFile(allocID, file)
+	if err != nil {
+		err = v.explain(err, v.allocNamespace(allocID), models.CapabilityReadFS)
+		v.handleError("Failed to read %s: %s", file, err.Error())
+		return
+	}
+
+	v.filePage.size = info.Size
+	v.showFilePage(0)
+}
+
+// TailFile follows a file of an allocation in the log view, like
+// tail -f. New lines are appended as they are written.
+func (v *View) TailFile(allocID, file string) {
+	if !v.permitted(v.allocNamespace(allocID), models.CapabilityReadFS) {
+		return
+	}
+
+	v.viewSwitch()
+	v.logTarget = logTarget{
+		title:   fmt.Sprintf("%s %s", titleTailFile, file),
+		allocID: allocID,
+		path:    file,
+	}
+	v.filePage = nil
+	v.setLogTitle()
+
+	v.openLogView(v.InputLogs, component.LogCommands)
+
+	update := func() {
+		v.components.LogStream.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToFile(allocID, file, update)
+
+	update()
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicLog, func() {
+		v.TailFile(allocID, file)
+	})
+}
+
+// openLogView shows the empty log view with the given keys.
+func (v *View) openLogView(input func(event *tcell.EventKey) *tcell.EventKey, commands []string) {
+	v.components.LogSearch.InputField.SetText("")
+	v.components.LogFind.InputField.SetText("")
+	v.Layout.Body.Clear()
+	v.components.LogStream.Clear()
+
+	v.components.LogStream.Props.TaskName = path.Base(v.logTarget.path)
+
+	v.Layout.Container.SetInputCapture(input)
+	v.components.Commands.Update(commands)
+
+	v.components.LogStream.ClearDisplay()
+	v.components.LogStream.Display()
+
+	v.Layout.Container.SetFocus(v.components.LogStream.TextView.Primitive())
+}
+
+// showFilePage reads the page of the file which starts at the offset
+// into the log buffer and shows it.
+func (v *View) showFilePage(offset int64) {
+	p := v.filePage
+
+	data, err := v.Client.ReadFile(p.allocID, p.path, offset, filePageSize)
+	if err != nil {
+		v.Layout.Body.SetTitle(fmt.Sprintf("%s (failed to read: %s)", v.logTarget.title, err))
+		return
+	}
+
+	buffer := v.state.LogBuffer
+	buffer.Reset()
+
+	if bytes.IndexByte(data, 0) >= 0 {
+		p.offset, p.end = offset, p.size
+		buffer.Append(&models.LogLine{
+			Text: fmt.Sprintf("binary file (%s), hit <W> to save it", component.FormatBytes(p.size)),
+		})
+	} else {
+		eof := offset+int64(len(data)) >= p.size
+		lines, n := pageLines(data, buffer.Limit(), eof)
+		for _, text := range lines {
+			buffer.Append(&models.LogLine{Text: text})
+		}
+
+		p.offset, p.end = offset, offset+int64(n)
+	}
+
+	percent := int64(100)
+	if p.size > 0 {
+		percent = p.end * 100 / p.size
+	}
+
+	v.Layout.Body.SetTitle(fmt.Sprintf("%s (page %d, %d%%)", v.logTarget.title, len(p.previous)+1, percent))
+
+	v.components.LogStream.Clear()
+	v.components.LogStream.Render()
+	v.Draw()
+}
+
+// NextFilePage shows the page after the current page of the file.
+func (v *View) NextFilePage() {
+	p := v.filePage
+	if p == nil || p.end >= p.size {
+		return
+	}
+
+	p.previous = append(p.previous, p.offset)
+	v.showFilePage(p.end)
+}
+
+// PrevFilePage shows the page before the current page of the file.
+func (v *View) PrevFilePage() {
+	p := v.filePage
+	if p == nil || len(p.previous) == 0 {
+		return
+	}
+
+	offset := p.previous[len(p.previous)-1]
+	p.previous = p.previous[:len(p.previous)-1]
+	v.showFilePage(offset)
+}
+
+// pageLines splits a page of a file into at most limit lines. A partial
+// line at the end is left for the next page, unless it is the only line
+// or the file ends with it. It returns the lines and the number of bytes
+// they span.
+func pageLines(data []byte, limit int, eof bool) ([]string, int) {
+	lines := []string{}
+
+	n := 0
+	for n < len(data) && len(lines) < limit {
+		i := bytes.IndexByte(data[n:], '\n')
+		if i < 0 {
+			if len(lines) > 0 && !eof {
+				break
+			}
+
+			lines = append(lines, strings.TrimSuffix(string(data[n:]), "\r"))
+			n = len(data)
+			break
+		}
+
+		lines = append(lines, strings.TrimSuffix(string(data[n:n+i]), "\r"))
+		n += i + 1
+	}
+
+	return lines, n
+}
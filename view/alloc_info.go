@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// AllocInfo shows the details of an allocation, such as its
+// reschedule trail and the metrics of its placement.
+func (v *View) AllocInfo(allocID string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleAllocInfo)
+	v.Layout.Body.Clear()
+
+	v.components.Commands.Update(component.AllocInfoCommands)
+	v.Layout.Container.SetInputCapture(v.InputAllocInfo)
+
+	info := v.components.AllocInfo
+
+	update := func() {
+		info.Props.Data = v.state.AllocDetail
+		info.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToAllocDetail(allocID, update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicAllocDetail, func() {
+		v.AllocInfo(allocID)
+	})
+
+	v.Layout.Container.SetFocus(info.TextView.Primitive())
+}
+
+func (v *View) InputAllocInfo(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	detail := v.components.AllocInfo.Props.Data
+	if detail == nil {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'p':
+		if detail.PreviousAllocation != "" {
+			v.AllocInfo(detail.PreviousAllocation)
+		}
+		return nil
+	case 'n':
+		if detail.NextAllocation != "" {
+			v.AllocInfo(detail.NextAllocation)
+		}
+		return nil
+	case 't':
+		if alloc, ok := v.getAllocation(detail.ID); ok {
+			v.Tasks(alloc)
+		}
+		return nil
+	}
+
+	return event
+}
@@ -19,7 +19,7 @@ func (v *View) Allocations(jobID string) {
 
 	v.Layout.Body.SetTitle(titleAllocations)
 
-	v.components.Commands.Update(component.AllocCommands)
+	v.components.Commands.Update(v.restrict(component.AllocCommands, component.AllocCapabilities))
 	v.Layout.Container.SetInputCapture(v.InputAllocations)
 
 	search := v.components.Search
@@ -27,7 +27,9 @@ func (v *View) Allocations(jobID string) {
 
 	update := func() {
 		table.Props.Data = v.filterAllocs(jobID)
+		table.Props.Stats = v.allocStats(table.Props.Data)
 		table.Render()
+		v.renderDetail()
 		v.Draw()
 	}
 
@@ -38,8 +40,22 @@ func (v *View) Allocations(jobID string) {
 	}
 
 	v.components.AllocationTable.Props.JobID = jobID
+	v.components.AllocationTable.Props.NodeName = ""
 
-	v.Watcher.Subscribe(update, api.TopicAllocation)
+	table.Props.HighlightAllocation = func(allocID string) {
+		v.renderDetail()
+	}
+
+	v.setLocation(bookmarkAllocations, jobID)
+	v.Watcher.Subscribe(update, api.TopicAllocation, models.TopicAllocStats)
+
+	// the usage of all allocations of the cluster is too much to poll
+	if jobID != "" {
+		v.Watcher.WatchAllocStats(func() []string {
+			return runningAllocIDs(table.Props.Data)
+		})
+	}
+	v.setDetail(v.allocationDetail)
 
 	update()
 
@@ -63,29 +79,53 @@ func (v *View) Allocations(jobID string) {
 func (v *View) filterAllocs(jobID string) []*models.Alloc {
 	data := v.filterAllocsForJob(jobID)
 	data = v.namespaceFilterAllocs(data)
-	filter := v.state.Filter.Allocations
-	if filter != "" {
-		rx, _ := regexp.Compile(filter)
-		result := []*models.Alloc{}
-		for _, alloc := range v.state.Allocations {
-			switch true {
-			case rx.MatchString(alloc.ID),
-				rx.MatchString(alloc.TaskGroup),
-				rx.MatchString(alloc.JobID),
-				rx.MatchString(alloc.DesiredStatus),
-				rx.MatchString(alloc.NodeID),
-				rx.MatchString(alloc.NodeName):
-				result = append(result, alloc)
-			}
+	query := v.parseFilter(v.state.Filter.Allocations, allocSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*models.Alloc{}
+	for _, alloc := range data {
+		if query.Match(allocFields(alloc)) {
+			result = append(result, alloc)
+		}
+	}
+
+	return result
+}
+
+// allocStats returns the recent resource usage of the allocations.
+func (v *View) allocStats(allocs []*models.Alloc) map[string]*models.AllocStats {
+	result := map[string]*models.AllocStats{}
+	for _, alloc := range allocs {
+		if stats := v.state.AllocStats.Get(alloc.ID); stats != nil {
+			result[alloc.ID] = stats
 		}
+	}
+
+	return result
+}
 
-		return result
+// runningAllocIDs returns the IDs of the running allocations,
+// as only those report their resource usage.
+func runningAllocIDs(allocs []*models.Alloc) []string {
+	ids := []string{}
+	for _, alloc := range allocs {
+		if alloc.Status == models.StatusRunning {
+			ids = append(ids, alloc.ID)
+		}
 	}
 
-	return data
+	return ids
 }
 
+// filterAllocsForJob returns the allocations of the
+// job, or the allocations of all jobs if jobID is empty.
 func (v *View) filterAllocsForJob(jobID string) []*models.Alloc {
+	if jobID == "" {
+		return v.state.Allocations
+	}
+
 	rx, _ := regexp.Compile(fmt.Sprintf("^%s$", jobID))
 	result := []*models.Alloc{}
 	for _, job := range v.state.Allocations {
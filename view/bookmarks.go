@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// The views which can be bookmarked.
+const (
+	bookmarkJobs        = "jobs"
+	bookmarkDeployments = "deployments"
+	bookmarkNamespaces  = "namespaces"
+	bookmarkAllocations = "allocations"
+	bookmarkTaskGroups  = "taskgroups"
+	bookmarkNodes       = "clients"
+	bookmarkNodePools   = "node pools"
+	bookmarkEvaluations = "evaluations"
+)
+
+// location identifies the current view, such that it can be
+// bookmarked. Views which can't be bookmarked leave it empty.
+type location struct {
+	view  string
+	jobID string
+}
+
+func (v *View) setLocation(view, jobID string) {
+	v.location = location{view: view, jobID: jobID}
+}
+
+// filterFor returns the filter of the view.
+func (v *View) filterFor(view string) *string {
+	switch view {
+	case bookmarkJobs:
+		return &v.state.Filter.Jobs
+	case bookmarkDeployments:
+		return &v.state.Filter.Deployments
+	case bookmarkNamespaces:
+		return &v.state.Filter.Namespaces
+	case bookmarkAllocations:
+		return &v.state.Filter.Allocations
+	case bookmarkTaskGroups:
+		return &v.state.Filter.TaskGroups
+	case bookmarkNodes:
+		return &v.state.Filter.Nodes
+	case bookmarkNodePools:
+		return &v.state.Filter.NodePools
+	case bookmarkEvaluations:
+		return &v.state.Filter.Evaluations
+	}
+
+	return nil
+}
+
+// BookmarkName opens the input field to save the current view,
+// namespace and filter as a bookmark.
+func (v *View) BookmarkName() {
+	if v.Config == nil {
+		v.handleError("bookmarks are not available without a config file")
+		return
+	}
+
+	if v.location.view == "" {
+		v.handleInfo("This view can't be bookmarked.")
+		return
+	}
+
+	name := v.components.BookmarkName
+	if v.state.Toggle.Bookmark {
+		v.Layout.Container.SetFocus(name.InputField.Primitive())
+		return
+	}
+
+	v.state.Toggle.Bookmark = true
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
+	name.Render()
+	v.Layout.Container.SetFocus(name.InputField.Primitive())
+}
+
+func (v *View) saveBookmark(key tcell.Key) {
+	input := v.components.BookmarkName.InputField
+	name := input.GetText()
+
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 0)
+	v.Layout.Footer.RemoveItem(input.Primitive())
+	v.Layout.Container.SetFocus(v.state.Elements.TableMain)
+	v.state.Toggle.Bookmark = false
+	input.SetText("")
+
+	if key != tcell.KeyEnter || name == "" {
+		return
+	}
+
+	bookmark := &models.Bookmark{
+		Name:      name,
+		View:      v.location.view,
+		JobID:     v.location.jobID,
+		Namespace: v.state.SelectedNamespace,
+		Filter:    *v.filterFor(v.location.view),
+	}
+
+	v.err(v.Config.SaveBookmark(bookmark), "Failed to save bookmark")
+}
+
+// Bookmarks opens the quick-pick modal with the saved bookmarks.
+func (v *View) Bookmarks() {
+	if v.Config == nil {
+		v.handleError("bookmarks are not available without a config file")
+		return
+	}
+
+	bookmarks := v.components.Bookmarks
+	bookmarks.Props.Data = v.Config.Bookmarks
+	bookmarks.Render()
+
+	v.Layout.Container.SetFocus(bookmarks.Modal.Primitive())
+}
+
+func (v *View) closeBookmarks() {
+	v.components.Bookmarks.Close()
+	v.Layout.Container.SetFocus(v.state.Elements.TableMain)
+}
+
+// OpenBookmark opens the view of the bookmark with
+// its namespace and filter.
+func (v *View) OpenBookmark(bookmark *models.Bookmark) {
+	filter := v.filterFor(bookmark.View)
+	if filter == nil {
+		v.handleError("bookmark %q has an unknown view: %s", bookmark.Name, bookmark.View)
+		return
+	}
+
+	*filter = bookmark.Filter
+
+	if bookmark.Namespace != "" {
+		v.state.SelectedNamespace = bookmark.Namespace
+
+		// the view sets the selected func when it opens
+		v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {})
+		index := getNamespaceNameIndex(bookmark.Namespace, v.state.Namespaces)
+		v.state.Elements.DropDownNamespace.SetCurrentOption(index)
+	}
+
+	switch bookmark.View {
+	case bookmarkJobs:
+		v.Jobs()
+	case bookmarkDeployments:
+		v.Deployments()
+	case bookmarkNamespaces:
+		v.Namespaces()
+	case bookmarkAllocations:
+		v.Allocations(bookmark.JobID)
+	case bookmarkTaskGroups:
+		v.TaskGroups(bookmark.JobID)
+	case bookmarkNodes:
+		v.Nodes()
+	case bookmarkNodePools:
+		v.NodePools()
+	case bookmarkEvaluations:
+		v.Evaluations()
+	}
+
+	v.components.Search.InputField.SetText(bookmark.Filter)
+}
+
+func (v *View) openBookmarkForKey(key int) {
+	if v.Config == nil {
+		return
+	}
+
+	if bookmark := v.Config.BookmarkForKey(key); bookmark != nil {
+		v.OpenBookmark(bookmark)
+	}
+}
+
+func (v *View) initBookmarks() {
+	name := v.components.BookmarkName
+	name.Bind(v.Layout.Footer)
+	name.Props.ChangedFunc = func(text string) {}
+	name.Props.DoneFunc = v.saveBookmark
+
+	bookmarks := v.components.Bookmarks
+	bookmarks.Bind(v.Layout.Pages)
+	bookmarks.Props.Close = v.closeBookmarks
+	bookmarks.Props.SelectBookmark = func(name string) {
+		v.closeBookmarks()
+		if bookmark := v.Config.Bookmark(name); bookmark != nil {
+			v.OpenBookmark(bookmark)
+		}
+	}
+
+	bookmarks.Props.DeleteBookmark = v.deleteBookmark
+
+	bookmarks.Props.BindBookmark = func(name string, key int) {
+		if err := v.Config.BindBookmark(name, key); err != nil {
+			v.closeBookmarks()
+			v.handleError("Failed to bind bookmark: %s", err.Error())
+			return
+		}
+
+		v.Bookmarks()
+	}
+}
+
+// deleteBookmark asks for confirmation and deletes the
+// bookmark. The quick-pick modal is shown again afterwards.
+func (v *View) deleteBookmark(name string) {
+	v.components.Confirm.Props.Done = func(index int, text string) {
+		v.closeConfirmModal()
+
+		if index == 1 {
+			if err := v.Config.DeleteBookmark(name); err != nil {
+				v.closeBookmarks()
+				v.handleError("Failed to delete bookmark: %s", err.Error())
+				return
+			}
+		}
+
+		v.Bookmarks()
+	}
+
+	v.components.Confirm.Render(fmt.Sprintf("Do you really want to delete the bookmark %s?", name))
+	v.Layout.Container.SetFocus(v.components.Confirm.Modal.Primitive())
+}
+
+// bookmarksOpen reports whether the quick-pick modal is shown.
+func (v *View) bookmarksOpen() bool {
+	return v.Layout.Pages.HasPage(component.PageNameBookmarks)
+}
@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// Overview shows the cluster overview, which summarises the servers,
+// clients, jobs, allocations, deployments and blocked evaluations.
+func (v *View) Overview() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleOverview)
+
+	v.Layout.Container.SetInputCapture(v.InputOverview)
+	v.components.Commands.Update(component.OverviewCommands)
+
+	overview := v.components.ClusterOverview
+
+	v.state.Elements.TableMain = overview.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		overview.Props.Cluster = v.namespaceFilterCluster()
+		overview.Props.Jobs = v.namespaceFilterJobs()
+		overview.Props.Allocations = v.namespaceFilterAllocs(v.state.Allocations)
+		overview.Props.Deployments = v.state.Deployments
+		overview.Props.Namespace = v.state.SelectedNamespace
+		overview.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToCluster(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.Overview()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicCluster, v.Overview)
+	v.Layout.Container.SetFocus(overview.Table.Primitive())
+}
+
+// namespaceFilterCluster returns a copy of the cluster
+// with the blocked evaluations of the selected namespace.
+func (v *View) namespaceFilterCluster() *models.Cluster {
+	if v.state.Cluster == nil {
+		return nil
+	}
+
+	cluster := *v.state.Cluster
+	cluster.BlockedEvals = v.namespaceFilterEvals(cluster.BlockedEvals)
+
+	return &cluster
+}
+
+// openTile opens the view with the resources of the tile.
+func (v *View) openTile(tile *component.OverviewTile) {
+	if tile.Status == component.OverviewUnavailable {
+		return
+	}
+
+	status := fmt.Sprintf("status=%s", tile.Status)
+
+	switch tile.Section {
+	case component.OverviewServers, component.OverviewRaft:
+		v.Servers()
+	case component.OverviewClients:
+		switch tile.Status {
+		case component.OverviewIneligible:
+			v.openFiltered(bookmarkNodes, "eligibility=ineligible")
+		case component.OverviewDraining:
+			v.openFiltered(bookmarkNodes, "drain=true")
+		default:
+			v.openFiltered(bookmarkNodes, status)
+		}
+	case component.OverviewJobs:
+		v.openFiltered(bookmarkJobs, status)
+	case component.OverviewJobTypes:
+		v.openFiltered(bookmarkJobs, fmt.Sprintf("type=%s", tile.Status))
+	case component.OverviewAllocations:
+		v.openFiltered(bookmarkAllocations, status)
+	case component.OverviewDeployments:
+		v.openFiltered(bookmarkDeployments, status)
+	case component.OverviewEvaluations:
+		v.openFiltered(bookmarkEvaluations, status)
+	}
+}
+
+// openFiltered opens a view the way a bookmark
+// does, with the filter set in the search field.
+func (v *View) openFiltered(view, filter string) {
+	v.OpenBookmark(&models.Bookmark{
+		Name:   view,
+		View:   view,
+		Filter: filter,
+	})
+}
+
+// Servers lists the server members with their Raft
+// and autopilot health, to watch the quorum.
+func (v *View) Servers() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleServers)
+
+	v.Layout.Container.SetInputCapture(v.InputServers)
+	v.components.Commands.Update(component.ServerCommands)
+
+	table := v.components.ServerTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.state.Cluster
+		table.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToServers(update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicServer, v.Servers)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+// Nodes lists the clients of the cluster.
+func (v *View) Nodes() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleNodes)
+
+	v.Layout.Container.SetInputCapture(v.InputNodes)
+	v.components.Commands.Update(component.NodeCommands)
+
+	search := v.components.Search
+	table := v.components.NodeTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterNodes()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Nodes = text
+		update()
+	}
+
+	v.setLocation(bookmarkNodes, "")
+	v.Watcher.SubscribeToNodes(update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicNode, v.Nodes)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterNodes() []*models.Node {
+	query := v.parseFilter(v.state.Filter.Nodes, nodeSchema)
+	if query == nil {
+		return v.state.Nodes
+	}
+
+	result := []*models.Node{}
+	for _, node := range v.state.Nodes {
+		if query.Match(nodeFields(node)) {
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
+
+// Evaluations lists the evaluations of the selected namespace.
+func (v *View) Evaluations() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleEvaluations)
+
+	v.Layout.Container.SetInputCapture(v.InputEvaluations)
+	v.components.Commands.Update(component.EvaluationCommands)
+
+	search := v.components.Search
+	table := v.components.EvaluationTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterEvaluations()
+		table.Props.Namespace = v.state.SelectedNamespace
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Evaluations = text
+		update()
+	}
+
+	v.setLocation(bookmarkEvaluations, "")
+	v.Watcher.SubscribeToEvaluations(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.Evaluations()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicEvaluation, v.Evaluations)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterEvaluations() []*models.Evaluation {
+	data := v.namespaceFilterEvals(v.state.Evaluations)
+	query := v.parseFilter(v.state.Filter.Evaluations, evaluationSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*models.Evaluation{}
+	for _, eval := range data {
+		if query.Match(evaluationFields(eval)) {
+			result = append(result, eval)
+		}
+	}
+
+	return result
+}
+
+func (v *View) namespaceFilterEvals(evals []*models.Evaluation) []*models.Evaluation {
+	rx, _ := regexp.Compile(v.state.SelectedNamespace)
+	result := []*models.Evaluation{}
+	for _, eval := range evals {
+		if rx.MatchString(eval.Namespace) {
+			result = append(result, eval)
+		}
+	}
+
+	return result
+}
@@ -4,8 +4,6 @@
 package view
 
 import (
-	"regexp"
-
 	"github.com/hashicorp/nomad/api"
 	"github.com/rivo/tview"
 
@@ -36,6 +34,7 @@ func (v *View) Deployments() {
 		update()
 	}
 
+	v.setLocation(bookmarkDeployments, "")
 	v.Watcher.Subscribe(update, api.TopicDeployment)
 
 	update()
@@ -50,23 +49,54 @@ func (v *View) Deployments() {
 }
 
 func (v *View) filterDeployments(data []*models.Deployment) []*models.Deployment {
-	filter := v.state.Filter.Deployments
-	if filter != "" {
-		rx, _ := regexp.Compile(filter)
-		result := []*models.Deployment{}
-		for _, dep := range v.state.Deployments {
-			switch true {
-			case rx.MatchString(dep.ID),
-				rx.MatchString(dep.JobID),
-				rx.MatchString(dep.Namespace),
-				rx.MatchString(dep.Status),
-				rx.MatchString(dep.StatusDescription):
-				result = append(result, dep)
-			}
+	query := v.parseFilter(v.state.Filter.Deployments, deploymentSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*models.Deployment{}
+	for _, dep := range data {
+		if query.Match(deploymentFields(dep)) {
+			result = append(result, dep)
 		}
+	}
+
+	return result
+}
+
+// DeploymentInfo shows a deployment with the
+// progress of each of its task groups.
+func (v *View) DeploymentInfo(namespace, deploymentID string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleDeployment)
+
+	v.Layout.Container.SetInputCapture(v.InputDeploymentInfo)
+	v.components.Commands.Update(component.DeploymentInfoCommands)
+
+	info := v.components.DeploymentInfo
 
-		return result
+	update := func() {
+		info.Props.Data = v.state.DeploymentDetail
+		info.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToDeploymentDetail(namespace, deploymentID, update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicDeploymentDetail, func() {
+		v.DeploymentInfo(namespace, deploymentID)
+	})
+	v.Layout.Container.SetFocus(info.TextView.Primitive())
+}
+
+// deploymentNamespace returns the namespace of the deployment,
+// or the selected namespace if the deployment isn't known.
+func (v *View) deploymentNamespace(deploymentID string) string {
+	for _, dep := range v.state.Deployments {
+		if dep.ID == deploymentID {
+			return dep.Namespace
+		}
 	}
 
-	return data
+	return v.state.SelectedNamespace
 }
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/rivo/tview"
+)
+
+// setDetail registers the function that renders the detail panel for
+// the currently selected row of the main table. Views without a
+// detail pass nil, which hides the panel.
+func (v *View) setDetail(detail func()) {
+	v.detail = detail
+	v.stopFollowing()
+
+	v.Layout.Detail.Clear()
+	v.Layout.ActivateDetail(detail != nil)
+
+	v.renderDetail()
+}
+
+// renderDetail renders the detail panel if it is visible.
+func (v *View) renderDetail() {
+	if v.detail == nil || !v.Layout.DetailVisible() {
+		return
+	}
+
+	v.detail()
+}
+
+// ToggleSplit cycles through the split modes and (re)renders
+// or stops the detail panel accordingly.
+func (v *View) ToggleSplit() {
+	v.Layout.CycleSplit()
+
+	if !v.Layout.DetailVisible() {
+		v.stopFollowing()
+		v.Layout.Detail.Clear()
+		return
+	}
+
+	v.renderDetail()
+}
+
+// ResizeSplit grows (positive delta) or shrinks (negative delta)
+// the detail panel.
+func (v *View) ResizeSplit(delta int) {
+	v.Layout.ResizeSplit(-delta)
+}
+
+func (v *View) stopFollowing() {
+	v.followedJobID = ""
+	v.Watcher.StopFollowing()
+}
+
+func (v *View) handleNoDetail(text string, args ...interface{}) {
+	msg := fmt.Sprintf(text, args...)
+	info := tview.
+		NewTextView().
+		SetDynamicColors(true).
+		SetText(msg).
+		SetTextAlign(tview.AlignCenter)
+	info.SetBorder(true)
+
+	v.Layout.Detail.AddItem(info, 0, 1, false)
+}
+
+// jobDetail follows the status of the selected job.
+func (v *View) jobDetail() {
+	jobID := v.components.JobTable.GetIDForSelection()
+	if jobID == v.followedJobID {
+		return
+	}
+
+	if jobID == "" {
+		v.stopFollowing()
+		v.Layout.Detail.Clear()
+		return
+	}
+
+	v.followedJobID = jobID
+	v.Watcher.FollowJobStatus(jobID, func() {
+		detail := v.components.JobStatusDetail
+		detail.Props.Data = v.state.JobStatus
+		detail.Render()
+		v.Draw()
+	})
+}
+
+// allocationDetail renders the tasks of the selected allocation.
+func (v *View) allocationDetail() {
+	detail := v.components.TaskDetail
+	allocID := v.components.AllocationTable.GetIDForSelection()
+
+	alloc, ok := v.getAllocation(allocID)
+	if !ok {
+		v.Layout.Detail.Clear()
+		return
+	}
+
+	detail.Props.AllocationID = alloc.ID
+	detail.Props.Data = alloc.TaskList
+	detail.Props.Stats = v.state.AllocStats.Get(alloc.ID)
+	detail.Render()
+}
+
+// taskDetail renders the events of the selected task, latest first.
+func (v *View) taskDetail() {
+	detail := v.components.TaskEventsDetail
+	allocID := v.components.TaskTable.Props.AllocationID
+	taskName := v.components.TaskTable.GetNameForSelection()
+
+	alloc, ok := v.getAllocation(allocID)
+	if !ok {
+		v.Layout.Detail.Clear()
+		return
+	}
+
+	task := getTaskFromAlloc(alloc, taskName)
+	if task == nil {
+		v.Layout.Detail.Clear()
+		return
+	}
+
+	events := make([]*api.TaskEvent, len(task.Events))
+	copy(events, task.Events)
+	reverseEvents(events)
+
+	detail.Props.AllocID = fmt.Sprintf("%s/%s", alloc.ID, task.Name)
+	detail.Props.Data = events
+	detail.Render()
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// defaultEditor is used when neither VISUAL nor EDITOR is set.
+const defaultEditor = "vi"
+
+// edit opens the content in the editor of the user and returns the
+// edited content. The terminal UI is suspended while the editor runs.
+// The pattern names the temporary file, its extension lets editors
+// pick the syntax highlighting.
+func (v *View) edit(pattern string, content []byte) ([]byte, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(editorCommand(), f.Name())
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	v.Layout.Container.Suspend(func() {
+		err = cmd.Run()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(f.Name())
+}
+
+// editorCommand returns the editor of the user with its arguments,
+// e.g. "code --wait".
+func editorCommand() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if args := strings.Fields(os.Getenv(env)); len(args) > 0 {
+			return args
+		}
+	}
+
+	return []string{defaultEditor}
+}
@@ -0,0 +1,415 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/filter"
+	"github.com/hcjulz/damon/models"
+)
+
+// The schemas define the fields which can be used
+// in the search field of the table views.
+var (
+	jobSchema = filter.Schema{
+		"id":        filter.String,
+		"name":      filter.String,
+		"namespace": filter.String,
+		"type":      filter.String,
+		"status":    filter.String,
+		"running":   filter.Number,
+		"total":     filter.Number,
+		"age":       filter.Time,
+	}
+
+	allocSchema = filter.Schema{
+		"id":        filter.String,
+		"name":      filter.String,
+		"group":     filter.String,
+		"job":       filter.String,
+		"namespace": filter.String,
+		"node":      filter.String,
+		"status":    filter.String,
+		"desired":   filter.String,
+		"version":   filter.Number,
+		"age":       filter.Time,
+	}
+
+	deploymentSchema = filter.Schema{
+		"id":          filter.String,
+		"job":         filter.String,
+		"namespace":   filter.String,
+		"status":      filter.String,
+		"description": filter.String,
+	}
+
+	namespaceSchema = filter.Schema{
+		"name":        filter.String,
+		"description": filter.String,
+	}
+
+	taskGroupSchema = filter.Schema{
+		"name":     filter.String,
+		"job":      filter.String,
+		"queued":   filter.Number,
+		"complete": filter.Number,
+		"failed":   filter.Number,
+		"running":  filter.Number,
+		"starting": filter.Number,
+		"lost":     filter.Number,
+	}
+
+	taskSchema = filter.Schema{
+		"name":   filter.String,
+		"driver": filter.String,
+		"state":  filter.String,
+		"image":  filter.String,
+		"cpu":    filter.Number,
+		"memory": filter.Number,
+		"disk":   filter.Number,
+	}
+
+	taskEventSchema = filter.Schema{
+		"type":    filter.String,
+		"message": filter.String,
+		"age":     filter.Time,
+	}
+
+	fileSchema = filter.Schema{
+		"name": filter.String,
+		"size": filter.Number,
+		"mode": filter.String,
+		"age":  filter.Time,
+	}
+
+	serviceSchema = filter.Schema{
+		"name":      filter.String,
+		"namespace": filter.String,
+		"tags":      filter.String,
+	}
+
+	serviceInstanceSchema = filter.Schema{
+		"alloc":   filter.String,
+		"address": filter.String,
+		"port":    filter.Number,
+		"node":    filter.String,
+		"dc":      filter.String,
+		"job":     filter.String,
+		"tags":    filter.String,
+		"health":  filter.String,
+	}
+
+	volumeSchema = filter.Schema{
+		"id":          filter.String,
+		"namespace":   filter.String,
+		"plugin":      filter.String,
+		"access":      filter.String,
+		"attachment":  filter.String,
+		"schedulable": filter.String,
+		"readers":     filter.Number,
+		"writers":     filter.Number,
+	}
+
+	claimSchema = filter.Schema{
+		"alloc":   filter.String,
+		"name":    filter.String,
+		"mode":    filter.String,
+		"job":     filter.String,
+		"node":    filter.String,
+		"status":  filter.String,
+		"desired": filter.String,
+	}
+
+	pluginSchema = filter.Schema{
+		"id":       filter.String,
+		"provider": filter.String,
+	}
+
+	aclPolicySchema = filter.Schema{
+		"name":        filter.String,
+		"description": filter.String,
+	}
+
+	aclRoleSchema = filter.Schema{
+		"name":        filter.String,
+		"description": filter.String,
+		"policy":      filter.String,
+	}
+
+	aclTokenSchema = filter.Schema{
+		"name":   filter.String,
+		"type":   filter.String,
+		"global": filter.String,
+		"policy": filter.String,
+		"role":   filter.String,
+		"age":    filter.Time,
+	}
+
+	nodeSchema = filter.Schema{
+		"id":          filter.String,
+		"name":        filter.String,
+		"address":     filter.String,
+		"dc":          filter.String,
+		"pool":        filter.String,
+		"class":       filter.String,
+		"version":     filter.String,
+		"status":      filter.String,
+		"eligibility": filter.String,
+		"drain":       filter.String,
+	}
+
+	nodePoolSchema = filter.Schema{
+		"name":        filter.String,
+		"description": filter.String,
+		"algorithm":   filter.String,
+	}
+
+	evaluationSchema = filter.Schema{
+		"id":          filter.String,
+		"job":         filter.String,
+		"namespace":   filter.String,
+		"type":        filter.String,
+		"priority":    filter.Number,
+		"trigger":     filter.String,
+		"status":      filter.String,
+		"description": filter.String,
+		"age":         filter.Time,
+	}
+
+	variableSchema = filter.Schema{
+		"path":      filter.String,
+		"namespace": filter.String,
+		"index":     filter.Number,
+		"age":       filter.Time,
+	}
+)
+
+// parseFilter parses the query entered in the search field.
+// Invalid queries are reported inline in the search field
+// and don't filter any rows.
+func (v *View) parseFilter(query string, schema filter.Schema) *filter.Query {
+	q, err := filter.Parse(query, schema)
+	v.components.Search.SetError(err)
+
+	return q
+}
+
+func jobFields(job *models.Job) filter.Fields {
+	return filter.Fields{
+		"id":        job.ID,
+		"name":      job.Name,
+		"namespace": job.Namespace,
+		"type":      job.Type,
+		"status":    job.Status,
+		"running":   job.StatusSummary.Running,
+		"total":     job.StatusSummary.Total,
+		"age":       job.SubmitTime,
+	}
+}
+
+func allocFields(alloc *models.Alloc) filter.Fields {
+	return filter.Fields{
+		"id":        alloc.ID,
+		"name":      alloc.Name,
+		"group":     alloc.TaskGroup,
+		"job":       alloc.JobID,
+		"namespace": alloc.Namespace,
+		"node":      alloc.NodeName,
+		"status":    alloc.Status,
+		"desired":   alloc.DesiredStatus,
+		"version":   alloc.Version,
+		"age":       alloc.Created,
+	}
+}
+
+func deploymentFields(dep *models.Deployment) filter.Fields {
+	return filter.Fields{
+		"id":          dep.ID,
+		"job":         dep.JobID,
+		"namespace":   dep.Namespace,
+		"status":      dep.Status,
+		"description": dep.StatusDescription,
+	}
+}
+
+func namespaceFields(ns *models.Namespace) filter.Fields {
+	return filter.Fields{
+		"name":        ns.Name,
+		"description": ns.Description,
+	}
+}
+
+func taskGroupFields(tg *models.TaskGroup) filter.Fields {
+	return filter.Fields{
+		"name":     tg.Name,
+		"job":      tg.JobID,
+		"queued":   tg.Queued,
+		"complete": tg.Complete,
+		"failed":   tg.Failed,
+		"running":  tg.Running,
+		"starting": tg.Starting,
+		"lost":     tg.Lost,
+	}
+}
+
+func taskFields(task *models.Task) filter.Fields {
+	return filter.Fields{
+		"name":   task.Name,
+		"driver": task.Driver,
+		"state":  task.State,
+		"image":  task.Image,
+		"cpu":    task.CPU,
+		"memory": task.MemoryMB,
+		"disk":   task.DiskMB,
+	}
+}
+
+func taskEventFields(event *api.TaskEvent) filter.Fields {
+	return filter.Fields{
+		"type":    event.Type,
+		"message": event.DisplayMessage,
+		"age":     time.Unix(0, event.Time),
+	}
+}
+
+func fileFields(file *models.AllocFile) filter.Fields {
+	return filter.Fields{
+		"name": file.Name,
+		"size": file.Size,
+		"mode": file.Mode,
+		"age":  file.Modified,
+	}
+}
+
+func serviceFields(service *models.Service) filter.Fields {
+	return filter.Fields{
+		"name":      service.Name,
+		"namespace": service.Namespace,
+		"tags":      strings.Join(service.Tags, ","),
+	}
+}
+
+func serviceInstanceFields(instance *models.ServiceInstance) filter.Fields {
+	health, _ := component.ServiceHealth(instance)
+	return filter.Fields{
+		"alloc":   instance.AllocID,
+		"address": instance.Address,
+		"port":    instance.Port,
+		"node":    instance.NodeID,
+		"dc":      instance.Datacenter,
+		"job":     instance.JobID,
+		"tags":    strings.Join(instance.Tags, ","),
+		"health":  health,
+	}
+}
+
+func volumeFields(volume *models.Volume) filter.Fields {
+	return filter.Fields{
+		"id":          volume.ID,
+		"namespace":   volume.Namespace,
+		"plugin":      volume.PluginID,
+		"access":      volume.AccessMode,
+		"attachment":  volume.AttachmentMode,
+		"schedulable": fmt.Sprint(volume.Schedulable),
+		"readers":     volume.Readers,
+		"writers":     volume.Writers,
+	}
+}
+
+func claimFields(claim *models.VolumeClaim) filter.Fields {
+	return filter.Fields{
+		"alloc":   claim.AllocID,
+		"name":    claim.AllocName,
+		"mode":    claim.Mode,
+		"job":     claim.JobID,
+		"node":    claim.NodeName,
+		"status":  claim.ClientStatus,
+		"desired": claim.DesiredStatus,
+	}
+}
+
+func pluginFields(plugin *models.CSIPlugin) filter.Fields {
+	return filter.Fields{
+		"id":       plugin.ID,
+		"provider": plugin.Provider,
+	}
+}
+
+func variableFields(v *models.Variable) filter.Fields {
+	return filter.Fields{
+		"path":      v.Path,
+		"namespace": v.Namespace,
+		"index":     v.ModifyIndex,
+		"age":       v.Modified,
+	}
+}
+
+func aclPolicyFields(policy *models.ACLPolicy) filter.Fields {
+	return filter.Fields{
+		"name":        policy.Name,
+		"description": policy.Description,
+	}
+}
+
+func aclRoleFields(role *models.ACLRole) filter.Fields {
+	return filter.Fields{
+		"name":        role.Name,
+		"description": role.Description,
+		"policy":      strings.Join(role.Policies, ","),
+	}
+}
+
+func aclTokenFields(token *models.ACLToken) filter.Fields {
+	return filter.Fields{
+		"name":   token.Name,
+		"type":   token.Type,
+		"global": fmt.Sprint(token.Global),
+		"policy": strings.Join(token.Policies, ","),
+		"role":   strings.Join(token.Roles, ","),
+		"age":    token.Created,
+	}
+}
+
+func nodePoolFields(pool *models.NodePool) filter.Fields {
+	return filter.Fields{
+		"name":        pool.Name,
+		"description": pool.Description,
+		"algorithm":   pool.SchedulerAlgorithm,
+	}
+}
+
+func nodeFields(node *models.Node) filter.Fields {
+	return filter.Fields{
+		"id":          node.ID,
+		"name":        node.Name,
+		"address":     node.Address,
+		"dc":          node.Datacenter,
+		"pool":        node.NodePool,
+		"class":       node.NodeClass,
+		"version":     node.Version,
+		"status":      node.Status,
+		"eligibility": node.Eligibility,
+		"drain":       fmt.Sprint(node.Drain),
+	}
+}
+
+func evaluationFields(eval *models.Evaluation) filter.Fields {
+	return filter.Fields{
+		"id":          eval.ID,
+		"job":         eval.JobID,
+		"namespace":   eval.Namespace,
+		"type":        eval.Type,
+		"priority":    eval.Priority,
+		"trigger":     eval.TriggeredBy,
+		"status":      eval.Status,
+		"description": eval.StatusDescription,
+		"age":         eval.Created,
+	}
+}
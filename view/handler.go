@@ -23,8 +23,7 @@ func (v *View) handleNoResources(text string, args ...interface{}) {
 
 func (v *View) err(err error, msg string) {
 	if err != nil {
-		fmt.Sprintf("%s: %s", msg, err.Error())
-		v.handleError(msg)
+		v.handleError("%s: %s", msg, err.Error())
 	}
 }
 
@@ -34,6 +33,14 @@ func (v *View) handleError(format string, args ...interface{}) {
 	v.Layout.Container.SetFocus(v.components.Failure.Modal.Primitive())
 }
 
+// handleDenied explains an action the ACL token isn't allowed to
+// perform. Unlike failures it stays on the current view.
+func (v *View) handleDenied(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	v.components.Denied.Render(msg)
+	v.Layout.Container.SetFocus(v.components.Denied.Modal.Primitive())
+}
+
 func (v *View) handleInfo(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
 	v.components.Info.Render(msg)
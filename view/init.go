@@ -16,13 +16,16 @@ import (
 func (v *View) Init(version string) {
 	// ClusterInfo
 	v.components.ClusterInfo.Props.Info = fmt.Sprintf(
-		"%sAddress%s: %s\n%sVersion:%s %s",
+		"%sAddress%s: %s\n%sVersion:%s %s\n%sToken:%s %s",
 		styles.HighlightSecondaryTag,
 		styles.StandardColorTag,
 		v.state.NomadAddress,
 		styles.HighlightSecondaryTag,
 		styles.StandardColorTag,
 		version,
+		styles.HighlightSecondaryTag,
+		styles.StandardColorTag,
+		tokenInfo(v.state.Token),
 	)
 
 	v.components.ClusterInfo.Bind(v.Layout.Elements.ClusterInfo)
@@ -50,6 +53,7 @@ func (v *View) Init(version string) {
 	v.components.LogSearch.Props.ChangedFunc = func(text string) {
 		v.state.Filter.Logs = text
 		v.components.LogStream.Props.Filter = text
+		v.components.LogSearch.SetError(component.ValidateLogFilter(text))
 	}
 
 	v.components.LogSearch.Props.DoneFunc = func(key tcell.Key) {
@@ -78,6 +82,25 @@ func (v *View) Init(version string) {
 		v.Draw()
 	}
 
+	// LogFindField
+	v.components.LogFind.Bind(v.Layout.Footer)
+	v.components.LogFind.Props.ChangedFunc = func(text string) {
+		v.components.LogStream.Props.Search = text
+	}
+
+	v.components.LogFind.Props.DoneFunc = func(key tcell.Key) {
+		v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 0)
+		v.Layout.Footer.RemoveItem(v.components.LogFind.InputField.Primitive())
+		v.Layout.Container.SetFocus(v.components.LogStream.TextView.Primitive())
+		v.state.Toggle.LogFind = false
+
+		v.components.LogStream.Render()
+		v.Draw()
+	}
+
+	// LogSave and LogStart fields
+	v.initLogFiles()
+
 	// SearchField
 	v.components.Search.Bind(v.Layout.Footer)
 	v.components.Search.Props.DoneFunc = func(key tcell.Key) {
@@ -88,18 +111,33 @@ func (v *View) Init(version string) {
 		v.state.Toggle.Search = false
 	}
 
+	// Bookmarks
+	v.initBookmarks()
+
 	// JobTable
 	v.components.JobTable.Bind(v.Layout.Body)
 	v.components.JobTable.Props.HandleNoResources = v.handleNoResources
 
 	// JobStatus
 	v.components.JobStatus.Bind(v.Layout.Body)
+	v.initScale()
+
+	// JobVersionTable
+	v.components.JobVersionTable.Bind(v.Layout.Body)
+	v.components.JobVersionTable.Props.HandleNoResources = v.handleNoResources
+
+	// JobSpec
+	v.components.JobSpec.Bind(v.Layout.Body)
+	v.initSpecFind()
 
 	// DeploymentTable
 	v.components.DeploymentTable.Bind(v.Layout.Body)
-	v.components.DeploymentTable.Props.SelectDeployment = func(jobID string) {
-		//TODO
+	v.components.DeploymentTable.Props.SelectDeployment = func(deploymentID string) {
+		v.DeploymentInfo(v.deploymentNamespace(deploymentID), deploymentID)
 	}
+
+	// DeploymentInfo
+	v.components.DeploymentInfo.Bind(v.Layout.Body)
 	v.components.DeploymentTable.Props.HandleNoResources = v.handleNoResources
 
 	// NamespaceTable
@@ -151,9 +189,134 @@ func (v *View) Init(version string) {
 	// TaskEventsTable
 	v.components.TaskEventsTable.Bind(v.Layout.Body)
 
+	// TaskInfo
+	v.components.TaskInfo.Bind(v.Layout.Body)
+
+	// AllocInfo
+	v.components.AllocInfo.Bind(v.Layout.Body)
+
+	// AllocFSTable
+	v.components.AllocFSTable.Bind(v.Layout.Body)
+	v.components.AllocFSTable.Props.HandleNoResources = v.handleNoResources
+	v.components.AllocFSTable.Props.SelectFile = func(file *models.AllocFile) {
+		allocID := v.components.AllocFSTable.Props.AllocID
+		if file.IsDir {
+			v.AllocFS(allocID, file.Path)
+			return
+		}
+
+		v.File(allocID, file.Path)
+	}
+
+	// VariableTable
+	v.components.VariableTable.Bind(v.Layout.Body)
+	v.components.VariableTable.Props.HandleNoResources = v.handleNoResources
+	v.components.VariableTable.Props.SelectEntry = func(entry *component.VariableEntry) {
+		if entry.IsDir {
+			v.Variables(entry.Path)
+			return
+		}
+
+		v.Variable(entry.Namespace, entry.Path)
+	}
+
+	// VariableItems
+	v.components.VariableItems.Bind(v.Layout.Body)
+	v.components.VariableItems.Props.HandleNoResources = v.handleNoResources
+
+	// ServiceTable
+	v.components.ServiceTable.Bind(v.Layout.Body)
+	v.components.ServiceTable.Props.HandleNoResources = v.handleNoResources
+	v.components.ServiceTable.Props.SelectService = func(namespace, name string) {
+		v.ServiceInstances(namespace, name)
+	}
+
+	// ServiceInstanceTable
+	v.components.ServiceInstanceTable.Bind(v.Layout.Body)
+	v.components.ServiceInstanceTable.Props.HandleNoResources = v.handleNoResources
+	v.components.ServiceInstanceTable.Props.SelectInstance = v.serviceAllocation
+
+	// VolumeTable
+	v.components.VolumeTable.Bind(v.Layout.Body)
+	v.components.VolumeTable.Props.HandleNoResources = v.handleNoResources
+	v.components.VolumeTable.Props.SelectVolume = func(namespace, id string) {
+		v.VolumeClaims(namespace, id)
+	}
+
+	// VolumeClaimTable
+	v.components.VolumeClaimTable.Bind(v.Layout.Body)
+	v.components.VolumeClaimTable.Props.HandleNoResources = v.handleNoResources
+	v.components.VolumeClaimTable.Props.SelectClaim = func(claim *models.VolumeClaim) {
+		v.showAllocation(claim.JobID, claim.AllocID)
+	}
+
+	// PluginTable
+	v.components.PluginTable.Bind(v.Layout.Body)
+	v.components.PluginTable.Props.HandleNoResources = v.handleNoResources
+
+	// ACLPolicyTable
+	v.components.ACLPolicyTable.Bind(v.Layout.Body)
+	v.components.ACLPolicyTable.Props.HandleNoResources = v.handleNoResources
+	v.components.ACLPolicyTable.Props.SelectPolicy = func(name string) {
+		v.ACLPolicy(name)
+	}
+
+	// ACLPolicyRules
+	v.components.ACLPolicyRules.Bind(v.Layout.Body)
+
+	// ACLRoleTable
+	v.components.ACLRoleTable.Bind(v.Layout.Body)
+	v.components.ACLRoleTable.Props.HandleNoResources = v.handleNoResources
+
+	// ACLTokenTable
+	v.components.ACLTokenTable.Bind(v.Layout.Body)
+	v.components.ACLTokenTable.Props.HandleNoResources = v.handleNoResources
+
+	// ClusterOverview
+	v.components.ClusterOverview.Bind(v.Layout.Body)
+	v.components.ClusterOverview.Props.SelectTile = v.openTile
+
+	// ServerTable
+	v.components.ServerTable.Bind(v.Layout.Body)
+	v.components.ServerTable.Props.HandleNoResources = v.handleNoResources
+
+	// NodeTable
+	v.components.NodeTable.Bind(v.Layout.Body)
+	v.components.NodeTable.Props.HandleNoResources = v.handleNoResources
+	v.components.NodeTable.Props.SelectNode = v.Node
+
+	// NodeInfo
+	v.components.NodeInfo.Bind(v.Layout.Body)
+
+	// NodePoolTable
+	v.components.NodePoolTable.Bind(v.Layout.Body)
+	v.components.NodePoolTable.Props.HandleNoResources = v.handleNoResources
+	v.components.NodePoolTable.Props.SelectPool = v.openNodePool
+
+	// EvaluationTable
+	v.components.EvaluationTable.Bind(v.Layout.Body)
+	v.components.EvaluationTable.Props.HandleNoResources = v.handleNoResources
+
+	// Detail panel
+	v.components.JobStatusDetail.Bind(v.Layout.Detail)
+
+	v.components.TaskDetail.Bind(v.Layout.Detail)
+	v.components.TaskDetail.Props.HandleNoResources = v.handleNoDetail
+	v.components.TaskDetail.Props.SelectTask = func(taskName, allocID string) {
+		v.components.LogSearch.InputField.SetText("")
+		v.Logs(taskName, allocID, "stdout")
+	}
+
+	v.components.TaskEventsDetail.Bind(v.Layout.Detail)
+	v.components.TaskEventsDetail.Props.HandleNoResources = v.handleNoDetail
+
+	v.components.ServiceCheckDetail.Bind(v.Layout.Detail)
+	v.components.ServiceCheckDetail.Props.HandleNoResources = v.handleNoDetail
+
 	// Logs
 	v.components.LogStream.Bind(v.Layout.Body)
 	v.components.LogStream.Props.HandleNoResources = v.handleNoResources
+	v.components.LogStream.Props.Buffer = v.state.LogBuffer
 	v.components.LogStream.Props.App = v.Layout.Container
 
 	// Logo
@@ -198,6 +361,13 @@ func (v *View) Init(version string) {
 		v.GoBack()
 	}
 
+	// Denied
+	v.components.Denied.Bind(v.Layout.Pages)
+	v.components.Denied.Props.Done = func(buttonIndex int, buttonLabel string) {
+		v.Layout.Pages.RemovePage(component.PageNameInfo)
+		v.Layout.Container.SetFocus(v.state.Elements.TableMain)
+	}
+
 	v.components.Confirm.Bind(v.Layout.Pages)
 	selectorModal := v.components.SelectorModal
 	selectorModal.Bind(v.Layout.Pages)
@@ -205,6 +375,35 @@ func (v *View) Init(version string) {
 		selectorModal.Close()
 	})
 
+	// Draw the tables again once the highlighting of changed rows expired
+	for _, table := range []component.Table{
+		v.components.JobTable.Table,
+		v.components.DeploymentTable.Table,
+		v.components.NamespaceTable.Table,
+		v.components.AllocationTable.Table,
+		v.components.TaskGroupTable.Table,
+		v.components.TaskTable.Table,
+		v.components.TaskEventsTable.Table,
+		v.components.AllocFSTable.Table,
+		v.components.VariableTable.Table,
+		v.components.VariableItems.Table,
+		v.components.ServiceTable.Table,
+		v.components.ServiceInstanceTable.Table,
+		v.components.VolumeTable.Table,
+		v.components.VolumeClaimTable.Table,
+		v.components.PluginTable.Table,
+		v.components.ACLPolicyTable.Table,
+		v.components.ACLRoleTable.Table,
+		v.components.ACLTokenTable.Table,
+		v.components.ClusterOverview.Table,
+		v.components.ServerTable.Table,
+		v.components.NodeTable.Table,
+		v.components.NodePoolTable.Table,
+		v.components.EvaluationTable.Table,
+	} {
+		table.SetRedrawFunc(v.Draw)
+	}
+
 	v.Watcher.SubscribeHandler(models.HandleError, v.handleError)
 	v.Watcher.SubscribeHandler(models.HandleFatal, v.handleFatal)
 
@@ -212,6 +411,14 @@ func (v *View) Init(version string) {
 
 	go v.DrawLoop(stop)
 
-	// Set initial view to jobs
-	v.Jobs()
+	if v.StartView == StartViewOverview {
+		v.Overview()
+	} else {
+		// Set initial view to jobs
+		v.Jobs()
+	}
+
+	if v.ConfigErr != nil {
+		v.handleError("Failed to load config, bookmarks won't be saved: %s", v.ConfigErr.Error())
+	}
 }
@@ -5,6 +5,9 @@ package view
 
 import (
 	"github.com/gdamore/tcell/v2"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
 )
 
 func (v *View) InputJobs(event *tcell.EventKey) *tcell.EventKey {
@@ -12,28 +15,121 @@ func (v *View) InputJobs(event *tcell.EventKey) *tcell.EventKey {
 	return v.inputJobs(event)
 }
 
+func (v *View) InputJobStatus(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	return v.inputJobStatus(event)
+}
+
 func (v *View) InputDeployments(event *tcell.EventKey) *tcell.EventKey {
 	return v.InputMainCommands(event)
 }
 
+func (v *View) InputDeploymentInfo(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'i' && v.state.DeploymentDetail != nil {
+		v.JobStatus(v.state.DeploymentDetail.JobID)
+		return nil
+	}
+
+	return event
+}
+
 func (v *View) InputNamespaces(event *tcell.EventKey) *tcell.EventKey {
 	return v.InputMainCommands(event)
 }
 
-func (v *View) InputTaskGroups(event *tcell.EventKey) *tcell.EventKey {
+func (v *View) InputServices(event *tcell.EventKey) *tcell.EventKey {
+	return v.InputMainCommands(event)
+}
+
+func (v *View) InputServiceInstances(event *tcell.EventKey) *tcell.EventKey {
+	return v.InputMainCommands(event)
+}
+
+func (v *View) InputPlugins(event *tcell.EventKey) *tcell.EventKey {
+	return v.InputMainCommands(event)
+}
+
+func (v *View) InputOverview(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'r':
+		v.Servers()
+		return nil
+	case 'c':
+		v.Nodes()
+		return nil
+	case 'p':
+		v.NodePools()
+		return nil
+	case 'e':
+		v.Evaluations()
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) InputServers(event *tcell.EventKey) *tcell.EventKey {
+	return v.InputMainCommands(event)
+}
+
+func (v *View) InputNodes(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'p' {
+		v.NodePools()
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) InputEvaluations(event *tcell.EventKey) *tcell.EventKey {
 	return v.InputMainCommands(event)
 }
 
+func (v *View) InputTaskGroups(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	return v.inputTaskGroups(event)
+}
+
+func (v *View) InputTasks(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	return v.inputTasks(event)
+}
+
 func (v *View) InputAllocations(event *tcell.EventKey) *tcell.EventKey {
-	v.InputMainCommands(event)
+	event = v.InputMainCommands(event)
 	return v.inputAllocs(event)
 }
 
+func (v *View) InputAllocFS(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	return v.inputAllocFS(event)
+}
+
 func (v *View) InputMainCommands(event *tcell.EventKey) *tcell.EventKey {
 	if event == nil {
 		return event
 	}
 
+	// the bookmarks modal handles its keys itself
+	if v.bookmarksOpen() {
+		return event
+	}
+
 	switch event.Key() {
 	case tcell.KeyCtrlJ:
 		v.Jobs()
@@ -44,6 +140,21 @@ func (v *View) InputMainCommands(event *tcell.EventKey) *tcell.EventKey {
 	case tcell.KeyCtrlD:
 		v.Deployments()
 
+	case tcell.KeyCtrlV:
+		v.Variables("")
+
+	case tcell.KeyCtrlR:
+		v.Services()
+
+	case tcell.KeyCtrlT:
+		v.Volumes()
+
+	case tcell.KeyCtrlG:
+		v.ACLPolicies()
+
+	case tcell.KeyCtrlL:
+		v.Overview()
+
 	case tcell.KeyCtrlO, tcell.KeyEsc:
 		v.GoBack()
 
@@ -65,6 +176,51 @@ func (v *View) InputMainCommands(event *tcell.EventKey) *tcell.EventKey {
 			if !v.Layout.Footer.HasFocus() {
 				v.Layout.Container.SetFocus(v.state.Elements.DropDownNamespace)
 			}
+
+		case 'b':
+			if !v.Layout.Footer.HasFocus() {
+				v.BookmarkName()
+				return nil
+			}
+
+		case 'B':
+			if !v.Layout.Footer.HasFocus() {
+				v.Bookmarks()
+				return nil
+			}
+
+		case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			if !v.Layout.Footer.HasFocus() {
+				v.openBookmarkForKey(component.BookmarkKey(event))
+				return nil
+			}
+
+		case '/':
+			// only views with a filter set a ChangedFunc
+			if !v.Layout.Footer.HasFocus() && v.components.Search.Props.ChangedFunc != nil {
+				if !v.state.Toggle.Search {
+					v.state.Toggle.Search = true
+					v.Search()
+				} else {
+					v.Layout.Container.SetFocus(v.components.Search.InputField.Primitive())
+				}
+				return nil
+			}
+
+		case 'v':
+			if !v.Layout.Footer.HasFocus() {
+				v.ToggleSplit()
+			}
+
+		case '+':
+			if !v.Layout.Footer.HasFocus() {
+				v.ResizeSplit(1)
+			}
+
+		case '-':
+			if !v.Layout.Footer.HasFocus() {
+				v.ResizeSplit(-1)
+			}
 		}
 	}
 
@@ -72,9 +228,122 @@ func (v *View) InputMainCommands(event *tcell.EventKey) *tcell.EventKey {
 }
 
 func (v *View) inputAllocs(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'm':
+		allocID := v.components.AllocationTable.GetIDForSelection()
+		v.MergedLogs(models.LogScope{AllocID: allocID})
+		return nil
+	case 'M':
+		jobID := v.components.AllocationTable.Props.JobID
+		if jobID != "" {
+			v.MergedLogs(models.LogScope{JobID: jobID})
+		}
+		return nil
+	case 'f':
+		allocID := v.components.AllocationTable.GetIDForSelection()
+		v.AllocFS(allocID, "/")
+		return nil
+	case 'i':
+		allocID := v.components.AllocationTable.GetIDForSelection()
+		if allocID != "" {
+			v.AllocInfo(allocID)
+		}
+		return nil
+	case 'n':
+		allocID := v.components.AllocationTable.GetIDForSelection()
+		if alloc, ok := v.getAllocation(allocID); ok && alloc.NodeID != "" {
+			v.Node(alloc.NodeID)
+		}
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) inputTaskGroups(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'm' {
+		v.MergedLogs(models.LogScope{
+			JobID:     v.components.TaskGroupTable.Props.JobID,
+			TaskGroup: v.components.TaskGroupTable.GetNameForSelection(),
+		})
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) inputTasks(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'm':
+		allocID := v.components.TaskTable.Props.AllocationID
+		v.MergedLogs(models.LogScope{AllocID: allocID})
+		return nil
+	case 'f':
+		allocID := v.components.TaskTable.Props.AllocationID
+		taskName := v.components.TaskTable.GetNameForSelection()
+		v.AllocFS(allocID, "/"+taskName)
+		return nil
+	case 'i':
+		allocID := v.components.TaskTable.Props.AllocationID
+		taskName := v.components.TaskTable.GetNameForSelection()
+		v.TaskInfo(allocID, taskName)
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) inputAllocFS(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 't' {
+		table := v.components.AllocFSTable
+		if file := table.GetFileForSelection(); file != nil && !file.IsDir {
+			v.TailFile(table.Props.AllocID, file.Path)
+		}
+		return nil
+	}
+
 	return event
 }
 
+// InputFile handles the keys of the file view, which
+// shows a file page by page instead of streaming it.
+func (v *View) InputFile(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyRune && !v.Layout.Footer.HasFocus() {
+		switch event.Rune() {
+		case ']':
+			v.NextFilePage()
+			return nil
+		case '[':
+			v.PrevFilePage()
+			return nil
+		case 't':
+			v.TailFile(v.logTarget.allocID, v.logTarget.path)
+			return nil
+		case 's', 'r', 'o':
+			// there is no stream to stop, resume or restart
+			return nil
+		}
+	}
+
+	return v.InputLogs(event)
+}
+
 func (v *View) InputLogs(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Key() {
 	case tcell.KeyEsc, tcell.KeyCtrlO, tcell.KeyEnter:
@@ -114,6 +383,69 @@ func (v *View) InputLogs(event *tcell.EventKey) *tcell.EventKey {
 				v.Watcher.ResumeLogs()
 
 			}
+		case 'o':
+			if !v.Layout.Footer.HasFocus() {
+				v.LogStartInput()
+				return nil
+			}
+		case 'w':
+			if !v.Layout.Footer.HasFocus() {
+				v.SaveLogs(false)
+				return nil
+			}
+		case 'W':
+			if !v.Layout.Footer.HasFocus() {
+				v.SaveLogs(true)
+				return nil
+			}
+		case 'J':
+			if !v.Layout.Footer.HasFocus() {
+				props := v.components.LogStream.Props
+				props.Mode = props.Mode.Next()
+				v.components.LogStream.Render()
+				return nil
+			}
+		case 'f':
+			if !v.Layout.Footer.HasFocus() {
+				if !v.state.Toggle.LogFind {
+					v.state.Toggle.LogFind = true
+					v.LogFind()
+					return nil
+				}
+
+				v.Layout.Container.SetFocus(v.components.LogFind.InputField.Primitive())
+			}
+		case 'n':
+			if !v.Layout.Footer.HasFocus() {
+				v.components.LogStream.NextMatch()
+				return nil
+			}
+		case 'N':
+			if !v.Layout.Footer.HasFocus() {
+				v.components.LogStream.PrevMatch()
+				return nil
+			}
+		case 'i':
+			if !v.Layout.Footer.HasFocus() {
+				props := v.components.LogStream.Props
+				props.IgnoreCase = !props.IgnoreCase
+				v.components.LogStream.Render()
+				return nil
+			}
+		case 'F':
+			if !v.Layout.Footer.HasFocus() {
+				props := v.components.LogStream.Props
+				props.Literal = !props.Literal
+				v.components.LogStream.Render()
+				return nil
+			}
+		case 'C':
+			if !v.Layout.Footer.HasFocus() {
+				props := v.components.LogStream.Props
+				props.Context = nextLogContext(props.Context)
+				v.components.LogStream.Render()
+				return nil
+			}
 		}
 	}
 
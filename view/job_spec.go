@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// JobSpec shows the definition of a job, the source
+// it was submitted with or the job rendered as JSON.
+func (v *View) JobSpec(namespace, jobID string) {
+	v.viewSwitch()
+	v.Watcher.Unsubscribe()
+	v.Layout.Body.SetTitle(titleJobSpec)
+	v.Layout.Body.Clear()
+
+	v.Layout.Container.SetInputCapture(v.InputJobSpec)
+	v.components.Commands.Update(component.JobSpecCommands)
+
+	spec := v.components.JobSpec
+	spec.Props.Data = nil
+	spec.Props.ShowJSON = false
+	spec.Props.Search = ""
+	spec.Props.Folded = map[string]bool{}
+	v.components.SpecFind.InputField.SetText("")
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicJobSpec, func() {
+		v.JobSpec(namespace, jobID)
+	})
+
+	job, err := v.Client.GetJob(namespace, jobID)
+	if err != nil {
+		err = v.explain(err, namespace, models.CapabilityReadJob)
+		v.handleError("Failed to read job %s: %s", jobID, err.Error())
+		return
+	}
+
+	data, err := v.Client.JobSpec(job)
+	if err != nil {
+		v.handleError("Failed to show job %s: %s", jobID, err.Error())
+		return
+	}
+
+	spec.Props.Data = data
+	spec.Render()
+
+	v.Layout.Container.SetFocus(spec.TextView.Primitive())
+}
+
+// InputJobSpec handles the keys of the job spec: the search,
+// folding the task groups and switching to JSON.
+func (v *View) InputJobSpec(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	spec := v.components.JobSpec
+	if spec.Props.Data == nil {
+		return event
+	}
+
+	switch event.Rune() {
+	case '/':
+		v.SpecFind()
+		return nil
+	case 'n':
+		spec.NextMatch()
+		return nil
+	case 'N':
+		spec.PrevMatch()
+		return nil
+	case 'z':
+		spec.ToggleFold()
+		return nil
+	case 'Z':
+		spec.ToggleFoldAll()
+		return nil
+	case 'J':
+		spec.Props.ShowJSON = !spec.Props.ShowJSON
+		spec.Render()
+		return nil
+	}
+
+	return event
+}
+
+// SpecFind opens the input field to search the job spec.
+func (v *View) SpecFind() {
+	find := v.components.SpecFind
+	if v.state.Toggle.SpecFind {
+		v.Layout.Container.SetFocus(find.InputField.Primitive())
+		return
+	}
+
+	v.state.Toggle.SpecFind = true
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
+	find.Render()
+	v.Layout.Container.SetFocus(find.InputField.Primitive())
+}
+
+func (v *View) initSpecFind() {
+	find := v.components.SpecFind
+	find.Bind(v.Layout.Footer)
+	find.Props.ChangedFunc = func(text string) {
+		v.components.JobSpec.Props.Search = text
+		v.components.JobSpec.Render()
+	}
+
+	find.Props.DoneFunc = func(key tcell.Key) {
+		v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 0)
+		v.Layout.Footer.RemoveItem(find.InputField.Primitive())
+		v.Layout.Container.SetFocus(v.components.JobSpec.TextView.Primitive())
+		v.state.Toggle.SpecFind = false
+
+		v.components.JobSpec.ScrollToMatch()
+	}
+}
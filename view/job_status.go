@@ -4,28 +4,221 @@
 package view
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
 	"github.com/hcjulz/damon/models"
 )
 
+// JobStatus shows the status of a job. Its summary, deployment and
+// allocations are tables which are focused with <Tab>.
 func (v *View) JobStatus(jobID string) {
+	v.viewSwitch()
 	v.Layout.Body.SetTitle(titleJobStatus)
 	v.Layout.Body.Clear()
 
-	v.Layout.Container.SetInputCapture(v.InputMainCommands)
-	v.Layout.Container.SetFocus(v.components.JobStatus.TextView.Primitive())
+	v.Layout.Container.SetInputCapture(v.InputJobStatus)
+	v.components.Commands.Update(v.restrict(component.JobStatusCommands, component.JobStatusCapabilities))
 
 	jobStatus := v.components.JobStatus
+	jobStatus.Props.Data = nil
+
+	jobStatus.Props.SelectAllocation = func(allocID string) {
+		alloc, ok := v.getAllocation(allocID)
+		if !ok {
+			return
+		}
+
+		v.Tasks(alloc)
+	}
+
+	jobStatus.Props.SelectDeployment = func(deploymentID string) {
+		v.DeploymentInfo(jobStatus.Props.Data.Namespace, deploymentID)
+	}
 
 	update := func() {
-		jobStatus.Props.Data = v.state.JobStatus
+		section := jobStatus.Section()
 
+		jobStatus.Props.Data = v.state.JobStatus
 		jobStatus.Render()
+
+		// the deployment may disappear with the job
+		if jobStatus.Section() != section {
+			v.focusJobStatusSection(jobStatus.Section())
+		}
+
 		v.Draw()
 	}
 
 	v.Watcher.SubscribeToJobStatus(jobID, update)
-	update()
+	v.focusJobStatusSection(jobStatus.ResetSection())
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicJobStatus, func() {
+		v.JobStatus(jobID)
+	})
+}
+
+// focusJobStatusSection focuses a table of the job status.
+func (v *View) focusJobStatusSection(section component.Table) {
+	v.state.Elements.TableMain = section.Primitive().(*tview.Table)
+	v.Layout.Container.SetFocus(section.Primitive())
+}
+
+func (v *View) inputJobStatus(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	jobStatus := v.components.JobStatus
+	data := jobStatus.Props.Data
+	if data == nil || data.ID == "" {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyTab:
+		v.focusJobStatusSection(jobStatus.NextSection(false))
+		return nil
+	case tcell.KeyBacktab:
+		v.focusJobStatusSection(jobStatus.NextSection(true))
+		return nil
+	case tcell.KeyCtrlS:
+		v.startStopJob(data.Namespace, data.ID)
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'V':
+			v.JobVersions(data.Namespace, data.ID)
+			return nil
+		case 'e':
+			v.openFiltered(bookmarkEvaluations, fmt.Sprintf("job=%s", data.ID))
+			return nil
+		case 'S':
+			v.ScaleInput()
+			return nil
+		}
+	}
+
+	return event
+}
+
+// JobVersions lists the versions of a job with
+// the changes of each version to the one before.
+func (v *View) JobVersions(namespace, jobID string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleJobVersions)
 
-	v.addToHistory(v.state.SelectedNamespace, models.TopicLog, update)
 	v.Layout.Container.SetInputCapture(v.InputMainCommands)
+	v.components.Commands.Update(component.JobVersionCommands)
+
+	table := v.components.JobVersionTable
+	table.Props.JobID = jobID
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.state.JobVersions
+		table.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToJobVersions(namespace, jobID, update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicJobVersion, func() {
+		v.JobVersions(namespace, jobID)
+	})
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+// ScaleInput opens the input field to set the count
+// of the selected task group of the job status.
+func (v *View) ScaleInput() {
+	data := v.components.JobStatus.Props.Data
+	if !v.permitted(data.Namespace, models.CapabilityScaleJob) {
+		return
+	}
+
+	group := v.components.JobStatus.GetTaskGroupForSelection()
+	if group == "" {
+		v.handleInfo("Select the TaskGroup to scale.")
+		return
+	}
+
+	scale := v.components.Scale
+	if v.state.Toggle.Scale {
+		v.Layout.Container.SetFocus(scale.InputField.Primitive())
+		return
+	}
+
+	v.state.Toggle.Scale = true
+	v.scaleGroup = group
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
+	scale.Render()
+	scale.InputField.SetText(fmt.Sprint(taskGroupCount(data, group)))
+	v.Layout.Container.SetFocus(scale.InputField.Primitive())
+}
+
+func (v *View) scaleJob(key tcell.Key) {
+	input := v.components.Scale.InputField
+	data := v.components.JobStatus.Props.Data
+
+	if key == tcell.KeyEnter && data != nil {
+		count, err := strconv.Atoi(strings.TrimSpace(input.GetText()))
+		if err != nil || count < 0 {
+			input.SetError("expected the number of allocations")
+			return
+		}
+
+		err = v.Client.ScaleJob(data.Namespace, data.ID, v.scaleGroup, count)
+		if err != nil {
+			input.SetError(fmt.Sprintf("failed to scale: %s", v.explain(err, data.Namespace, models.CapabilityScaleJob)))
+			return
+		}
+	}
+
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 0)
+	v.Layout.Footer.RemoveItem(input.Primitive())
+	v.Layout.Container.SetFocus(v.state.Elements.TableMain)
+	v.state.Toggle.Scale = false
+	input.SetError("")
+	input.SetText("")
+}
+
+func (v *View) initScale() {
+	scale := v.components.Scale
+	scale.Bind(v.Layout.Footer)
+	scale.Props.ChangedFunc = func(text string) {
+		scale.InputField.SetError("")
+	}
+	scale.Props.DoneFunc = v.scaleJob
+}
+
+// taskGroupCount returns the number of allocations of the
+// task group the job wants, i.e. the count to scale from. It
+// is read from the job spec, or the deployment if the spec
+// doesn't hold the task group. The allocations are counted
+// only if neither is available.
+func taskGroupCount(status *models.JobStatus, group string) int {
+	if count, ok := status.TaskGroupCounts[group]; ok {
+		return count
+	}
+
+	for _, tg := range status.TaskGroupStatus {
+		if tg.TaskGroup == group {
+			return tg.Desired
+		}
+	}
+
+	for _, tg := range status.TaskGroups {
+		if tg.Name == group {
+			return tg.Queued + tg.Starting + tg.Running
+		}
+	}
+
+	return 0
 }
@@ -19,7 +19,7 @@ func (v *View) Jobs() {
 	v.Layout.Body.SetTitle(titleJobs)
 
 	v.Layout.Container.SetInputCapture(v.InputJobs)
-	v.components.Commands.Update(component.JobCommands)
+	v.components.Commands.Update(v.restrict(component.JobCommands, component.JobCapabilities))
 
 	search := v.components.Search
 	table := v.components.JobTable
@@ -30,6 +30,7 @@ func (v *View) Jobs() {
 		table.Props.Data = v.filterJobs()
 		table.Props.Namespace = v.state.SelectedNamespace
 		table.Render()
+		v.renderDetail()
 
 		v.Draw()
 	}
@@ -51,7 +52,13 @@ func (v *View) Jobs() {
 		}
 	}
 
+	table.Props.HighlightJob = func(jobID string) {
+		v.renderDetail()
+	}
+
+	v.setLocation(bookmarkJobs, "")
 	v.Watcher.Subscribe(update, api.TopicJob, api.TopicAllocation)
+	v.setDetail(v.jobDetail)
 
 	update()
 
@@ -66,25 +73,19 @@ func (v *View) Jobs() {
 
 func (v *View) filterJobs() []*models.Job {
 	data := v.namespaceFilterJobs()
-	filter := v.state.Filter.Jobs
-	if filter != "" {
-		rx, _ := regexp.Compile(filter)
-		result := []*models.Job{}
-		for _, job := range data {
-			switch true {
-			case rx.MatchString(job.ID),
-				rx.MatchString(job.Name),
-				rx.MatchString(job.Namespace),
-				rx.MatchString(job.Status),
-				rx.MatchString(job.Type):
-				result = append(result, job)
-			}
-		}
+	query := v.parseFilter(v.state.Filter.Jobs, jobSchema)
+	if query == nil {
+		return data
+	}
 
-		return result
+	result := []*models.Job{}
+	for _, job := range data {
+		if query.Match(jobFields(job)) {
+			result = append(result, job)
+		}
 	}
 
-	return data
+	return result
 }
 
 func (v *View) namespaceFilterJobs() []*models.Job {
@@ -107,7 +108,8 @@ func (v *View) inputJobs(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Key() {
 	case tcell.KeyCtrlS:
 		jobID := v.components.JobTable.GetIDForSelection()
-		v.startStopJob(jobID)
+		namespace := v.components.JobTable.GetNamespaceForSelection()
+		v.startStopJob(namespace, jobID)
 	case tcell.KeyRune:
 		switch event.Rune() {
 		case 't':
@@ -126,16 +128,22 @@ func (v *View) inputJobs(event *tcell.EventKey) *tcell.EventKey {
 			jobID := v.components.JobTable.GetIDForSelection()
 			v.JobStatus(jobID)
 
-		case '/':
-			if !v.Layout.Footer.HasFocus() {
-				if !v.state.Toggle.Search {
-					v.state.Toggle.Search = true
-					v.Search()
-				} else {
-					v.Layout.Container.SetFocus(v.components.Search.InputField.Primitive())
-				}
-				return nil
+		case 'd':
+			if v.Layout.Footer.HasFocus() || v.components.Search.InputField.Primitive().HasFocus() {
+				return event
+			}
+
+			jobID := v.components.JobTable.GetIDForSelection()
+			namespace := v.components.JobTable.GetNamespaceForSelection()
+			v.JobSpec(namespace, jobID)
+
+		case 'm':
+			if v.Layout.Footer.HasFocus() || v.components.Search.InputField.Primitive().HasFocus() {
+				return event
 			}
+
+			jobID := v.components.JobTable.GetIDForSelection()
+			v.MergedLogs(models.LogScope{JobID: jobID})
 		}
 
 	}
@@ -143,18 +151,23 @@ func (v *View) inputJobs(event *tcell.EventKey) *tcell.EventKey {
 	return event
 }
 
-func (v *View) startStopJob(jobID string) {
-	job, err := v.Client.GetJob(jobID)
+func (v *View) startStopJob(namespace, jobID string) {
+	job, err := v.Client.GetJob(namespace, jobID)
 	if err != nil {
+		err = v.explain(err, namespace, models.CapabilityReadJob)
 		v.handleError("failed to start/stop job: %s", err.Error())
 		return
 	}
 
+	if !v.permitted(namespace, models.CapabilitySubmitJob) {
+		return
+	}
+
 	if *job.Status == "dead" {
 		v.components.Confirm.Props.Done = func(index int, text string) {
 			if index == 1 {
 				err := v.Client.StartJob(job)
-				v.err(err, "Failed to start job")
+				v.err(v.explain(err, namespace, models.CapabilitySubmitJob), "Failed to start job")
 			}
 
 			v.closeConfirmModal()
@@ -165,7 +178,7 @@ func (v *View) startStopJob(jobID string) {
 		v.components.Confirm.Props.Done = func(index int, text string) {
 			if index == 1 {
 				err := v.Client.StopJob(jobID)
-				v.err(err, "Failed to stop job")
+				v.err(v.explain(err, namespace, models.CapabilitySubmitJob), "Failed to stop job")
 			}
 
 			v.closeConfirmModal()
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/hcjulz/damon/models"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// logTarget is the log stream the log view shows, such
+// that its logs can be saved or opened at another start.
+type logTarget struct {
+	title                     string
+	allocID, taskName, source string
+
+	// scope is set for merged log streams
+	scope *models.LogScope
+
+	// path is set for files of an allocation
+	path string
+
+	// full is set when the full log is saved
+	// instead of the lines of the buffer.
+	full bool
+}
+
+func (v *View) setLogTitle() {
+	title := v.logTarget.title
+	if v.state.LogStart != nil {
+		title = fmt.Sprintf("%s (from %s)", title, models.FormatLogStart(v.state.LogStart))
+	}
+
+	v.Layout.Body.SetTitle(title)
+}
+
+// SaveLogs opens the input field to save the logs to a file. It saves
+// the lines which are kept in the buffer, or the full log of the task
+// fetched from the start if full is set.
+func (v *View) SaveLogs(full bool) {
+	if full && v.logTarget.scope != nil {
+		v.handleInfo("The full log can only be saved for the logs of a single task.")
+		return
+	}
+
+	save := v.components.LogSave
+	if v.state.Toggle.LogSave {
+		v.Layout.Container.SetFocus(save.InputField.Primitive())
+		return
+	}
+
+	v.logTarget.full = full
+	v.state.Toggle.LogSave = true
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
+	save.Render()
+	save.InputField.SetText(v.logFileName())
+	v.Layout.Container.SetFocus(save.InputField.Primitive())
+}
+
+func (v *View) saveLogs(key tcell.Key) {
+	input := v.components.LogSave.InputField
+	path := input.GetText()
+
+	if key == tcell.KeyEnter && path != "" {
+		if err := v.writeLogs(path); err != nil {
+			input.SetError(fmt.Sprintf("failed to save logs: %s", err))
+			return
+		}
+
+		v.Layout.Body.SetTitle(fmt.Sprintf("%s (saved to %s)", v.logTarget.title, path))
+	}
+
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 0)
+	v.Layout.Footer.RemoveItem(input.Primitive())
+	v.Layout.Container.SetFocus(v.components.LogStream.TextView.Primitive())
+	v.state.Toggle.LogSave = false
+	input.SetError("")
+	input.SetText("")
+}
+
+func (v *View) writeLogs(path string) error {
+	if v.logTarget.full {
+		t := v.logTarget
+		read := func() ([]byte, error) {
+			if t.path != "" {
+				return v.Client.CatFile(t.allocID, t.path)
+			}
+
+			return v.Client.FullLog(t.allocID, t.taskName, t.source)
+		}
+
+		data, err := read()
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(path, data, 0o600)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := v.state.LogBuffer.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// logFileName returns the default name of the file
+// the logs are saved to, e.g. web-1a2b3c4d-stdout-20230102-150405.log
+func (v *View) logFileName() string {
+	t := v.logTarget
+
+	allocID := t.allocID
+	if len(allocID) > 8 {
+		allocID = allocID[:8]
+	}
+
+	name := fmt.Sprintf("%s-%s-%s", t.taskName, allocID, t.source)
+	switch {
+	case t.scope != nil:
+		name = logScopeName(*t.scope)
+	case t.path != "":
+		name = fmt.Sprintf("%s-%s", path.Base(t.path), allocID)
+	}
+
+	name = unsafeFileNameChars.ReplaceAllString(name, "-")
+	return fmt.Sprintf("%s-%s.log", name, time.Now().Format("20060102-150405"))
+}
+
+// LogStartInput opens the input field to set where log streams start.
+func (v *View) LogStartInput() {
+	start := v.components.LogStart
+	if v.state.Toggle.LogStart {
+		v.Layout.Container.SetFocus(start.InputField.Primitive())
+		return
+	}
+
+	v.state.Toggle.LogStart = true
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
+	start.Render()
+	start.InputField.SetText(models.FormatLogStart(v.state.LogStart))
+	v.Layout.Container.SetFocus(start.InputField.Primitive())
+}
+
+func (v *View) setLogStart(key tcell.Key) {
+	input := v.components.LogStart.InputField
+
+	if key == tcell.KeyEnter {
+		start, err := models.ParseLogStart(input.GetText())
+		if err != nil {
+			input.SetError(err.Error())
+			return
+		}
+
+		v.state.LogStart = start
+	}
+
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 0)
+	v.Layout.Footer.RemoveItem(input.Primitive())
+	v.Layout.Container.SetFocus(v.components.LogStream.TextView.Primitive())
+	v.state.Toggle.LogStart = false
+	input.SetError("")
+	input.SetText("")
+
+	if key == tcell.KeyEnter {
+		v.setLogTitle()
+		v.Watcher.ResumeLogs()
+	}
+}
+
+func (v *View) initLogFiles() {
+	save := v.components.LogSave
+	save.Bind(v.Layout.Footer)
+	save.Props.ChangedFunc = func(text string) {
+		save.InputField.SetError("")
+	}
+	save.Props.DoneFunc = v.saveLogs
+
+	start := v.components.LogStart
+	start.Bind(v.Layout.Footer)
+	start.Props.ChangedFunc = func(text string) {
+		start.InputField.SetError("")
+	}
+	start.Props.DoneFunc = v.setLogStart
+}
@@ -4,31 +4,37 @@
 package view
 
 import (
+	"fmt"
+
 	"github.com/hcjulz/damon/component"
 	"github.com/hcjulz/damon/models"
 )
 
 func (v *View) Logs(taskName string, allocID, source string) {
-	v.Layout.Body.SetTitle(titleLogs)
+	if !v.permitted(v.allocNamespace(allocID), models.CapabilityReadLogs) {
+		return
+	}
+
+	v.viewSwitch()
+	v.logTarget = logTarget{
+		title:    titleLogs,
+		allocID:  allocID,
+		taskName: taskName,
+		source:   source,
+	}
+	v.setLogTitle()
 
 	v.components.LogSearch.InputField.SetText("")
+	v.components.LogFind.InputField.SetText("")
 	v.Layout.Body.Clear()
 	v.components.LogStream.Clear()
 
-	logStreamProps := v.components.LogStream.Props
-	logStreamProps.TaskName = taskName
-
-	// If the logstream contains data from a previous log stream
-	// remove it!
-	if len(logStreamProps.Data) > 0 {
-		logStreamProps.Data = []byte{}
-	}
+	v.components.LogStream.Props.TaskName = taskName
 
 	v.Layout.Container.SetInputCapture(v.InputLogs)
 	v.components.Commands.Update(component.LogCommands)
 
 	update := func() {
-		logStreamProps.Data = append(logStreamProps.Data, v.state.Logs...)
 		v.components.LogStream.Render()
 		v.Draw()
 	}
@@ -48,3 +54,71 @@ func (v *View) Logs(taskName string, allocID, source string) {
 
 	v.Layout.Container.SetInputCapture(v.InputLogs)
 }
+
+// MergedLogs tails the logs of all tasks in the scope at the same
+// time. Streams are added and removed as allocations come and go.
+func (v *View) MergedLogs(scope models.LogScope) {
+	if !v.permitted(v.allocNamespace(scope.AllocID), models.CapabilityReadLogs) {
+		return
+	}
+
+	v.viewSwitch()
+	v.logTarget = logTarget{
+		title: fmt.Sprintf("%s (%s)", titleMergedLogs, logScopeName(scope)),
+		scope: &scope,
+	}
+	v.setLogTitle()
+
+	v.components.LogSearch.InputField.SetText("")
+	v.components.LogFind.InputField.SetText("")
+	v.Layout.Body.Clear()
+	v.components.LogStream.Clear()
+
+	v.components.LogStream.Props.TaskName = logScopeName(scope)
+
+	v.Layout.Container.SetInputCapture(v.InputLogs)
+	v.components.Commands.Update(component.LogCommands)
+
+	update := func() {
+		v.components.LogStream.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToMergedLogs(scope, update)
+
+	v.components.LogStream.ClearDisplay()
+	v.components.LogStream.Display()
+
+	update()
+
+	v.Layout.Container.SetFocus(v.components.LogStream.TextView.Primitive())
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicLog, func() {
+		update()
+	})
+}
+
+func logScopeName(scope models.LogScope) string {
+	switch {
+	case scope.AllocID != "":
+		return fmt.Sprintf("alloc %s", scope.AllocID)
+	case scope.TaskGroup != "":
+		return fmt.Sprintf("%s/%s", scope.JobID, scope.TaskGroup)
+	}
+
+	return scope.JobID
+}
+
+// logContexts are the numbers of context lines which can be
+// shown around the lines matching the log filter.
+var logContexts = []int{0, 1, 2, 3, 5, 10}
+
+func nextLogContext(context int) int {
+	for i, c := range logContexts {
+		if c > context {
+			return logContexts[i]
+		}
+	}
+
+	return logContexts[0]
+}
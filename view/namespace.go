@@ -4,8 +4,6 @@
 package view
 
 import (
-	"regexp"
-
 	"github.com/rivo/tview"
 
 	"github.com/hcjulz/damon/component"
@@ -32,6 +30,7 @@ func (v *View) Namespaces() {
 		update()
 	}
 
+	v.setLocation(bookmarkNamespaces, "")
 	v.Watcher.SubscribeToNamespaces(update)
 
 	update()
@@ -57,22 +56,19 @@ func getNamespaceNameIndex(name string, ns []*models.Namespace) int {
 }
 
 func (v *View) filterNamespaces(data []*models.Namespace) []*models.Namespace {
-	filter := v.state.Filter.Namespaces
-	if filter != "" {
-		rx, _ := regexp.Compile(filter)
-		result := []*models.Namespace{}
-		for _, ns := range v.state.Namespaces {
-			switch true {
-			case rx.MatchString(ns.Name),
-				rx.MatchString(ns.Description):
-				result = append(result, ns)
-			}
-		}
+	query := v.parseFilter(v.state.Filter.Namespaces, namespaceSchema)
+	if query == nil {
+		return data
+	}
 
-		return result
+	result := []*models.Namespace{}
+	for _, ns := range data {
+		if query.Match(namespaceFields(ns)) {
+			result = append(result, ns)
+		}
 	}
 
-	return data
+	return result
 }
 
 func (v *View) resetSearch() {
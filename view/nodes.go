@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// Node shows the details of a client, such as its drivers,
+// resources and events, and the allocations it runs.
+func (v *View) Node(nodeID string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleNode)
+	v.Layout.Body.Clear()
+
+	v.components.Commands.Update(component.NodeInfoCommands)
+	v.Layout.Container.SetInputCapture(v.InputNode)
+
+	info := v.components.NodeInfo
+
+	update := func() {
+		info.Props.Data = v.state.NodeDetail
+		info.Render()
+		v.Draw()
+	}
+
+	v.Watcher.SubscribeToNodeDetail(nodeID, update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicNodeDetail, func() {
+		v.Node(nodeID)
+	})
+
+	v.Layout.Container.SetFocus(info.TextView.Primitive())
+}
+
+func (v *View) InputNode(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'a' {
+		if detail := v.components.NodeInfo.Props.Data; detail != nil {
+			v.NodeAllocations(detail.ID)
+		}
+		return nil
+	}
+
+	return event
+}
+
+// NodeAllocations lists the allocations of a client, of all
+// namespaces, such that they can be followed to their tasks.
+func (v *View) NodeAllocations(nodeID string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleAllocations)
+
+	v.components.Commands.Update(v.restrict(component.AllocCommands, component.AllocCapabilities))
+	v.Layout.Container.SetInputCapture(v.InputAllocations)
+
+	search := v.components.Search
+	table := v.components.AllocationTable
+	table.Props.JobID = ""
+
+	update := func() {
+		table.Props.Data = v.filterNodeAllocs()
+		table.Props.NodeName = nodeID
+		if detail := v.state.NodeDetail; detail != nil {
+			table.Props.NodeName = detail.Name
+		}
+
+		table.Props.Stats = v.allocStats(table.Props.Data)
+		table.Render()
+		v.renderDetail()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Allocations = text
+		update()
+	}
+
+	table.Props.HighlightAllocation = func(allocID string) {
+		v.renderDetail()
+	}
+
+	v.Watcher.SubscribeToNodeDetail(nodeID, update)
+	v.Watcher.WatchAllocStats(func() []string {
+		return runningAllocIDs(table.Props.Data)
+	})
+	v.setDetail(v.allocationDetail)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicNodeDetail, func() {
+		v.NodeAllocations(nodeID)
+	})
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterNodeAllocs() []*models.Alloc {
+	if v.state.NodeDetail == nil {
+		return nil
+	}
+
+	data := v.state.NodeDetail.Allocations
+	query := v.parseFilter(v.state.Filter.Allocations, allocSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*models.Alloc{}
+	for _, alloc := range data {
+		if query.Match(allocFields(alloc)) {
+			result = append(result, alloc)
+		}
+	}
+
+	return result
+}
+
+// NodePools lists the node pools with the number of their clients.
+func (v *View) NodePools() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleNodePools)
+
+	v.Layout.Container.SetInputCapture(v.InputNodePools)
+	v.components.Commands.Update(component.NodePoolCommands)
+
+	search := v.components.Search
+	table := v.components.NodePoolTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterNodePools()
+		table.Props.Nodes = v.state.Nodes
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.NodePools = text
+		update()
+	}
+
+	v.setLocation(bookmarkNodePools, "")
+	v.Watcher.SubscribeToNodePools(update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicNodePool, v.NodePools)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) InputNodePools(event *tcell.EventKey) *tcell.EventKey {
+	return v.InputMainCommands(event)
+}
+
+func (v *View) filterNodePools() []*models.NodePool {
+	query := v.parseFilter(v.state.Filter.NodePools, nodePoolSchema)
+	if query == nil {
+		return v.state.NodePools
+	}
+
+	result := []*models.NodePool{}
+	for _, pool := range v.state.NodePools {
+		if query.Match(nodePoolFields(pool)) {
+			result = append(result, pool)
+		}
+	}
+
+	return result
+}
+
+// openNodePool lists the clients of the pool. Every
+// client is in the built-in pool "all".
+func (v *View) openNodePool(name string) {
+	if name == component.NodePoolAll {
+		v.openFiltered(bookmarkNodes, "")
+		return
+	}
+
+	v.openFiltered(bookmarkNodes, fmt.Sprintf("pool=%s", name))
+}
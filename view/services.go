@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// Services lists the services registered with the
+// Nomad service provider in the selected namespace.
+func (v *View) Services() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleServices)
+
+	v.Layout.Container.SetInputCapture(v.InputServices)
+	v.components.Commands.Update(component.ServiceCommands)
+
+	search := v.components.Search
+	table := v.components.ServiceTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterServices()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Services = text
+		update()
+	}
+
+	v.Watcher.SubscribeToServices(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.Services()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicService, v.Services)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterServices() []*models.Service {
+	rx, _ := regexp.Compile(v.state.SelectedNamespace)
+	query := v.parseFilter(v.state.Filter.Services, serviceSchema)
+
+	result := []*models.Service{}
+	for _, service := range v.state.Services {
+		if !rx.MatchString(service.Namespace) {
+			continue
+		}
+
+		if query == nil || query.Match(serviceFields(service)) {
+			result = append(result, service)
+		}
+	}
+
+	return result
+}
+
+// ServiceInstances lists the instances of a service with the status
+// of their health checks. The detail panel shows the results of the
+// checks of the selected instance.
+func (v *View) ServiceInstances(namespace, name string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleServiceInstances)
+
+	v.Layout.Container.SetInputCapture(v.InputServiceInstances)
+	v.components.Commands.Update(component.ServiceInstanceCommands)
+
+	search := v.components.Search
+	table := v.components.ServiceInstanceTable
+	table.Props.Namespace = namespace
+	table.Props.ServiceName = name
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterServiceInstances()
+		table.Props.NodeNames = v.nodeNames()
+		table.Render()
+		v.renderDetail()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Instances = text
+		update()
+	}
+
+	table.Props.HighlightInstance = func(instance *models.ServiceInstance) {
+		v.renderDetail()
+	}
+
+	v.Watcher.SubscribeToServiceInstances(namespace, name, update)
+	v.setDetail(v.serviceCheckDetail)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicServiceInstance, func() {
+		v.ServiceInstances(namespace, name)
+	})
+
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterServiceInstances() []*models.ServiceInstance {
+	data := v.state.ServiceInstances
+	query := v.parseFilter(v.state.Filter.Instances, serviceInstanceSchema)
+	if data == nil || query == nil {
+		return data
+	}
+
+	result := []*models.ServiceInstance{}
+	for _, instance := range data {
+		if query.Match(serviceInstanceFields(instance)) {
+			result = append(result, instance)
+		}
+	}
+
+	return result
+}
+
+// nodeNames maps the IDs of the nodes which run
+// allocations to their names.
+func (v *View) nodeNames() map[string]string {
+	names := map[string]string{}
+	for _, alloc := range v.state.Allocations {
+		names[alloc.NodeID] = alloc.NodeName
+	}
+
+	return names
+}
+
+// serviceCheckDetail renders the checks of the selected instance.
+func (v *View) serviceCheckDetail() {
+	instance := v.components.ServiceInstanceTable.GetInstanceForSelection()
+	if instance == nil {
+		v.Layout.Detail.Clear()
+		return
+	}
+
+	detail := v.components.ServiceCheckDetail
+	detail.Props.Data = instance
+	detail.Render()
+}
+
+// serviceAllocation shows the allocation of the instance.
+func (v *View) serviceAllocation(instance *models.ServiceInstance) {
+	v.showAllocation(instance.JobID, instance.AllocID)
+}
+
+// showAllocation shows the allocations of the
+// job with the allocation selected.
+func (v *View) showAllocation(jobID, allocID string) {
+	v.Allocations(jobID)
+	selectRow(v.state.Elements.TableMain, 0, allocID)
+}
+
+// selectRow selects the first row of the table whose
+// cell in the column holds the text.
+func selectRow(table *tview.Table, column int, text string) {
+	for row := 1; row < table.GetRowCount(); row++ {
+		cell := table.GetCell(row, column)
+		if cell != nil && strings.TrimSpace(cell.Text) == text {
+			table.Select(row, column)
+			return
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 )
 
 func (v *View) TaskEvents(allocID, taskName string) {
+	v.viewSwitch()
 	v.Layout.Body.SetTitle(titleTaskEvents)
 	v.state.Elements.TableMain = v.components.TaskEventsTable.Table.Primitive().(*tview.Table)
 
@@ -30,13 +31,18 @@ func (v *View) TaskEvents(allocID, taskName string) {
 	reverseEvents(task.Events)
 
 	update := func() {
-		v.components.TaskEventsTable.Props.Data = task.Events
+		v.components.TaskEventsTable.Props.Data = v.filterTaskEvents(task.Events)
 		v.components.TaskEventsTable.Props.AllocID = allocID
 		v.components.TaskEventsTable.Props.HandleNoResources = v.handleNoResources
 		v.components.TaskEventsTable.Render()
 		v.Draw()
 	}
 
+	v.components.Search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.TaskEvents = text
+		update()
+	}
+
 	v.Watcher.Subscribe(update, api.TopicAllocation)
 
 	update()
@@ -53,6 +59,22 @@ func (v *View) TaskEvents(allocID, taskName string) {
 	v.Layout.Container.SetFocus(v.components.TaskEventsTable.Table.Primitive())
 }
 
+func (v *View) filterTaskEvents(data []*api.TaskEvent) []*api.TaskEvent {
+	query := v.parseFilter(v.state.Filter.TaskEvents, taskEventSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*api.TaskEvent{}
+	for _, event := range data {
+		if query.Match(taskEventFields(event)) {
+			result = append(result, event)
+		}
+	}
+
+	return result
+}
+
 func getTaskFromAlloc(alloc *models.Alloc, taskName string) *models.Task {
 	for _, t := range alloc.TaskList {
 		if t.Name == taskName {
@@ -76,5 +98,22 @@ func (v *View) getAllocation(id string) (*models.Alloc, bool) {
 		}
 	}
 
+	// the allocations of a client may be in any namespace
+	if v.state.NodeDetail != nil {
+		for _, a := range v.state.NodeDetail.Allocations {
+			if a.ID == id {
+				return a, true
+			}
+		}
+	}
+
+	if v.state.JobStatus != nil {
+		for _, a := range v.state.JobStatus.Allocations {
+			if a.ID == id {
+				return a, true
+			}
+		}
+	}
+
 	return nil, false
 }
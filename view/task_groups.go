@@ -11,16 +11,17 @@ import (
 )
 
 func (v *View) TaskGroups(jobID string) {
+	v.viewSwitch()
 	v.Layout.Body.SetTitle(titleTaskGroups)
 	v.state.Elements.TableMain = v.components.TaskGroupTable.Table.Primitive().(*tview.Table)
 
-	v.components.Commands.Update(component.NoViewCommands)
+	v.components.Commands.Update(v.restrict(component.TaskGroupCommands, component.TaskGroupCapabilities))
 	v.Layout.Container.SetInputCapture(v.InputTaskGroups)
 
 	search := v.components.Search
 
 	update := func() {
-		v.components.TaskGroupTable.Props.Data = v.state.TaskGroups
+		v.components.TaskGroupTable.Props.Data = v.filterTaskGroups()
 		v.components.TaskGroupTable.Props.JobID = jobID
 		v.components.TaskGroupTable.Props.HandleNoResources = v.handleNoResources
 		v.components.TaskGroupTable.Render()
@@ -32,6 +33,7 @@ func (v *View) TaskGroups(jobID string) {
 		update()
 	}
 
+	v.setLocation(bookmarkTaskGroups, jobID)
 	v.Watcher.SubscribeToTaskGroups(jobID, update)
 
 	update()
@@ -47,3 +49,19 @@ func (v *View) TaskGroups(jobID string) {
 
 	v.Layout.Container.SetFocus(v.components.TaskGroupTable.Table.Primitive())
 }
+
+func (v *View) filterTaskGroups() []*models.TaskGroup {
+	query := v.parseFilter(v.state.Filter.TaskGroups, taskGroupSchema)
+	if query == nil {
+		return v.state.TaskGroups
+	}
+
+	result := []*models.TaskGroup{}
+	for _, tg := range v.state.TaskGroups {
+		if query.Match(taskGroupFields(tg)) {
+			result = append(result, tg)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/component"
+)
+
+// TaskInfo shows the details of a task of an allocation.
+// Values which look like secrets are masked until revealed.
+func (v *View) TaskInfo(allocID, taskName string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleTaskInfo)
+	v.Layout.Body.Clear()
+
+	v.components.Commands.Update(component.TaskInfoCommands)
+	v.Layout.Container.SetInputCapture(v.InputTaskInfo)
+
+	info := v.components.TaskInfo
+	info.Props.AllocID = allocID
+	info.Props.Reveal = false
+
+	update := func() {
+		info.Props.Data = nil
+		if alloc, ok := v.getAllocation(allocID); ok {
+			info.Props.Data = getTaskFromAlloc(alloc, taskName)
+		}
+
+		info.Render()
+		v.Draw()
+	}
+
+	v.Watcher.Subscribe(update, api.TopicAllocation)
+
+	update()
+
+	v.addToHistory(v.state.SelectedNamespace, api.TopicAllocation, func() {
+		v.TaskInfo(allocID, taskName)
+	})
+
+	v.Layout.Container.SetFocus(info.TextView.Primitive())
+}
+
+func (v *View) InputTaskInfo(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'r' {
+		info := v.components.TaskInfo
+		info.Props.Reveal = !info.Props.Reveal
+		info.Render()
+		return nil
+	}
+
+	return event
+}
@@ -15,28 +15,44 @@ func (v *View) Tasks(alloc *models.Alloc) {
 	v.viewSwitch()
 	v.Layout.Body.SetTitle(titleTasks)
 
-	v.Layout.Container.SetInputCapture(v.InputMainCommands)
-	v.components.Commands.Update(component.TaskCommands)
+	v.Layout.Container.SetInputCapture(v.InputTasks)
+	v.components.Commands.Update(v.restrict(component.TaskCommands, component.TaskCapabilities))
 
+	search := v.components.Search
 	table := v.components.TaskTable
-	table.Props.Data = alloc.TaskList
 	table.Props.AllocationID = alloc.ID
 
 	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
 
 	update := func() {
+		table.Props.Data = v.filterTasks(alloc.TaskList)
+		table.Props.Stats = v.state.AllocStats.Get(alloc.ID)
 		table.Render()
+		v.renderDetail()
 
 		v.Draw()
 	}
 
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Tasks = text
+		update()
+	}
+
 	if table.Props.SelectTask == nil {
 		table.Props.SelectTask = func(taskName, allocID string) {
 			v.Logs(taskName, allocID, "stdout")
 		}
 	}
 
-	v.Watcher.Subscribe(update, api.TopicAllocation)
+	table.Props.HighlightTask = func(taskName, allocID string) {
+		v.renderDetail()
+	}
+
+	v.Watcher.Subscribe(update, api.TopicAllocation, models.TopicAllocStats)
+	v.Watcher.WatchAllocStats(func() []string {
+		return runningAllocIDs([]*models.Alloc{alloc})
+	})
+	v.setDetail(v.taskDetail)
 
 	update()
 
@@ -51,3 +67,19 @@ func (v *View) Tasks(alloc *models.Alloc) {
 
 	v.Layout.Container.SetFocus(v.components.TaskTable.Table.Primitive())
 }
+
+func (v *View) filterTasks(data []*models.Task) []*models.Task {
+	query := v.parseFilter(v.state.Filter.Tasks, taskSchema)
+	if query == nil {
+		return data
+	}
+
+	result := []*models.Task{}
+	for _, task := range data {
+		if query.Match(taskFields(task)) {
+			result = append(result, task)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// variableDocument is the document a variable is
+// edited as in the editor of the user.
+type variableDocument struct {
+	Namespace string
+	Path      string
+	Items     map[string]string
+}
+
+// Variables lists the Nomad Variables below the path prefix like
+// a directory tree. The prefix is empty or ends with a slash.
+func (v *View) Variables(prefix string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleVariables)
+
+	v.Layout.Container.SetInputCapture(v.InputVariables)
+	v.components.Commands.Update(component.VariableCommands)
+
+	search := v.components.Search
+	table := v.components.VariableTable
+	table.Props.Prefix = prefix
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterVariables()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Variables = text
+		update()
+	}
+
+	v.Watcher.SubscribeToVariables(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.Variables(prefix)
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicVariable, func() {
+		v.Variables(prefix)
+	})
+
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterVariables() []*models.Variable {
+	rx, _ := regexp.Compile(v.state.SelectedNamespace)
+	query := v.parseFilter(v.state.Filter.Variables, variableSchema)
+
+	result := []*models.Variable{}
+	for _, variable := range v.state.Variables {
+		if !rx.MatchString(variable.Namespace) {
+			continue
+		}
+
+		if query == nil || query.Match(variableFields(variable)) {
+			result = append(result, variable)
+		}
+	}
+
+	return result
+}
+
+// Variable shows the items of a variable. The
+// values are masked until they are revealed.
+func (v *View) Variable(namespace, path string) {
+	v.viewSwitch()
+	v.Watcher.Unsubscribe()
+	v.Layout.Body.SetTitle(titleVariable)
+
+	v.Layout.Container.SetInputCapture(v.InputVariable)
+	v.components.Commands.Update(component.VariableItemCommands)
+
+	table := v.components.VariableItems
+	table.Props.Reveal = false
+	table.Props.Data = nil
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicVariable, func() {
+		v.Variable(namespace, path)
+	})
+
+	variable, err := v.Client.Variable(namespace, path)
+	if err != nil {
+		v.handleError("Failed to read variable %s: %s", path, err.Error())
+		return
+	}
+
+	table.Props.Data = variable
+	table.Render()
+
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) InputVariables(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	table := v.components.VariableTable
+
+	switch event.Rune() {
+	case 'c':
+		v.createVariable(table.Props.Prefix)
+		return nil
+
+	case 'e':
+		if entry := table.GetEntryForSelection(); entry != nil && !entry.IsDir {
+			v.editVariable(entry.Namespace, entry.Path)
+		}
+		return nil
+
+	case 'd':
+		if entry := table.GetEntryForSelection(); entry != nil && !entry.IsDir {
+			v.deleteVariable(entry.Variable, func() {
+				v.Variables(table.Props.Prefix)
+			})
+		}
+		return nil
+
+	case 'u':
+		if prefix := table.Props.Prefix; prefix != "" {
+			v.Variables(parentPrefix(prefix))
+		}
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) InputVariable(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	table := v.components.VariableItems
+	if table.Props.Data == nil {
+		return event
+	}
+
+	switch event.Rune() {
+	case 'r':
+		table.Props.Reveal = !table.Props.Reveal
+		table.Render()
+		return nil
+
+	case 'e':
+		v.editVariable(table.Props.Data.Namespace, table.Props.Data.Path)
+		return nil
+
+	case 'd':
+		v.deleteVariable(table.Props.Data, func() {
+			v.Variables(parentPrefix(table.Props.Data.Path))
+		})
+		return nil
+	}
+
+	return event
+}
+
+// createVariable opens a new variable below the prefix in the editor
+// and creates it. Creating fails if the path exists in the meantime.
+func (v *View) createVariable(prefix string) {
+	namespace := v.state.SelectedNamespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	v.writeVariable(&models.Variable{
+		Namespace: namespace,
+		Path:      prefix,
+		Items:     map[string]string{"": ""},
+	})
+}
+
+// editVariable reads the variable, opens it in the editor and
+// updates it. Updating fails if the variable was changed since
+// it was read.
+func (v *View) editVariable(namespace, path string) {
+	variable, err := v.Client.Variable(namespace, path)
+	if err != nil {
+		v.handleError("Failed to read variable %s: %s", path, err.Error())
+		return
+	}
+
+	v.writeVariable(variable)
+}
+
+func (v *View) writeVariable(variable *models.Variable) {
+	doc, err := json.MarshalIndent(variableDocument{
+		Namespace: variable.Namespace,
+		Path:      variable.Path,
+		Items:     variable.Items,
+	}, "", "  ")
+	if err != nil {
+		v.handleError("Failed to edit variable: %s", err.Error())
+		return
+	}
+
+	edited, err := v.edit("damon-variable-*.json", doc)
+	if err != nil {
+		v.handleError("Failed to edit variable: %s", err.Error())
+		return
+	}
+
+	// nothing to write if the editor was closed without changes
+	if bytes.Equal(bytes.TrimSpace(edited), bytes.TrimSpace(doc)) {
+		return
+	}
+
+	var out variableDocument
+	if err := json.Unmarshal(edited, &out); err != nil {
+		v.handleError("Invalid variable: %s", err.Error())
+		return
+	}
+
+	out.Path = strings.Trim(out.Path, "/")
+	switch {
+	case out.Path == "":
+		v.handleError("Invalid variable: the path is missing")
+		return
+	case len(out.Items) == 0:
+		v.handleError("Invalid variable: a variable needs at least one item")
+		return
+	case variable.ModifyIndex != 0 && (out.Namespace != variable.Namespace || out.Path != variable.Path):
+		v.handleError("Invalid variable: the namespace and path of %s can't be changed", variable.Path)
+		return
+	}
+
+	if _, ok := out.Items[""]; ok {
+		v.handleError("Invalid variable: item keys can't be empty")
+		return
+	}
+
+	written, err := v.Client.PutVariable(&models.Variable{
+		Namespace:   out.Namespace,
+		Path:        out.Path,
+		Items:       out.Items,
+		ModifyIndex: variable.ModifyIndex,
+	})
+	if err != nil {
+		err = v.explain(err, out.Namespace, models.CapabilityVariablesWrite)
+		v.handleError("Failed to write variable %s: %s", out.Path, err.Error())
+		return
+	}
+
+	v.Variable(written.Namespace, written.Path)
+}
+
+// deleteVariable asks for confirmation and deletes the variable,
+// unless it was changed since it was read. It calls done after
+// the variable was deleted.
+func (v *View) deleteVariable(variable *models.Variable, done func()) {
+	v.components.Confirm.Props.Done = func(index int, text string) {
+		v.closeConfirmModal()
+
+		if index == 1 {
+			err := v.Client.DeleteVariable(variable.Namespace, variable.Path, variable.ModifyIndex)
+			if err != nil {
+				err = v.explain(err, variable.Namespace, models.CapabilityVariablesDestroy)
+				v.handleError("Failed to delete variable %s: %s", variable.Path, err.Error())
+				return
+			}
+
+			done()
+		}
+	}
+
+	v.components.Confirm.Render(fmt.Sprintf("Do you really want to delete the variable %s:%s?", variable.Namespace, variable.Path))
+	v.Layout.Container.SetFocus(v.components.Confirm.Modal.Primitive())
+}
+
+// parentPrefix returns the prefix of the directory
+// above the path, or an empty prefix for the root.
+func parentPrefix(p string) string {
+	dir := path.Dir(strings.TrimSuffix(p, "/"))
+	if dir == "." || dir == "/" {
+		return ""
+	}
+
+	return dir + "/"
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/nomad/api"
 
 	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/config"
 	"github.com/hcjulz/damon/layout"
 	"github.com/hcjulz/damon/models"
 	"github.com/hcjulz/damon/state"
@@ -17,6 +18,9 @@ import (
 const (
 	historySize = 10
 
+	StartViewJobs     = "jobs"
+	StartViewOverview = "overview"
+
 	titleTaskGroups  = "taskgroups"
 	titleJobs        = "jobs"
 	titleTasks       = "tasks"
@@ -26,14 +30,55 @@ const (
 	titleAllocations = "allocations"
 	titleTaskEvents  = "taskevents"
 	titleLogs        = "logs"
+	titleMergedLogs  = "merged logs"
+	titleAllocFS     = "files"
+	titleFile        = "file"
+	titleTailFile    = "tail"
+	titleTaskInfo    = "task"
+	titleAllocInfo   = "allocation"
+	titleVariables   = "variables"
+	titleVariable    = "variable"
+	titleServices    = "services"
+	titleVolumes     = "volumes"
+	titlePlugins     = "plugins"
+	titleACLPolicies = "acl policies"
+	titleACLPolicy   = "acl policy"
+	titleACLRoles    = "acl roles"
+	titleACLTokens   = "acl tokens"
+	titleOverview    = "overview"
+	titleServers     = "servers"
+	titleNodes       = "clients"
+	titleNode        = "client"
+	titleNodePools   = "node pools"
+	titleEvaluations = "evaluations"
+	titleJobVersions = "job versions"
+	titleDeployment  = "deployment"
+	titleJobSpec     = "job spec"
+
+	titleServiceInstances = "service instances"
+	titleVolumeClaims     = "volume claims"
 )
 
 // Client ...
 //go:generate counterfeiter . Client
 type Client interface {
-	GetJob(string) (*api.Job, error)
+	GetJob(namespace, jobID string) (*api.Job, error)
 	StartJob(job *api.Job) error
 	StopJob(string) error
+	ScaleJob(namespace, jobID, group string, count int) error
+	JobSpec(job *api.Job) (*models.JobSpec, error)
+	FullLog(allocID, taskName, logType string) ([]byte, error)
+	ListFiles(allocID, dir string) ([]*models.AllocFile, error)
+	StatFile(allocID, file string) (*models.AllocFile, error)
+	ReadFile(allocID, file string, offset, limit int64) ([]byte, error)
+	CatFile(allocID, file string) ([]byte, error)
+	Variable(namespace, path string) (*models.Variable, error)
+	PutVariable(variable *models.Variable) (*models.Variable, error)
+	DeleteVariable(namespace, path string, modifyIndex uint64) error
+	DetachVolume(namespace, volumeID, nodeID string) error
+	ACLPolicy(name string) (*models.ACLPolicy, error)
+	PutACLPolicy(policy *models.ACLPolicy) error
+	RevokeToken(accessorID string) error
 }
 
 // Watcher ...
@@ -46,7 +91,27 @@ type Watcher interface {
 	SubscribeToNamespaces(notify func())
 	SubscribeToTaskGroups(jobID string, notify func()) error
 	SubscribeToJobStatus(jobID string, notify func()) error
+	SubscribeToJobVersions(namespace, jobID string, notify func())
+	SubscribeToDeploymentDetail(namespace, deploymentID string, notify func())
 	SubscribeToLogs(allocID, taskName, source string, notify func())
+	SubscribeToMergedLogs(scope models.LogScope, notify func())
+	SubscribeToFile(allocID, path string, notify func())
+	SubscribeToAllocDetail(allocID string, notify func())
+	SubscribeToVariables(notify func())
+	SubscribeToServices(notify func())
+	SubscribeToServiceInstances(namespace, name string, notify func())
+	SubscribeToVolumes(notify func())
+	SubscribeToVolume(namespace, id string, notify func())
+	SubscribeToACL(notify func())
+	SubscribeToCluster(notify func())
+	SubscribeToServers(notify func())
+	SubscribeToNodes(notify func())
+	SubscribeToNodeDetail(nodeID string, notify func())
+	SubscribeToNodePools(notify func())
+	SubscribeToEvaluations(notify func())
+	FollowJobStatus(jobID string, notify func())
+	StopFollowing()
+	WatchAllocStats(allocIDs func() []string)
 
 	ResumeLogs()
 }
@@ -63,6 +128,25 @@ type View struct {
 	components *Components
 	mutex      sync.Mutex
 
+	detail        func()
+	followedJobID string
+	logTarget     logTarget
+	scaleGroup    string
+	filePage      *filePage
+
+	// Config holds the bookmarks. Bookmarks are
+	// disabled when it is nil.
+	Config   *config.Config
+	location location
+
+	// ConfigErr is shown when Damon starts, if
+	// the config file couldn't be loaded.
+	ConfigErr error
+
+	// StartView is the view shown when Damon starts,
+	// either the jobs (default) or the cluster overview.
+	StartView string
+
 	draw chan struct{}
 }
 
@@ -80,15 +164,53 @@ type Components struct {
 	AllocationTable *component.AllocationTable
 	TaskGroupTable  *component.TaskGroupTable
 	TaskEventsTable *component.TaskEventsTable
+	AllocFSTable    *component.AllocFSTable
+	TaskInfo        *component.TaskInfo
+	AllocInfo       *component.AllocInfo
+	VariableTable   *component.VariableTable
+	VariableItems   *component.VariableItemsTable
+	ServiceTable    *component.ServiceTable
+	VolumeTable     *component.VolumeTable
+	PluginTable     *component.PluginTable
+	ACLPolicyTable  *component.ACLPolicyTable
+	ACLPolicyRules  *component.ACLPolicyRules
+	ACLRoleTable    *component.ACLRoleTable
+	ACLTokenTable   *component.ACLTokenTable
+	ClusterOverview *component.ClusterOverview
+	ServerTable     *component.ServerTable
+	NodeTable       *component.NodeTable
+	NodeInfo        *component.NodeInfo
+	NodePoolTable   *component.NodePoolTable
+	EvaluationTable *component.EvaluationTable
+	JobVersionTable *component.JobVersionTable
+	DeploymentInfo  *component.DeploymentInfo
+	JobSpec         *component.JobSpec
 	JumpToJob       *component.JumpToJob
 	Error           *component.Error
 	Info            *component.Info
 	Failure         *component.Info
+	Denied          *component.Info
 	LogStream       *component.Logger
 	LogSearch       *component.SearchField
 	LogHighlight    *component.SearchField
+	LogFind         *component.SearchField
+	LogSave         *component.SearchField
+	LogStart        *component.SearchField
 	Search          *component.SearchField
 	Confirm         *component.GenericModal
+	Bookmarks       *component.Bookmarks
+	BookmarkName    *component.SearchField
+	Scale           *component.SearchField
+	SpecFind        *component.SearchField
+
+	ServiceInstanceTable *component.ServiceInstanceTable
+	VolumeClaimTable     *component.VolumeClaimTable
+
+	JobStatusDetail  *component.JobStatus
+	TaskDetail       *component.TaskTable
+	TaskEventsDetail *component.TaskEventsTable
+
+	ServiceCheckDetail *component.ServiceCheckTable
 }
 
 func New(components *Components, watcher Watcher, client Client, state *state.State) *View {
@@ -129,6 +251,13 @@ func (v *View) LogSearch() {
 	v.Layout.Container.SetFocus(search.InputField.Primitive())
 }
 
+func (v *View) LogFind() {
+	find := v.components.LogFind
+	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
+	find.Render()
+	v.Layout.Container.SetFocus(find.InputField.Primitive())
+}
+
 func (v *View) LogHighlight() {
 	highlight := v.components.LogHighlight
 	v.Layout.MainPage.ResizeItem(v.Layout.Footer, 0, 1)
@@ -181,4 +310,7 @@ func (v *View) addToHistory(ns string, topic api.Topic, update func()) {
 
 func (v *View) viewSwitch() {
 	v.resetSearch()
+	v.components.Search.Props.ChangedFunc = nil
+	v.setLocation("", "")
+	v.setDetail(nil)
 }
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package view
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/hcjulz/damon/component"
+	"github.com/hcjulz/damon/models"
+)
+
+// Volumes lists the CSI volumes of the selected namespace.
+func (v *View) Volumes() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleVolumes)
+
+	v.Layout.Container.SetInputCapture(v.InputVolumes)
+	v.components.Commands.Update(component.VolumeCommands)
+
+	search := v.components.Search
+	table := v.components.VolumeTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterVolumes()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Volumes = text
+		update()
+	}
+
+	v.Watcher.SubscribeToVolumes(update)
+
+	v.components.Selections.Namespace.SetSelectedFunc(func(text string, index int) {
+		v.state.SelectedNamespace = text
+		v.Volumes()
+	})
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicVolume, v.Volumes)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterVolumes() []*models.Volume {
+	rx, _ := regexp.Compile(v.state.SelectedNamespace)
+	query := v.parseFilter(v.state.Filter.Volumes, volumeSchema)
+
+	result := []*models.Volume{}
+	for _, volume := range v.state.Volumes {
+		if !rx.MatchString(volume.Namespace) {
+			continue
+		}
+
+		if query == nil || query.Match(volumeFields(volume)) {
+			result = append(result, volume)
+		}
+	}
+
+	return result
+}
+
+// Plugins lists the CSI plugins with the
+// health of their controllers and nodes.
+func (v *View) Plugins() {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titlePlugins)
+
+	v.Layout.Container.SetInputCapture(v.InputPlugins)
+	v.components.Commands.Update(component.PluginCommands)
+
+	search := v.components.Search
+	table := v.components.PluginTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterPlugins()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Plugins = text
+		update()
+	}
+
+	v.Watcher.SubscribeToVolumes(update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicVolume, v.Plugins)
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+func (v *View) filterPlugins() []*models.CSIPlugin {
+	query := v.parseFilter(v.state.Filter.Plugins, pluginSchema)
+	if query == nil {
+		return v.state.CSIPlugins
+	}
+
+	result := []*models.CSIPlugin{}
+	for _, plugin := range v.state.CSIPlugins {
+		if query.Match(pluginFields(plugin)) {
+			result = append(result, plugin)
+		}
+	}
+
+	return result
+}
+
+// VolumeClaims lists the allocations which claim the CSI volume.
+func (v *View) VolumeClaims(namespace, id string) {
+	v.viewSwitch()
+	v.Layout.Body.SetTitle(titleVolumeClaims)
+
+	v.Layout.Container.SetInputCapture(v.InputVolumeClaims)
+	v.components.Commands.Update(v.restrict(component.VolumeClaimCommands, component.VolumeClaimCapabilities))
+
+	search := v.components.Search
+	table := v.components.VolumeClaimTable
+
+	v.state.Elements.TableMain = table.Table.Primitive().(*tview.Table)
+
+	update := func() {
+		table.Props.Data = v.filterVolumeClaims()
+		table.Render()
+		v.Draw()
+	}
+
+	search.Props.ChangedFunc = func(text string) {
+		v.state.Filter.Claims = text
+		update()
+	}
+
+	v.Watcher.SubscribeToVolume(namespace, id, update)
+
+	v.addToHistory(v.state.SelectedNamespace, models.TopicVolumeClaim, func() {
+		v.VolumeClaims(namespace, id)
+	})
+
+	v.Layout.Container.SetFocus(table.Table.Primitive())
+}
+
+// filterVolumeClaims returns a copy of the
+// volume with the claims matching the filter.
+func (v *View) filterVolumeClaims() *models.Volume {
+	volume := v.state.Volume
+	query := v.parseFilter(v.state.Filter.Claims, claimSchema)
+	if volume == nil || query == nil {
+		return volume
+	}
+
+	filtered := *volume
+	filtered.Claims = []*models.VolumeClaim{}
+	for _, claim := range volume.Claims {
+		if query.Match(claimFields(claim)) {
+			filtered.Claims = append(filtered.Claims, claim)
+		}
+	}
+
+	return &filtered
+}
+
+func (v *View) InputVolumes(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'p' {
+		v.Plugins()
+		return nil
+	}
+
+	return event
+}
+
+func (v *View) InputVolumeClaims(event *tcell.EventKey) *tcell.EventKey {
+	event = v.InputMainCommands(event)
+	if event == nil || event.Key() != tcell.KeyRune || v.Layout.Footer.HasFocus() {
+		return event
+	}
+
+	if event.Rune() == 'd' {
+		volume := v.components.VolumeClaimTable.Props.Data
+		claim := v.components.VolumeClaimTable.GetClaimForSelection()
+		if volume != nil && claim != nil {
+			v.detachVolume(volume, claim)
+		}
+		return nil
+	}
+
+	return event
+}
+
+// detachVolume asks for confirmation and detaches the volume
+// from the node of the claim, which releases the claims of all
+// allocations on the node.
+func (v *View) detachVolume(volume *models.Volume, claim *models.VolumeClaim) {
+	if !v.permitted(volume.Namespace, models.CapabilityCSIWriteVolume) {
+		return
+	}
+
+	v.components.Confirm.Props.Done = func(index int, text string) {
+		v.closeConfirmModal()
+
+		if index == 1 {
+			err := v.Client.DetachVolume(volume.Namespace, volume.ID, claim.NodeID)
+			v.err(v.explain(err, volume.Namespace, models.CapabilityCSIWriteVolume), "Failed to detach volume")
+		}
+	}
+
+	v.components.Confirm.Render(fmt.Sprintf(
+		"Do you really want to detach the volume %s from the node %s? This releases the claims of all allocations on the node.",
+		volume.ID,
+		claim.NodeName,
+	))
+	v.Layout.Container.SetFocus(v.components.Confirm.Modal.Primitive())
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// SubscribeToACL starts a goroutine to poll the ACL policies, roles
+// and tokens based on the provided interval. It updates the state
+// accordingly. The goroutine will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToACL(notify func()) {
+	w.updateACL()
+	w.Subscribe(notify, models.TopicACL)
+	w.Notify(models.TopicACL)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateACL()
+				w.Notify(models.TopicACL)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateACL() {
+	policies, err := w.nomad.ACLPolicies()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	roles, err := w.nomad.ACLRoles()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	tokens, err := w.nomad.ACLTokens()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.ACLPolicies = policies
+	w.state.ACLRoles = roles
+	w.state.ACLTokens = tokens
+}
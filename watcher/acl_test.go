@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToACL(t *testing.T) {
+	t.Run("It updates the policies, roles and tokens on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.ACLPoliciesReturnsOnCall(0, []*models.ACLPolicy{{Name: "read"}}, nil)
+		nomad.ACLPoliciesReturnsOnCall(1, []*models.ACLPolicy{{Name: "read"}, {Name: "write"}}, nil)
+		nomad.ACLRolesReturns([]*models.ACLRole{{Name: "ops"}}, nil)
+		nomad.ACLTokensReturns([]*models.ACLToken{{Name: "ci"}}, nil)
+
+		notified := make(chan []*models.ACLPolicy, 10)
+		watcher.SubscribeToACL(func() {
+			notified <- state.ACLPolicies
+		})
+
+		r.Equal([]*models.ACLPolicy{{Name: "read"}}, <-notified)
+		r.Equal([]*models.ACLPolicy{{Name: "read"}, {Name: "write"}}, <-notified)
+		r.Equal([]*models.ACLRole{{Name: "ops"}}, state.ACLRoles)
+		r.Equal([]*models.ACLToken{{Name: "ci"}}, state.ACLTokens)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.ACLTokensReturns(nil, errors.New("Permission denied"))
+
+		watcher.SubscribeToACL(func() {})
+
+		r.True(called)
+		r.Nil(state.ACLTokens)
+	})
+}
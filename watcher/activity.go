@@ -19,8 +19,10 @@ func (a *ActivityPool) DeactivateAll() {
 
 func (a *ActivityPool) deactivate() {
 	if a.hasActivities() {
-		ch := a.Activities[0]
-		ch <- struct{}{}
+		// closing doesn't block on activities which ended already,
+		// e.g. a log stream which was closed, and stops every reader
+		// of the channel, like the log stream of the Nomad API.
+		close(a.Activities[0])
 		a.Activities = a.Activities[1:]
 	}
 }
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// SubscribeToAllocDetail starts a goroutine which polls the details of
+// an allocation to update the state. The goroutine will be stopped
+// whenever a new subscription happens.
+func (w *Watcher) SubscribeToAllocDetail(allocID string, notify func()) {
+	// drop the details of the previous allocation, such
+	// that they aren't shown if the first poll fails
+	w.state.AllocDetail = nil
+	w.updateAllocDetail(allocID)
+	w.Subscribe(notify, models.TopicAllocDetail)
+	w.Notify(models.TopicAllocDetail)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateAllocDetail(allocID)
+				w.Notify(models.TopicAllocDetail)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateAllocDetail(allocID string) {
+	detail, err := w.nomad.AllocDetail(allocID)
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.AllocDetail = detail
+}
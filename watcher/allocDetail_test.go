@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToAllocDetail(t *testing.T) {
+	t.Run("It notifies the subscriber initially and on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.AllocDetailReturnsOnCall(0, &models.AllocDetail{ID: "moon"}, nil)
+		nomad.AllocDetailReturnsOnCall(1, &models.AllocDetail{ID: "moon", Status: "failed"}, nil)
+
+		notified := make(chan *models.AllocDetail, 10)
+		watcher.SubscribeToAllocDetail("moon", func() {
+			notified <- state.AllocDetail
+		})
+
+		r.Equal(&models.AllocDetail{ID: "moon"}, <-notified)
+		r.Equal(&models.AllocDetail{ID: "moon", Status: "failed"}, <-notified)
+		r.Equal("moon", nomad.AllocDetailArgsForCall(0))
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.AllocDetail = &models.AllocDetail{ID: "sun"}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.AllocDetailReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToAllocDetail("moon", func() {})
+
+		r.True(called)
+		r.Nil(state.AllocDetail)
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+)
+
+// SubscribeToCluster starts a goroutine to poll the servers, clients
+// and blocked evaluations of the cluster based on the provided interval.
+// It updates the state accordingly. The subscriber is also notified
+// about the jobs, allocations and deployments of the event stream.
+// The goroutine will be stopped whenever a new subscription happens.
+func (w *Watcher) SubscribeToCluster(notify func()) {
+	w.updateCluster()
+	w.Subscribe(notify, models.TopicCluster, api.TopicJob, api.TopicAllocation, api.TopicDeployment)
+	w.Notify(models.TopicCluster)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateCluster()
+				w.Notify(models.TopicCluster)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateCluster() {
+	cluster, err := w.nomad.Cluster()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Cluster = cluster
+}
+
+// SubscribeToServers starts a goroutine to poll the server members,
+// the Raft peers and the autopilot health based on the provided
+// interval. It updates the state accordingly. The goroutine will
+// be stopped whenever a new subscription happens.
+func (w *Watcher) SubscribeToServers(notify func()) {
+	w.updateServers()
+	w.Subscribe(notify, models.TopicServer)
+	w.Notify(models.TopicServer)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateServers()
+				w.Notify(models.TopicServer)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateServers() {
+	cluster, err := w.nomad.Servers()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Cluster = cluster
+}
+
+// SubscribeToNodes starts a goroutine to poll the clients of the
+// cluster based on the provided interval. It updates the state
+// accordingly. The goroutine will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToNodes(notify func()) {
+	w.updateNodes()
+	w.Subscribe(notify, models.TopicNode)
+	w.Notify(models.TopicNode)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateNodes()
+				w.Notify(models.TopicNode)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateNodes() {
+	nodes, err := w.nomad.Nodes()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Nodes = nodes
+}
+
+// SubscribeToNodeDetail starts a goroutine to poll the details and
+// the allocations of a client based on the provided interval. It
+// updates the state accordingly. The subscriber is also notified
+// about the usage of the allocations, if it is watched. The
+// goroutine will be stopped whenever a new subscription happens.
+func (w *Watcher) SubscribeToNodeDetail(nodeID string, notify func()) {
+	// drop the details of the previous client, such
+	// that they aren't shown if the first poll fails
+	if w.state.NodeDetail != nil && w.state.NodeDetail.ID != nodeID {
+		w.state.NodeDetail = nil
+	}
+
+	w.updateNodeDetail(nodeID)
+	w.Subscribe(notify, models.TopicNodeDetail, models.TopicAllocStats)
+	w.Notify(models.TopicNodeDetail)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateNodeDetail(nodeID)
+				w.Notify(models.TopicNodeDetail)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateNodeDetail(nodeID string) {
+	detail, err := w.nomad.NodeDetail(nodeID)
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.NodeDetail = detail
+}
+
+// SubscribeToNodePools starts a goroutine to poll the node pools and
+// the clients, which are counted per pool, based on the provided
+// interval. It updates the state accordingly. The goroutine will
+// be stopped whenever a new subscription happens.
+func (w *Watcher) SubscribeToNodePools(notify func()) {
+	w.updateNodePools()
+	w.Subscribe(notify, models.TopicNodePool)
+	w.Notify(models.TopicNodePool)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateNodePools()
+				w.Notify(models.TopicNodePool)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateNodePools() {
+	pools, err := w.nomad.NodePools()
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.NodePools = pools
+	w.updateNodes()
+}
+
+// SubscribeToEvaluations starts a goroutine to poll the evaluations
+// of all namespaces based on the provided interval. It updates the
+// state accordingly. The goroutine will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToEvaluations(notify func()) {
+	w.updateEvaluations()
+	w.Subscribe(notify, models.TopicEvaluation)
+	w.Notify(models.TopicEvaluation)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateEvaluations()
+				w.Notify(models.TopicEvaluation)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateEvaluations() {
+	evals, err := w.nomad.Evaluations(&nomad.SearchOptions{Namespace: "*"})
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Evaluations = evals
+}
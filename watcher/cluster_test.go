@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToCluster(t *testing.T) {
+	t.Run("It updates the cluster on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.ClusterReturnsOnCall(0, &models.Cluster{Leader: "10.0.0.1:4647"}, nil)
+		nomad.ClusterReturnsOnCall(1, &models.Cluster{Leader: "10.0.0.2:4647"}, nil)
+
+		notified := make(chan string, 10)
+		watcher.SubscribeToCluster(func() {
+			notified <- state.Cluster.Leader
+		})
+
+		r.Equal("10.0.0.1:4647", <-notified)
+		r.Equal("10.0.0.2:4647", <-notified)
+	})
+
+	t.Run("It notifies the subscriber about jobs, allocations and deployments", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Hour)
+		defer watcher.Unsubscribe()
+
+		nomad.ClusterReturns(&models.Cluster{}, nil)
+
+		var count int
+		watcher.SubscribeToCluster(func() {
+			count++
+		})
+
+		watcher.Notify(api.TopicJob)
+		watcher.Notify(api.TopicAllocation)
+		watcher.Notify(api.TopicDeployment)
+		watcher.Notify(models.TopicNode)
+
+		r.Equal(4, count)
+	})
+
+	t.Run("It notifies the error handler if the cluster can't be read", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.ClusterReturns(nil, errors.New("no cluster leader"))
+
+		watcher.SubscribeToCluster(func() {})
+
+		r.True(called)
+		r.Nil(state.Cluster)
+	})
+}
+
+func TestSubscribeToServers(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+	defer watcher.Unsubscribe()
+
+	nomad.ServersReturnsOnCall(0, &models.Cluster{Autopilot: &models.AutopilotHealth{Healthy: true}}, nil)
+	nomad.ServersReturnsOnCall(1, &models.Cluster{Autopilot: &models.AutopilotHealth{Healthy: false}}, nil)
+
+	notified := make(chan bool, 10)
+	watcher.SubscribeToServers(func() {
+		notified <- state.Cluster.Autopilot.Healthy
+	})
+
+	r.True(<-notified)
+	r.False(<-notified)
+	r.Equal(0, nomad.ClusterCallCount())
+}
+
+func TestSubscribeToNodes(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+	defer watcher.Unsubscribe()
+
+	nomad.NodesReturnsOnCall(0, []*models.Node{{Name: "client-1"}}, nil)
+	nomad.NodesReturnsOnCall(1, []*models.Node{{Name: "client-1"}, {Name: "client-2"}}, nil)
+
+	notified := make(chan []*models.Node, 10)
+	watcher.SubscribeToNodes(func() {
+		notified <- state.Nodes
+	})
+
+	r.Equal([]*models.Node{{Name: "client-1"}}, <-notified)
+	r.Equal([]*models.Node{{Name: "client-1"}, {Name: "client-2"}}, <-notified)
+}
+
+func TestSubscribeToNodeDetail(t *testing.T) {
+	t.Run("It updates the node detail on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.NodeDetailReturnsOnCall(0, &models.NodeDetail{Node: models.Node{ID: "1", Status: "ready"}}, nil)
+		nomad.NodeDetailReturnsOnCall(1, &models.NodeDetail{Node: models.Node{ID: "1", Status: "down"}}, nil)
+
+		notified := make(chan string, 10)
+		watcher.SubscribeToNodeDetail("1", func() {
+			notified <- state.NodeDetail.Status
+		})
+
+		r.Equal("ready", <-notified)
+		r.Equal("down", <-notified)
+		r.Equal("1", nomad.NodeDetailArgsForCall(0))
+	})
+
+	t.Run("It drops the detail of another node", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Hour)
+		defer watcher.Unsubscribe()
+
+		state.NodeDetail = &models.NodeDetail{Node: models.Node{ID: "1"}}
+		nomad.NodeDetailReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToNodeDetail("2", func() {})
+
+		r.Nil(state.NodeDetail)
+	})
+}
+
+func TestSubscribeToNodePools(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	watcher := watcher.NewWatcher(state, nomad, time.Hour)
+	defer watcher.Unsubscribe()
+
+	nomad.NodePoolsReturns([]*models.NodePool{{Name: "default"}}, nil)
+	nomad.NodesReturns([]*models.Node{{Name: "client-1", NodePool: "default"}}, nil)
+
+	var called bool
+	watcher.SubscribeToNodePools(func() {
+		called = true
+	})
+
+	r.True(called)
+	r.Equal([]*models.NodePool{{Name: "default"}}, state.NodePools)
+	r.Equal([]*models.Node{{Name: "client-1", NodePool: "default"}}, state.Nodes)
+}
+
+func TestSubscribeToEvaluations(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+	defer watcher.Unsubscribe()
+
+	nomad.EvaluationsReturns([]*models.Evaluation{{ID: "e1"}}, nil)
+
+	notified := make(chan []*models.Evaluation, 10)
+	watcher.SubscribeToEvaluations(func() {
+		notified <- state.Evaluations
+	})
+
+	r.Equal([]*models.Evaluation{{ID: "e1"}}, <-notified)
+	r.Equal("*", nomad.EvaluationsArgsForCall(0).Namespace)
+}
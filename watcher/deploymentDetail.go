@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// SubscribeToDeploymentDetail starts a goroutine which polls a deployment
+// with the state of its task groups to update the state. The goroutine
+// will be stopped whenever a new subscription happens.
+func (w *Watcher) SubscribeToDeploymentDetail(namespace, deploymentID string, notify func()) {
+	// drop the previous deployment, such that it
+	// isn't shown if the first poll fails
+	w.state.DeploymentDetail = nil
+	w.updateDeploymentDetail(namespace, deploymentID)
+	w.Subscribe(notify, models.TopicDeploymentDetail)
+	w.Notify(models.TopicDeploymentDetail)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateDeploymentDetail(namespace, deploymentID)
+				w.Notify(models.TopicDeploymentDetail)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateDeploymentDetail(namespace, deploymentID string) {
+	detail, err := w.nomad.DeploymentDetail(namespace, deploymentID)
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.DeploymentDetail = detail
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToDeploymentDetail(t *testing.T) {
+	t.Run("It notifies the subscriber initially and on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.DeploymentDetailReturnsOnCall(0, &models.DeploymentDetail{ID: "moon"}, nil)
+		nomad.DeploymentDetailReturnsOnCall(1, &models.DeploymentDetail{ID: "moon", Status: "successful"}, nil)
+
+		notified := make(chan *models.DeploymentDetail, 10)
+		watcher.SubscribeToDeploymentDetail("space", "moon", func() {
+			notified <- state.DeploymentDetail
+		})
+
+		r.Equal(&models.DeploymentDetail{ID: "moon"}, <-notified)
+		r.Equal(&models.DeploymentDetail{ID: "moon", Status: "successful"}, <-notified)
+
+		namespace, id := nomad.DeploymentDetailArgsForCall(0)
+		r.Equal("space", namespace)
+		r.Equal("moon", id)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.DeploymentDetail = &models.DeploymentDetail{ID: "sun"}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.DeploymentDetailReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToDeploymentDetail("space", "moon", func() {})
+
+		r.True(called)
+		r.Nil(state.DeploymentDetail)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
 )
 
 // SubscribeToJobStatus starts a goroutine to polls JobStatus every two
@@ -36,6 +37,53 @@ func (w *Watcher) SubscribeToJobStatus(jobID string, notify func()) error {
 	return nil
 }
 
+// FollowJobStatus polls the JobStatus of a job in the background without
+// replacing the current subscriber. This keeps a detail panel up to date
+// next to the main view. Only one job can be followed at a time; following
+// another job or subscribing to a new topic stops the previous follower.
+func (w *Watcher) FollowJobStatus(jobID string, notify func()) {
+	w.StopFollowing()
+
+	stop := make(chan struct{})
+	w.follower = stop
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			js, err := w.nomad.JobStatus(jobID, nil)
+
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			if err != nil {
+				w.NotifyHandler(models.HandleError, err.Error())
+			} else {
+				w.state.JobStatus = js
+				notify()
+			}
+
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// StopFollowing stops the current follower, if any.
+func (w *Watcher) StopFollowing() {
+	if w.follower != nil {
+		close(w.follower)
+		w.follower = nil
+	}
+}
+
 func (w *Watcher) updateJobStatus(jobID string) {
 	js, err := w.nomad.JobStatus(jobID, nil)
 	if err != nil {
@@ -44,3 +92,42 @@ func (w *Watcher) updateJobStatus(jobID string) {
 
 	w.state.JobStatus = js
 }
+
+// SubscribeToJobVersions starts a goroutine which polls the versions
+// of a job to update the state. The goroutine will be stopped whenever
+// a new subscription happens.
+func (w *Watcher) SubscribeToJobVersions(namespace, jobID string, notify func()) {
+	// drop the versions of the previous job, such
+	// that they aren't shown if the first poll fails
+	w.state.JobVersions = nil
+	w.updateJobVersions(namespace, jobID)
+	w.Subscribe(notify, models.TopicJobVersion)
+	w.Notify(models.TopicJobVersion)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateJobVersions(namespace, jobID)
+				w.Notify(models.TopicJobVersion)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateJobVersions(namespace, jobID string) {
+	versions, err := w.nomad.JobVersions(jobID, &nomad.SearchOptions{Namespace: namespace})
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.JobVersions = versions
+}
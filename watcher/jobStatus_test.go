@@ -121,3 +121,122 @@ func TestSubscribeToJobStatus_Sad(t *testing.T) {
 
 	r.True(called)
 }
+
+func TestFollowJobStatus(t *testing.T) {
+	r := require.New(t)
+
+	t.Run("It keeps notifying until following stops", func(t *testing.T) {
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+
+		nomad.JobStatusReturns(&models.JobStatus{Name: "foo"}, nil)
+
+		notified := make(chan struct{}, 10)
+		watcher.FollowJobStatus("myJob", func() {
+			notified <- struct{}{}
+		})
+
+		<-notified
+		<-notified
+
+		r.Equal(&models.JobStatus{Name: "foo"}, state.JobStatus)
+		r.Equal("myJob", func() string {
+			jobID, _ := nomad.JobStatusArgsForCall(0)
+			return jobID
+		}())
+
+		watcher.StopFollowing()
+		callCount := nomad.JobStatusCallCount()
+
+		time.Sleep(time.Millisecond * 200)
+		r.LessOrEqual(nomad.JobStatusCallCount(), callCount+1)
+	})
+
+	t.Run("A new subscription stops following", func(t *testing.T) {
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+
+		nomad.JobStatusReturns(&models.JobStatus{Name: "foo"}, nil)
+
+		notified := make(chan struct{}, 10)
+		watcher.FollowJobStatus("myJob", func() {
+			notified <- struct{}{}
+		})
+
+		<-notified
+
+		watcher.Subscribe(func() {}, models.TopicNamespace)
+		callCount := nomad.JobStatusCallCount()
+
+		time.Sleep(time.Millisecond * 200)
+		r.LessOrEqual(nomad.JobStatusCallCount(), callCount+1)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.StopFollowing()
+
+		nomad.JobStatusReturns(nil, errors.New("argh"))
+
+		errs := make(chan string, 10)
+		watcher.SubscribeHandler(models.HandleError, func(msg string, args ...interface{}) {
+			errs <- msg
+		})
+
+		watcher.FollowJobStatus("myJob", func() {})
+
+		r.Equal("argh", <-errs)
+	})
+}
+
+func TestSubscribeToJobVersions(t *testing.T) {
+	t.Run("It notifies the subscriber initially and on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.JobVersionsReturnsOnCall(0, []*models.JobVersion{{Version: 0}}, nil)
+		nomad.JobVersionsReturnsOnCall(1, []*models.JobVersion{{Version: 1}, {Version: 0}}, nil)
+
+		notified := make(chan []*models.JobVersion, 10)
+		watcher.SubscribeToJobVersions("space", "rocket", func() {
+			notified <- state.JobVersions
+		})
+
+		r.Equal([]*models.JobVersion{{Version: 0}}, <-notified)
+		r.Equal([]*models.JobVersion{{Version: 1}, {Version: 0}}, <-notified)
+
+		jobID, so := nomad.JobVersionsArgsForCall(0)
+		r.Equal("rocket", jobID)
+		r.Equal("space", so.Namespace)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.JobVersions = []*models.JobVersion{{Version: 7}}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.JobVersionsReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToJobVersions("space", "rocket", func() {})
+
+		r.True(called)
+		r.Nil(state.JobVersions)
+	})
+}
@@ -3,14 +3,20 @@
 
 package watcher
 
-import "github.com/hcjulz/damon/models"
+import (
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/logbuffer"
+	"github.com/hcjulz/damon/models"
+)
 
 // SubscribeToLogs starts an event stream for Logs
-// which updates the state whenever a new log is written.
+// which appends complete lines to the log buffer whenever
+// a new log is written.
 // The stream will be stopped whenever a new subscription happens.
 func (w *Watcher) SubscribeToLogs(allocID, taskName, source string, notify func()) {
 	// wipe any previous logs
-	w.state.Logs = nil
+	w.state.LogBuffer.Reset()
 	w.logResumer = &logResumer{
 		allocID:  allocID,
 		taskName: taskName,
@@ -33,28 +39,102 @@ func (w *Watcher) SubscribeToLogs(allocID, taskName, source string, notify func(
 	w.Notify(models.TopicLog)
 
 	cancel := make(chan struct{})
-	streamCh, errorCh := w.nomad.Logs(allocID, taskName, source, cancel)
+	streamCh, errorCh := w.nomad.Logs(allocID, taskName, source, w.state.LogStart, cancel)
 
 	w.activities.Add(cancel)
 
-	go func() {
-		for {
-			select {
-			case frame := <-streamCh:
-				if frame.Data != nil {
-					w.state.Logs = frame.Data
-					w.Notify(models.TopicLog)
-				}
-			case err := <-errorCh:
-				w.NotifyHandler(models.HandleError, err.Error())
-			case <-cancel:
+	// the alloc ID is left out, such that lines of a
+	// single stream are rendered without a prefix.
+	go w.appendLines(streamCh, errorCh, cancel, func(text string) *models.LogLine {
+		return &models.LogLine{
+			Task:   taskName,
+			Source: source,
+			Text:   text,
+		}
+	})
+}
+
+// SubscribeToFile follows a file in the filesystem of an allocation
+// and appends complete lines to the log buffer, like SubscribeToLogs
+// does for logs. The stream will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToFile(allocID, path string, notify func()) {
+	// wipe any previous logs
+	w.state.LogBuffer.Reset()
+	w.logResumer = &logResumer{
+		allocID: allocID,
+		path:    path,
+		notify:  notify,
+	}
+
+	w.Subscribe(notify, models.TopicLog)
+	w.Notify(models.TopicLog)
+
+	cancel := make(chan struct{})
+	streamCh, errorCh := w.nomad.StreamFile(allocID, path, w.state.LogStart, cancel)
+
+	w.activities.Add(cancel)
+
+	go w.appendLines(streamCh, errorCh, cancel, func(text string) *models.LogLine {
+		return &models.LogLine{Text: text}
+	})
+}
+
+// appendLines splits the frames of the stream into lines and appends
+// them to the log buffer until the stream ends or is cancelled.
+func (w *Watcher) appendLines(
+	streamCh <-chan *api.StreamFrame,
+	errorCh <-chan error,
+	cancel chan struct{},
+	line func(text string) *models.LogLine,
+) {
+	splitter := logbuffer.Splitter{}
+	for {
+		select {
+		case frame, ok := <-streamCh:
+			// the stream is closed when it ends or fails
+			if !ok {
 				return
 			}
+
+			if frame == nil || frame.Data == nil {
+				continue
+			}
+
+			texts := splitter.Split(frame.Data)
+			if len(texts) == 0 {
+				continue
+			}
+
+			for _, text := range texts {
+				w.state.LogBuffer.Append(line(text))
+			}
+
+			w.Notify(models.TopicLog)
+		case err, ok := <-errorCh:
+			if ok && err != nil {
+				w.NotifyHandler(models.HandleError, err.Error())
+			}
+
+			// the stream reports one error at most
+			errorCh = nil
+		case <-cancel:
+			return
 		}
-	}()
+	}
 }
 
 func (w *Watcher) ResumeLogs() {
+	if w.logResumer.scope != nil {
+		w.SubscribeToMergedLogs(*w.logResumer.scope, w.logResumer.notify)
+		return
+	}
+
+	if w.logResumer.path != "" {
+		w.SubscribeToFile(w.logResumer.allocID, w.logResumer.path, w.logResumer.notify)
+		return
+	}
+
 	w.SubscribeToLogs(w.logResumer.allocID, w.logResumer.taskName, w.logResumer.source, w.logResumer.notify)
 }
 
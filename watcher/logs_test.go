@@ -6,6 +6,9 @@ package watcher_test
 import (
 	"errors"
 	"fmt"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -38,7 +41,7 @@ func TestSubscribeToLogs_Happy(t *testing.T) {
 			TaskNames: []string{"another-task"},
 		},
 	}
-	state.Logs = []byte("an initial log line that should be wiped")
+	state.LogBuffer.Append(&models.LogLine{Text: "an initial log line that should be wiped"})
 
 	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*250)
 
@@ -57,14 +60,15 @@ func TestSubscribeToLogs_Happy(t *testing.T) {
 
 	watcher.SubscribeToLogs("the-alloc", "the-task", "stderr", notify)
 
-	actualAllocID, taskName, actualSource, _ := nomad.LogsArgsForCall(0)
+	actualAllocID, taskName, actualSource, start, _ := nomad.LogsArgsForCall(0)
 
 	// Check that the initial call happened for the right task and allocation.
 	r.Equal("the-alloc", actualAllocID)
 	r.Equal("the-task", taskName)
 	r.Equal("stderr", actualSource)
+	r.Nil(start)
 	r.Equal(callCount, 1)
-	r.Nil(state.Logs)
+	r.Equal(0, state.LogBuffer.Len())
 
 	// Next the goroutine should notify the subscriber whenever a new logs is available.
 	streamChan <- &api.StreamFrame{
@@ -75,18 +79,27 @@ func TestSubscribeToLogs_Happy(t *testing.T) {
 		return callCount == 2
 	}, time.Second*5, time.Microsecond*5)
 
-	r.Equal(state.Logs, []byte("a new log line\n"))
+	lines, _ := state.LogBuffer.Lines()
+	r.Len(lines, 1)
+	r.Equal(&models.LogLine{Task: "the-task", Source: "stderr", Text: "a new log line"}, lines[0])
 
-	// further log lines should be appended
+	// further log lines should be appended, partial
+	// lines are kept until they are complete.
 	streamChan <- &api.StreamFrame{
-		Data: []byte("another log line\n"),
+		Data: []byte("another log "),
+	}
+	streamChan <- &api.StreamFrame{
+		Data: []byte("line\n"),
 	}
 
 	r.Eventually(func() bool {
 		return callCount == 3
 	}, time.Second*5, time.Microsecond*5)
 
-	r.Equal(state.Logs, []byte("another log line\n"))
+	lines, _ = state.LogBuffer.Lines()
+	r.Len(lines, 2)
+	r.Equal("a new log line", lines[0].Text)
+	r.Equal("another log line", lines[1].Text)
 }
 
 func TestSubscribeToLogs_Sad(t *testing.T) {
@@ -168,3 +181,94 @@ func TestSubscribeToLogs_Sad(t *testing.T) {
 		}, time.Second*5, time.Microsecond*5)
 	})
 }
+
+func TestSubscribeToFile(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	state.LogBuffer.Append(&models.LogLine{Text: "a log line that should be wiped"})
+
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*250)
+	defer watcher.Subscribe(func() {}, api.TopicJob)
+
+	streamChan := make(chan *api.StreamFrame)
+	nomad.StreamFileReturns(streamChan, make(chan error))
+
+	var mutex sync.Mutex
+	var callCount int
+	notify := func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		callCount++
+	}
+
+	watcher.SubscribeToFile("the-alloc", "/alloc/data/app.log", notify)
+
+	allocID, path, start, _ := nomad.StreamFileArgsForCall(0)
+	r.Equal("the-alloc", allocID)
+	r.Equal("/alloc/data/app.log", path)
+	r.Nil(start)
+	r.Equal(0, state.LogBuffer.Len())
+
+	streamChan <- &api.StreamFrame{Data: []byte("first\nsecond\n")}
+
+	r.Eventually(func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return callCount == 2
+	}, time.Second*5, time.Microsecond*5)
+
+	lines, _ := state.LogBuffer.Lines()
+	r.Equal([]*models.LogLine{{Text: "first"}, {Text: "second"}}, lines)
+
+	// It resumes the file stream
+	watcher.ResumeLogs()
+	r.Equal(2, nomad.StreamFileCallCount())
+	r.Equal(0, nomad.LogsCallCount())
+}
+
+func TestSubscribeToFile_StreamEnds(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*250)
+	defer watcher.Subscribe(func() {}, api.TopicJob)
+
+	streamChan := make(chan *api.StreamFrame)
+	errChan := make(chan error, 1)
+	nomad.StreamFileReturns(streamChan, errChan)
+
+	var mutex sync.Mutex
+	var errCount int
+	watcher.SubscribeHandler(models.HandleError, func(string, ...interface{}) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		errCount++
+	})
+
+	watcher.SubscribeToFile("the-alloc", "/alloc/data/app.log", func() {})
+
+	// It reports the error of the stream once
+	errChan <- errors.New("argh")
+	r.Eventually(func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return errCount == 1
+	}, time.Second*5, time.Microsecond*5)
+
+	// It stops reading when the stream is closed
+	close(errChan)
+	close(streamChan)
+	r.Eventually(func() bool {
+		buf := make([]byte, 1<<20)
+		n := runtime.Stack(buf, true)
+		return !strings.Contains(string(buf[:n]), "(*Watcher).appendLines")
+	}, time.Second*5, time.Millisecond*5)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	r.Equal(1, errCount)
+}
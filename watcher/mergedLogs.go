@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+
+	"github.com/hcjulz/damon/logbuffer"
+	"github.com/hcjulz/damon/models"
+)
+
+var logSources = []string{"stdout", "stderr"}
+
+// maxLogRetryDelay is the longest a failed stream
+// waits before it is started again.
+const maxLogRetryDelay = time.Minute
+
+type logStream struct {
+	allocID, task, source string
+}
+
+func (s logStream) key() string {
+	return fmt.Sprintf("%s/%s/%s", s.allocID, s.task, s.source)
+}
+
+// logFailure reports a stream which failed and
+// whether it delivered lines before it failed.
+type logFailure struct {
+	key      string
+	received bool
+}
+
+// logRetry tracks when a failed stream is started again.
+// The delay doubles with every failure in a row.
+type logRetry struct {
+	at       time.Time
+	failures int
+}
+
+func (r *logRetry) fail(interval time.Duration) {
+	r.failures++
+
+	delay := interval << (r.failures - 1)
+	if r.failures > 8 || delay > maxLogRetryDelay {
+		delay = maxLogRetryDelay
+	}
+
+	r.at = time.Now().Add(delay)
+}
+
+// SubscribeToMergedLogs tails stdout and stderr of every task in the
+// scope at the same time. Complete lines are appended to the log
+// buffer. Streams are added and removed whenever allocations in the
+// scope come and go, streams which failed are started again with
+// a growing delay. The streams will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToMergedLogs(scope models.LogScope, notify func()) {
+	// wipe any previous logs
+	w.state.LogBuffer.Reset()
+	w.logResumer = &logResumer{
+		scope:  &scope,
+		notify: notify,
+	}
+
+	w.Subscribe(notify, models.TopicLog)
+	w.Notify(models.TopicLog)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	lines := make(chan []*models.LogLine)
+	failed := make(chan logFailure)
+	streams := map[string]chan struct{}{}
+	retries := map[string]*logRetry{}
+
+	reconcile := func() {
+		wanted := map[string]bool{}
+		for _, s := range w.logStreams(scope) {
+			key := s.key()
+			wanted[key] = true
+
+			if _, ok := streams[key]; ok {
+				continue
+			}
+
+			if retry, ok := retries[key]; ok && time.Now().Before(retry.at) {
+				continue
+			}
+
+			cancel := make(chan struct{})
+			streams[key] = cancel
+
+			streamCh, errorCh := w.nomad.Logs(s.allocID, s.task, s.source, w.state.LogStart, cancel)
+			go tailLog(s, streamCh, errorCh, cancel, lines, failed)
+		}
+
+		for key, cancel := range streams {
+			if !wanted[key] {
+				close(cancel)
+				delete(streams, key)
+			}
+		}
+
+		for key := range retries {
+			if !wanted[key] {
+				delete(retries, key)
+			}
+		}
+	}
+
+	reconcile()
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case l := <-lines:
+				w.state.LogBuffer.Append(l...)
+				w.Notify(models.TopicLog)
+			case f := <-failed:
+				if cancel, ok := streams[f.key]; ok {
+					close(cancel)
+					delete(streams, f.key)
+				}
+
+				retry, ok := retries[f.key]
+				if !ok || f.received {
+					retry = &logRetry{}
+					retries[f.key] = retry
+				}
+
+				retry.fail(w.interval)
+			case <-ticker.C:
+				reconcile()
+			case <-stop:
+				ticker.Stop()
+				for _, cancel := range streams {
+					close(cancel)
+				}
+				return
+			}
+		}
+	}()
+}
+
+// logStreams returns the streams of all tasks in the scope. All
+// allocations of a job or task group which are running are part
+// of the scope, while a single allocation is always part of it.
+func (w *Watcher) logStreams(scope models.LogScope) []logStream {
+	streams := []logStream{}
+	for _, alloc := range w.state.AllocationsSnapshot() {
+		switch {
+		case scope.AllocID != "":
+			if alloc.ID != scope.AllocID {
+				continue
+			}
+		case alloc.JobID != scope.JobID,
+			scope.TaskGroup != "" && alloc.TaskGroup != scope.TaskGroup,
+			alloc.Status != models.StatusRunning:
+			continue
+		}
+
+		tasks := append([]string{}, alloc.TaskNames...)
+		sort.Strings(tasks)
+
+		for _, task := range tasks {
+			for _, source := range logSources {
+				streams = append(streams, logStream{
+					allocID: alloc.ID,
+					task:    task,
+					source:  source,
+				})
+			}
+		}
+	}
+
+	return streams
+}
+
+// tailLog reads the log stream of a single task and source. Frames
+// are split into lines and partial lines are kept until they are
+// complete. If the stream fails, it is reported on failed.
+func tailLog(
+	s logStream,
+	streamCh <-chan *api.StreamFrame,
+	errorCh <-chan error,
+	cancel chan struct{},
+	lines chan<- []*models.LogLine,
+	failed chan<- logFailure,
+) {
+	send := func(l []*models.LogLine) bool {
+		select {
+		case lines <- l:
+			return true
+		case <-cancel:
+			return false
+		}
+	}
+
+	var received bool
+	splitter := logbuffer.Splitter{}
+	for {
+		select {
+		case frame, ok := <-streamCh:
+			if !ok {
+				return
+			}
+
+			if frame == nil || frame.Data == nil {
+				continue
+			}
+
+			texts := splitter.Split(frame.Data)
+			if len(texts) == 0 {
+				continue
+			}
+
+			result := []*models.LogLine{}
+			for _, text := range texts {
+				result = append(result, &models.LogLine{
+					AllocID: s.allocID,
+					Task:    s.task,
+					Source:  s.source,
+					Text:    text,
+				})
+			}
+
+			if !send(result) {
+				return
+			}
+
+			received = true
+
+		case err, ok := <-errorCh:
+			if !ok {
+				errorCh = nil
+				continue
+			}
+
+			if err == nil {
+				continue
+			}
+
+			// report the error inline instead of interrupting
+			// the other streams with an error modal.
+			if !send([]*models.LogLine{{
+				AllocID: s.allocID,
+				Task:    s.task,
+				Source:  s.source,
+				Text:    fmt.Sprintf("log stream failed: %s", err),
+			}}) {
+				return
+			}
+
+			select {
+			case failed <- logFailure{key: s.key(), received: received}:
+			case <-cancel:
+			}
+
+			return
+
+		case <-cancel:
+			return
+		}
+	}
+}
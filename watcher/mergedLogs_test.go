@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+type fakeLogStream struct {
+	frames chan *api.StreamFrame
+	errors chan error
+	cancel <-chan struct{}
+}
+
+func TestSubscribeToMergedLogs(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	st := state.New()
+	st.Allocations = []*models.Alloc{
+		{ID: "alloc-1", JobID: "web", TaskGroup: "app", Status: models.StatusRunning, TaskNames: []string{"server"}},
+		{ID: "alloc-2", JobID: "web", TaskGroup: "app", Status: models.StatusRunning, TaskNames: []string{"server"}},
+		{ID: "alloc-3", JobID: "web", TaskGroup: "app", Status: models.StatusDead, TaskNames: []string{"server"}},
+		{ID: "alloc-4", JobID: "api", TaskGroup: "app", Status: models.StatusRunning, TaskNames: []string{"server"}},
+	}
+
+	var mutex sync.Mutex
+	streams := map[string]*fakeLogStream{}
+	nomad.LogsStub = func(allocID, task, source string, start *models.LogStart, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		s := &fakeLogStream{
+			frames: make(chan *api.StreamFrame),
+			errors: make(chan error),
+			cancel: cancel,
+		}
+		streams[fmt.Sprintf("%s/%s/%s", allocID, task, source)] = s
+
+		return s.frames, s.errors
+	}
+
+	stream := func(key string) *fakeLogStream {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return streams[key]
+	}
+
+	w := watcher.NewWatcher(st, nomad, time.Millisecond*10)
+
+	// A new subscription stops all streams
+	defer w.Subscribe(func() {}, api.TopicJob)
+
+	var mutexLines sync.Mutex
+	lines := []*models.LogLine{}
+	w.SubscribeToMergedLogs(models.LogScope{JobID: "web"}, func() {
+		mutexLines.Lock()
+		defer mutexLines.Unlock()
+		lines, _ = st.LogBuffer.Lines()
+	})
+
+	// It streams stdout and stderr of the running allocations of the job
+	r.Equal(4, nomad.LogsCallCount())
+	r.NotNil(stream("alloc-1/server/stdout"))
+	r.NotNil(stream("alloc-1/server/stderr"))
+	r.NotNil(stream("alloc-2/server/stdout"))
+	r.NotNil(stream("alloc-2/server/stderr"))
+
+	waitForLines := func(n int) {
+		r.Eventually(func() bool {
+			mutexLines.Lock()
+			defer mutexLines.Unlock()
+			return len(lines) == n
+		}, time.Second*5, time.Millisecond)
+	}
+
+	// It keeps partial lines until they are complete
+	stream("alloc-1/server/stdout").frames <- &api.StreamFrame{Data: []byte("first line\nsecond ")}
+	waitForLines(1)
+	stream("alloc-2/server/stderr").frames <- &api.StreamFrame{Data: []byte("failure\n")}
+	waitForLines(2)
+	stream("alloc-1/server/stdout").frames <- &api.StreamFrame{Data: []byte("line\n")}
+	waitForLines(3)
+
+	r.Equal(&models.LogLine{AllocID: "alloc-1", Task: "server", Source: "stdout", Text: "first line"}, lines[0])
+	r.Equal(&models.LogLine{AllocID: "alloc-2", Task: "server", Source: "stderr", Text: "failure"}, lines[1])
+	r.Equal(&models.LogLine{AllocID: "alloc-1", Task: "server", Source: "stdout", Text: "second line"}, lines[2])
+
+	// It reports stream errors inline
+	stream("alloc-2/server/stdout").errors <- errors.New("task not running")
+	waitForLines(4)
+
+	r.Equal("log stream failed: task not running", lines[3].Text)
+
+	// It starts failed streams again
+	failed := stream("alloc-2/server/stdout")
+	r.Eventually(func() bool {
+		return stream("alloc-2/server/stdout") != failed
+	}, time.Second*5, time.Millisecond)
+
+	select {
+	case <-failed.cancel:
+	default:
+		r.Fail("the failed stream wasn't cancelled")
+	}
+
+	// It stops the streams of allocations which are gone
+	removed := stream("alloc-1/server/stdout").cancel
+	st.SetAllocations(st.AllocationsSnapshot()[1:])
+
+	r.Eventually(func() bool {
+		select {
+		case <-removed:
+			return true
+		default:
+			return false
+		}
+	}, time.Second*5, time.Millisecond)
+
+	// It starts streams for new allocations
+	st.SetAllocations(append(st.AllocationsSnapshot(), &models.Alloc{
+		ID: "alloc-5", JobID: "web", TaskGroup: "app", Status: models.StatusRunning, TaskNames: []string{"server"},
+	}))
+
+	r.Eventually(func() bool {
+		return stream("alloc-5/server/stdout") != nil
+	}, time.Second*5, time.Millisecond)
+}
+
+func TestSubscribeToMergedLogs_Retry(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	st := state.New()
+	st.SetAllocations([]*models.Alloc{
+		{ID: "alloc-1", JobID: "web", TaskGroup: "app", Status: models.StatusRunning, TaskNames: []string{"server"}},
+	})
+
+	// every stream fails right away
+	nomad.LogsStub = func(allocID, task, source string, start *models.LogStart, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
+		errorCh := make(chan error, 1)
+		errorCh <- errors.New("connection reset")
+		return make(chan *api.StreamFrame), errorCh
+	}
+
+	w := watcher.NewWatcher(st, nomad, time.Millisecond*10)
+	defer w.Subscribe(func() {}, api.TopicJob)
+
+	w.SubscribeToMergedLogs(models.LogScope{JobID: "web"}, func() {})
+
+	// It retries with a growing delay instead of on every reconcile
+	r.Eventually(func() bool {
+		return nomad.LogsCallCount() >= 4
+	}, time.Second*5, time.Millisecond)
+
+	time.Sleep(time.Millisecond * 200)
+	r.LessOrEqual(nomad.LogsCallCount(), 10)
+}
+
+func TestSubscribeToMergedLogs_Scope(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	nomad.LogsReturns(make(chan *api.StreamFrame), make(chan error))
+
+	st := state.New()
+	st.Allocations = []*models.Alloc{
+		{ID: "alloc-1", JobID: "web", TaskGroup: "app", Status: models.StatusDead, TaskNames: []string{"server", "sidecar"}},
+		{ID: "alloc-2", JobID: "web", TaskGroup: "cache", Status: models.StatusRunning, TaskNames: []string{"redis"}},
+	}
+
+	st.LogStart = &models.LogStart{Origin: models.LogOriginStart}
+
+	w := watcher.NewWatcher(st, nomad, time.Hour)
+	defer w.Subscribe(func() {}, api.TopicJob)
+
+	// A single allocation is tailed even if it isn't running
+	w.SubscribeToMergedLogs(models.LogScope{AllocID: "alloc-1"}, func() {})
+	r.Equal(4, nomad.LogsCallCount())
+
+	allocID, task, source, start, _ := nomad.LogsArgsForCall(0)
+	r.Equal("alloc-1", allocID)
+	r.Equal("server", task)
+	r.Equal("stdout", source)
+
+	// Streams start where the state says
+	r.Equal(st.LogStart, start)
+
+	// A task group only includes its own allocations
+	w.SubscribeToMergedLogs(models.LogScope{JobID: "web", TaskGroup: "cache"}, func() {})
+	r.Equal(6, nomad.LogsCallCount())
+
+	allocID, task, _, _, _ = nomad.LogsArgsForCall(4)
+	r.Equal("alloc-2", allocID)
+	r.Equal("redis", task)
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+)
+
+// SubscribeToServices starts a goroutine to poll the services of
+// all namespaces based on the provided interval. It updates the
+// state accordingly. The goroutine will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToServices(notify func()) {
+	w.updateServices()
+	w.Subscribe(notify, models.TopicService)
+	w.Notify(models.TopicService)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateServices()
+				w.Notify(models.TopicService)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateServices() {
+	services, err := w.nomad.Services(&nomad.SearchOptions{Namespace: "*"})
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Services = services
+}
+
+// SubscribeToServiceInstances polls the instances of a service with
+// their health checks in the background. Reading the checks can take
+// a while if clients are unreachable, so unlike other subscriptions
+// the first poll doesn't happen before returning. The subscriber is
+// notified after every poll. Polling stops whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToServiceInstances(namespace, name string, notify func()) {
+	// drop the instances of the previous service, such
+	// that they aren't shown until the first poll is done
+	w.state.ServiceInstances = nil
+	w.Subscribe(notify, models.TopicServiceInstance)
+	w.Notify(models.TopicServiceInstance)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	// Deactivating waits until the stop signal is received,
+	// which shouldn't be held up by the requests in flight.
+	done := make(chan struct{})
+	go func() {
+		<-stop
+		close(done)
+	}()
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			instances, err := w.nomad.ServiceInstances(namespace, name)
+
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			if err != nil {
+				w.NotifyHandler(models.HandleError, err.Error())
+			} else {
+				w.state.ServiceInstances = instances
+				w.Notify(models.TopicServiceInstance)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToServices(t *testing.T) {
+	t.Run("It notifies the subscriber initially and on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.ServicesReturnsOnCall(0, []*models.Service{{Name: "web"}}, nil)
+		nomad.ServicesReturnsOnCall(1, []*models.Service{{Name: "web"}, {Name: "db"}}, nil)
+
+		notified := make(chan []*models.Service, 10)
+		watcher.SubscribeToServices(func() {
+			notified <- state.Services
+		})
+
+		r.Equal([]*models.Service{{Name: "web"}}, <-notified)
+		r.Equal([]*models.Service{{Name: "web"}, {Name: "db"}}, <-notified)
+		r.Equal("*", nomad.ServicesArgsForCall(0).Namespace)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.ServicesReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToServices(func() {})
+
+		r.True(called)
+	})
+}
+
+func TestSubscribeToServiceInstances(t *testing.T) {
+	t.Run("It notifies the subscriber before and after every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.ServiceInstances = []*models.ServiceInstance{{ServiceName: "db"}}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.ServiceInstancesReturnsOnCall(0, []*models.ServiceInstance{{ServiceName: "web"}}, nil)
+		nomad.ServiceInstancesReturnsOnCall(1, []*models.ServiceInstance{{ServiceName: "web", Port: 80}}, nil)
+
+		notified := make(chan []*models.ServiceInstance, 10)
+		watcher.SubscribeToServiceInstances("default", "web", func() {
+			notified <- state.ServiceInstances
+		})
+
+		// the instances of the previous service are dropped
+		r.Nil(<-notified)
+		r.Equal([]*models.ServiceInstance{{ServiceName: "web"}}, <-notified)
+		r.Equal([]*models.ServiceInstance{{ServiceName: "web", Port: 80}}, <-notified)
+
+		namespace, name := nomad.ServiceInstancesArgsForCall(0)
+		r.Equal("default", namespace)
+		r.Equal("web", name)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		called := make(chan struct{}, 10)
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called <- struct{}{}
+		})
+
+		nomad.ServiceInstancesReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToServiceInstances("default", "web", func() {})
+
+		select {
+		case <-called:
+		case <-time.After(time.Second):
+			r.Fail("the error handler wasn't called")
+		}
+	})
+
+	t.Run("It stops polling when unsubscribed", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+
+		// a poll which is still in flight doesn't hold up unsubscribing
+		release := make(chan struct{})
+		nomad.ServiceInstancesStub = func(_, _ string) ([]*models.ServiceInstance, error) {
+			<-release
+			return nil, nil
+		}
+
+		watcher.SubscribeToServiceInstances("default", "web", func() {})
+
+		unsubscribed := make(chan struct{})
+		go func() {
+			watcher.Unsubscribe()
+			close(unsubscribed)
+		}()
+
+		select {
+		case <-unsubscribed:
+		case <-time.After(time.Second):
+			r.Fail("unsubscribing blocked on the poll")
+		}
+
+		close(release)
+		time.Sleep(time.Millisecond * 100)
+		r.Equal(1, nomad.ServiceInstancesCallCount())
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+)
+
+// WatchAllocStats polls the resource usage of the allocations returned
+// by allocIDs in the background, without replacing the current subscriber.
+// The subscriber is notified on TopicAllocStats after every poll. It
+// should be called after Subscribe, such that polling stops with the
+// next subscription, ie whenever the view is left.
+func (w *Watcher) WatchAllocStats(allocIDs func() []string) {
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.updateAllocStats(allocIDs())
+
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			w.Notify(models.TopicAllocStats)
+
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateAllocStats(allocIDs []string) {
+	// Keep the history of all running allocations, such
+	// that graphs don't start over when switching views.
+	running := []string{}
+	for _, alloc := range w.state.AllocationsSnapshot() {
+		if alloc.Status == models.StatusRunning {
+			running = append(running, alloc.ID)
+		}
+	}
+
+	w.state.AllocStats.Retain(running)
+
+	for _, id := range allocIDs {
+		usage, err := w.nomad.AllocStats(id)
+		if err != nil {
+			// The client of an allocation can be unreachable or
+			// the allocation just stopped. It is skipped rather
+			// than raising an error on every poll.
+			continue
+		}
+
+		w.state.AllocStats.Add(id, usage)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestWatchAllocStats(t *testing.T) {
+	t.Run("It polls the stats until a new subscription happens", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.Allocations = []*models.Alloc{
+			{ID: "moon", Status: models.StatusRunning},
+		}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+
+		nomad.AllocStatsReturns(&models.AllocUsage{
+			ResourceUsage: models.ResourceUsage{CPU: 250, MemoryBytes: 64 << 20},
+			Tasks: map[string]models.ResourceUsage{
+				"web": {CPU: 200},
+			},
+		}, nil)
+
+		notified := make(chan struct{}, 10)
+		watcher.Subscribe(func() {
+			notified <- struct{}{}
+		}, models.TopicAllocStats)
+		watcher.WatchAllocStats(func() []string {
+			return []string{"moon"}
+		})
+
+		<-notified
+		<-notified
+
+		r.Equal("moon", nomad.AllocStatsArgsForCall(0))
+
+		stats := state.AllocStats.Get("moon")
+		r.GreaterOrEqual(len(stats.Usage), 2)
+		r.Equal(float64(250), stats.Usage[0].CPU)
+		r.Equal(uint64(64<<20), stats.Usage[0].MemoryBytes)
+		r.Equal(float64(200), stats.Tasks["web"][0].CPU)
+
+		watcher.Subscribe(func() {}, models.TopicNamespace)
+		callCount := nomad.AllocStatsCallCount()
+
+		time.Sleep(time.Millisecond * 200)
+		r.LessOrEqual(nomad.AllocStatsCallCount(), callCount+1)
+	})
+
+	t.Run("It skips allocations without stats", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.Allocations = []*models.Alloc{
+			{ID: "moon", Status: models.StatusRunning},
+		}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.AllocStatsReturns(nil, errors.New("argh"))
+
+		errs := make(chan string, 10)
+		watcher.SubscribeHandler(models.HandleError, func(msg string, args ...interface{}) {
+			errs <- msg
+		})
+
+		notified := make(chan struct{}, 10)
+		watcher.Subscribe(func() {
+			notified <- struct{}{}
+		}, models.TopicAllocStats)
+		watcher.WatchAllocStats(func() []string {
+			return []string{"moon"}
+		})
+
+		<-notified
+
+		r.Nil(state.AllocStats.Get("moon"))
+		r.Empty(errs)
+	})
+
+	t.Run("It drops the stats of allocations which stopped", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.Allocations = []*models.Alloc{
+			{ID: "moon", Status: models.StatusRunning},
+			{ID: "sun", Status: models.StatusDead},
+		}
+		state.AllocStats.Add("sun", &models.AllocUsage{})
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.AllocStatsReturns(&models.AllocUsage{}, nil)
+
+		notified := make(chan struct{}, 10)
+		watcher.Subscribe(func() {
+			notified <- struct{}{}
+		}, models.TopicAllocStats)
+		watcher.WatchAllocStats(func() []string {
+			return []string{"moon"}
+		})
+
+		<-notified
+
+		r.NotNil(state.AllocStats.Get("moon"))
+		r.Nil(state.AllocStats.Get("sun"))
+	})
+}
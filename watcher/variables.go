@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+)
+
+// SubscribeToVariables starts a goroutine to poll the Variables of
+// all namespaces based on the provided interval. It updates the state
+// accordingly. The goroutine will be stopped whenever a new
+// subscription happens.
+func (w *Watcher) SubscribeToVariables(notify func()) {
+	w.updateVariables()
+	w.Subscribe(notify, models.TopicVariable)
+	w.Notify(models.TopicVariable)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateVariables()
+				w.Notify(models.TopicVariable)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateVariables() {
+	vars, err := w.nomad.Variables(&nomad.SearchOptions{Namespace: "*"})
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Variables = vars
+}
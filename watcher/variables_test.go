@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToVariables_Happy(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*250)
+
+	nomad.VariablesReturnsOnCall(0, []*models.Variable{
+		{Namespace: "default", Path: "foo"},
+	}, nil)
+
+	nomad.VariablesReturnsOnCall(1, []*models.Variable{
+		{Namespace: "default", Path: "foo"},
+		{Namespace: "default", Path: "foo/bar"},
+	}, nil)
+
+	done := make(chan struct{})
+
+	var callCount int
+	notifier := func() {
+		callCount++
+		switch callCount {
+		case 1:
+			r.Equal([]*models.Variable{{Namespace: "default", Path: "foo"}}, state.Variables)
+		case 2:
+			defer func() { done <- struct{}{} }()
+
+			r.Len(state.Variables, 2)
+		}
+	}
+
+	watcher.SubscribeToVariables(notifier)
+
+	<-done
+
+	so := nomad.VariablesArgsForCall(0)
+	r.Equal("*", so.Namespace)
+}
+
+func TestSubscribeToVariables_Sad(t *testing.T) {
+	r := require.New(t)
+
+	nomad := &watcherfakes.FakeNomad{}
+	state := state.New()
+	watcher := watcher.NewWatcher(state, nomad, time.Millisecond*250)
+
+	state.Variables = []*models.Variable{{Path: "foo"}}
+
+	var called bool
+	watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+		called = true
+	})
+
+	nomad.VariablesReturns(nil, errors.New("argh"))
+
+	watcher.SubscribeToVariables(func() {})
+
+	r.True(called)
+	r.Equal([]*models.Variable{{Path: "foo"}}, state.Variables)
+}
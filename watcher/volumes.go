@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher
+
+import (
+	"time"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/nomad"
+)
+
+// SubscribeToVolumes starts a goroutine to poll the CSI volumes of
+// all namespaces and the CSI plugins based on the provided interval.
+// It updates the state accordingly. The goroutine will be stopped
+// whenever a new subscription happens.
+func (w *Watcher) SubscribeToVolumes(notify func()) {
+	w.updateVolumes()
+	w.Subscribe(notify, models.TopicVolume)
+	w.Notify(models.TopicVolume)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateVolumes()
+				w.Notify(models.TopicVolume)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateVolumes() {
+	volumes, err := w.nomad.Volumes(&nomad.SearchOptions{Namespace: "*"})
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	plugins, err := w.nomad.CSIPlugins(nil)
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Volumes = volumes
+	w.state.CSIPlugins = plugins
+}
+
+// SubscribeToVolume starts a goroutine which polls a CSI volume with
+// the claims on it to update the state. The goroutine will be stopped
+// whenever a new subscription happens.
+func (w *Watcher) SubscribeToVolume(namespace, id string, notify func()) {
+	// drop the previous volume, such that its claims
+	// aren't shown if the first poll fails
+	w.state.Volume = nil
+	w.updateVolume(namespace, id)
+	w.Subscribe(notify, models.TopicVolumeClaim)
+	w.Notify(models.TopicVolumeClaim)
+
+	stop := make(chan struct{})
+	w.activities.Add(stop)
+
+	ticker := time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				w.updateVolume(namespace, id)
+				w.Notify(models.TopicVolumeClaim)
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *Watcher) updateVolume(namespace, id string) {
+	volume, err := w.nomad.Volume(namespace, id)
+	if err != nil {
+		w.NotifyHandler(models.HandleError, err.Error())
+		return
+	}
+
+	w.state.Volume = volume
+}
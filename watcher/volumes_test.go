@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2021, 2023
+// SPDX-License-Identifier: MPL-2.0
+
+package watcher_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hcjulz/damon/models"
+	"github.com/hcjulz/damon/state"
+	"github.com/hcjulz/damon/watcher"
+	"github.com/hcjulz/damon/watcher/watcherfakes"
+)
+
+func TestSubscribeToVolumes(t *testing.T) {
+	t.Run("It updates the volumes and plugins on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.VolumesReturnsOnCall(0, []*models.Volume{{ID: "pg"}}, nil)
+		nomad.VolumesReturnsOnCall(1, []*models.Volume{{ID: "pg", Writers: 1}}, nil)
+		nomad.CSIPluginsReturns([]*models.CSIPlugin{{ID: "ebs"}}, nil)
+
+		notified := make(chan []*models.Volume, 10)
+		watcher.SubscribeToVolumes(func() {
+			notified <- state.Volumes
+		})
+
+		r.Equal([]*models.Volume{{ID: "pg"}}, <-notified)
+		r.Equal([]*models.Volume{{ID: "pg", Writers: 1}}, <-notified)
+		r.Equal([]*models.CSIPlugin{{ID: "ebs"}}, state.CSIPlugins)
+		r.Equal("*", nomad.VolumesArgsForCall(0).Namespace)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.CSIPluginsReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToVolumes(func() {})
+
+		r.True(called)
+	})
+}
+
+func TestSubscribeToVolume(t *testing.T) {
+	t.Run("It notifies the subscriber initially and on every poll", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		nomad.VolumeReturnsOnCall(0, &models.Volume{ID: "pg"}, nil)
+		nomad.VolumeReturnsOnCall(1, &models.Volume{ID: "pg", Writers: 1}, nil)
+
+		notified := make(chan *models.Volume, 10)
+		watcher.SubscribeToVolume("default", "pg", func() {
+			notified <- state.Volume
+		})
+
+		r.Equal(&models.Volume{ID: "pg"}, <-notified)
+		r.Equal(&models.Volume{ID: "pg", Writers: 1}, <-notified)
+
+		namespace, id := nomad.VolumeArgsForCall(0)
+		r.Equal("default", namespace)
+		r.Equal("pg", id)
+	})
+
+	t.Run("It notifies the error handler on errors", func(t *testing.T) {
+		r := require.New(t)
+
+		nomad := &watcherfakes.FakeNomad{}
+		state := state.New()
+		state.Volume = &models.Volume{ID: "cache"}
+		watcher := watcher.NewWatcher(state, nomad, time.Millisecond*50)
+		defer watcher.Unsubscribe()
+
+		var called bool
+		watcher.SubscribeHandler(models.HandleError, func(_ string, _ ...interface{}) {
+			called = true
+		})
+
+		nomad.VolumeReturns(nil, errors.New("argh"))
+
+		watcher.SubscribeToVolume("default", "pg", func() {})
+
+		r.True(called)
+		r.Nil(state.Volume)
+	})
+}
@@ -4,6 +4,7 @@
 package watcher
 
 import (
+	"sync"
 	"time"
 
 	"github.com/hashicorp/nomad/api"
@@ -24,12 +25,32 @@ type Nomad interface {
 	Address() string
 	Jobs(*nomad.SearchOptions) ([]*models.Job, error)
 	JobStatus(string, *nomad.SearchOptions) (*models.JobStatus, error)
+	JobVersions(string, *nomad.SearchOptions) ([]*models.JobVersion, error)
 	Namespaces(*nomad.SearchOptions) ([]*models.Namespace, error)
 	Deployments(*nomad.SearchOptions) ([]*models.Deployment, error)
+	DeploymentDetail(namespace, deploymentID string) (*models.DeploymentDetail, error)
 	TaskGroups(string, *nomad.SearchOptions) ([]*models.TaskGroup, error)
 	Allocations(*nomad.SearchOptions) ([]*models.Alloc, error)
 	JobAllocs(string, *nomad.SearchOptions) ([]*models.Alloc, error)
-	Logs(allocID, taskNmae, logType string, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)
+	AllocStats(allocID string) (*models.AllocUsage, error)
+	AllocDetail(allocID string) (*models.AllocDetail, error)
+	Variables(*nomad.SearchOptions) ([]*models.Variable, error)
+	Services(*nomad.SearchOptions) ([]*models.Service, error)
+	ServiceInstances(namespace, name string) ([]*models.ServiceInstance, error)
+	Volumes(*nomad.SearchOptions) ([]*models.Volume, error)
+	Volume(namespace, id string) (*models.Volume, error)
+	CSIPlugins(*nomad.SearchOptions) ([]*models.CSIPlugin, error)
+	ACLPolicies() ([]*models.ACLPolicy, error)
+	ACLRoles() ([]*models.ACLRole, error)
+	ACLTokens() ([]*models.ACLToken, error)
+	Cluster() (*models.Cluster, error)
+	Servers() (*models.Cluster, error)
+	Nodes() ([]*models.Node, error)
+	NodeDetail(nodeID string) (*models.NodeDetail, error)
+	NodePools() ([]*models.NodePool, error)
+	Evaluations(*nomad.SearchOptions) ([]*models.Evaluation, error)
+	Logs(allocID, taskNmae, logType string, start *models.LogStart, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)
+	StreamFile(allocID, path string, start *models.LogStart, cancel <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)
 	Stream(topics nomad.Topics, index uint64) (<-chan *api.Events, error)
 }
 
@@ -45,13 +66,24 @@ type Watcher struct {
 
 	forceUpdate chan api.Topic
 	activities  Activities
+	follower    chan struct{}
 
 	interval time.Duration
+
+	// subscriberMutex guards the subscriber, which
+	// streams notify from their own goroutines.
+	subscriberMutex sync.RWMutex
 }
 
 type logResumer struct {
 	allocID, taskName, source string
 	notify                    func()
+
+	// scope is set for merged log streams
+	scope *models.LogScope
+
+	// path is set for files of an allocation
+	path string
 }
 
 type subscriber struct {
@@ -73,10 +105,12 @@ func NewWatcher(state *state.State, nomad Nomad, interval time.Duration) *Watche
 // Subscribe subscribes a function to a topic. This function should always be
 // called before Watcher.activities.Add().
 func (w *Watcher) Subscribe(notify func(), topics ...api.Topic) {
+	w.subscriberMutex.Lock()
 	w.subscriber = &subscriber{
 		topics: topics,
 		notify: notify,
 	}
+	w.subscriberMutex.Unlock()
 
 	// Whenever a subscription comes in make sure all running
 	// goroutines (expect the main (Watch)) are stopped.
@@ -84,12 +118,16 @@ func (w *Watcher) Subscribe(notify func(), topics ...api.Topic) {
 	// Deployments and Jobs, but polls the API for Namespaces,
 	// Allocations, and TaskGroups.
 	w.activities.DeactivateAll()
+	w.StopFollowing()
 }
 
 // Unsubscribe removes the current subscriber.
 func (w *Watcher) Unsubscribe() {
+	w.subscriberMutex.Lock()
 	w.subscriber = nil
+	w.subscriberMutex.Unlock()
 	w.activities.DeactivateAll()
+	w.StopFollowing()
 }
 
 // SubscribeHandler subscribes a handler to the watcher. This can be an for example an error
@@ -109,10 +147,14 @@ func (w *Watcher) NotifyHandler(handler models.Handler, msg string, args ...inte
 // Notify notifies the current subscriber on a specific topic (eg Jobs)
 // that data got updated in the state.
 func (w *Watcher) Notify(topic api.Topic) {
-	if w.subscriber != nil && w.subscriber.notify != nil {
-		for _, t := range w.subscriber.topics {
+	w.subscriberMutex.RLock()
+	sub := w.subscriber
+	w.subscriberMutex.RUnlock()
+
+	if sub != nil && sub.notify != nil {
+		for _, t := range sub.topics {
 			if t == topic {
-				w.subscriber.notify()
+				sub.notify()
 			}
 		}
 	}
@@ -190,5 +232,5 @@ func (w *Watcher) updateAllocations() {
 		w.NotifyHandler(models.HandleError, err.Error())
 	}
 
-	w.state.Allocations = allocs
+	w.state.SetAllocations(allocs)
 }
@@ -11,6 +11,42 @@ import (
 )
 
 type FakeNomad struct {
+	ACLPoliciesStub        func() ([]*models.ACLPolicy, error)
+	aCLPoliciesMutex       sync.RWMutex
+	aCLPoliciesArgsForCall []struct {
+	}
+	aCLPoliciesReturns struct {
+		result1 []*models.ACLPolicy
+		result2 error
+	}
+	aCLPoliciesReturnsOnCall map[int]struct {
+		result1 []*models.ACLPolicy
+		result2 error
+	}
+	ACLRolesStub        func() ([]*models.ACLRole, error)
+	aCLRolesMutex       sync.RWMutex
+	aCLRolesArgsForCall []struct {
+	}
+	aCLRolesReturns struct {
+		result1 []*models.ACLRole
+		result2 error
+	}
+	aCLRolesReturnsOnCall map[int]struct {
+		result1 []*models.ACLRole
+		result2 error
+	}
+	ACLTokensStub        func() ([]*models.ACLToken, error)
+	aCLTokensMutex       sync.RWMutex
+	aCLTokensArgsForCall []struct {
+	}
+	aCLTokensReturns struct {
+		result1 []*models.ACLToken
+		result2 error
+	}
+	aCLTokensReturnsOnCall map[int]struct {
+		result1 []*models.ACLToken
+		result2 error
+	}
 	AddressStub        func() string
 	addressMutex       sync.RWMutex
 	addressArgsForCall []struct {
@@ -21,6 +57,32 @@ type FakeNomad struct {
 	addressReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AllocDetailStub        func(string) (*models.AllocDetail, error)
+	allocDetailMutex       sync.RWMutex
+	allocDetailArgsForCall []struct {
+		arg1 string
+	}
+	allocDetailReturns struct {
+		result1 *models.AllocDetail
+		result2 error
+	}
+	allocDetailReturnsOnCall map[int]struct {
+		result1 *models.AllocDetail
+		result2 error
+	}
+	AllocStatsStub        func(string) (*models.AllocUsage, error)
+	allocStatsMutex       sync.RWMutex
+	allocStatsArgsForCall []struct {
+		arg1 string
+	}
+	allocStatsReturns struct {
+		result1 *models.AllocUsage
+		result2 error
+	}
+	allocStatsReturnsOnCall map[int]struct {
+		result1 *models.AllocUsage
+		result2 error
+	}
 	AllocationsStub        func(*nomad.SearchOptions) ([]*models.Alloc, error)
 	allocationsMutex       sync.RWMutex
 	allocationsArgsForCall []struct {
@@ -34,6 +96,45 @@ type FakeNomad struct {
 		result1 []*models.Alloc
 		result2 error
 	}
+	CSIPluginsStub        func(*nomad.SearchOptions) ([]*models.CSIPlugin, error)
+	cSIPluginsMutex       sync.RWMutex
+	cSIPluginsArgsForCall []struct {
+		arg1 *nomad.SearchOptions
+	}
+	cSIPluginsReturns struct {
+		result1 []*models.CSIPlugin
+		result2 error
+	}
+	cSIPluginsReturnsOnCall map[int]struct {
+		result1 []*models.CSIPlugin
+		result2 error
+	}
+	ClusterStub        func() (*models.Cluster, error)
+	clusterMutex       sync.RWMutex
+	clusterArgsForCall []struct {
+	}
+	clusterReturns struct {
+		result1 *models.Cluster
+		result2 error
+	}
+	clusterReturnsOnCall map[int]struct {
+		result1 *models.Cluster
+		result2 error
+	}
+	DeploymentDetailStub        func(string, string) (*models.DeploymentDetail, error)
+	deploymentDetailMutex       sync.RWMutex
+	deploymentDetailArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deploymentDetailReturns struct {
+		result1 *models.DeploymentDetail
+		result2 error
+	}
+	deploymentDetailReturnsOnCall map[int]struct {
+		result1 *models.DeploymentDetail
+		result2 error
+	}
 	DeploymentsStub        func(*nomad.SearchOptions) ([]*models.Deployment, error)
 	deploymentsMutex       sync.RWMutex
 	deploymentsArgsForCall []struct {
@@ -47,6 +148,19 @@ type FakeNomad struct {
 		result1 []*models.Deployment
 		result2 error
 	}
+	EvaluationsStub        func(*nomad.SearchOptions) ([]*models.Evaluation, error)
+	evaluationsMutex       sync.RWMutex
+	evaluationsArgsForCall []struct {
+		arg1 *nomad.SearchOptions
+	}
+	evaluationsReturns struct {
+		result1 []*models.Evaluation
+		result2 error
+	}
+	evaluationsReturnsOnCall map[int]struct {
+		result1 []*models.Evaluation
+		result2 error
+	}
 	JobAllocsStub        func(string, *nomad.SearchOptions) ([]*models.Alloc, error)
 	jobAllocsMutex       sync.RWMutex
 	jobAllocsArgsForCall []struct {
@@ -61,6 +175,34 @@ type FakeNomad struct {
 		result1 []*models.Alloc
 		result2 error
 	}
+	JobStatusStub        func(string, *nomad.SearchOptions) (*models.JobStatus, error)
+	jobStatusMutex       sync.RWMutex
+	jobStatusArgsForCall []struct {
+		arg1 string
+		arg2 *nomad.SearchOptions
+	}
+	jobStatusReturns struct {
+		result1 *models.JobStatus
+		result2 error
+	}
+	jobStatusReturnsOnCall map[int]struct {
+		result1 *models.JobStatus
+		result2 error
+	}
+	JobVersionsStub        func(string, *nomad.SearchOptions) ([]*models.JobVersion, error)
+	jobVersionsMutex       sync.RWMutex
+	jobVersionsArgsForCall []struct {
+		arg1 string
+		arg2 *nomad.SearchOptions
+	}
+	jobVersionsReturns struct {
+		result1 []*models.JobVersion
+		result2 error
+	}
+	jobVersionsReturnsOnCall map[int]struct {
+		result1 []*models.JobVersion
+		result2 error
+	}
 	JobsStub        func(*nomad.SearchOptions) ([]*models.Job, error)
 	jobsMutex       sync.RWMutex
 	jobsArgsForCall []struct {
@@ -74,13 +216,14 @@ type FakeNomad struct {
 		result1 []*models.Job
 		result2 error
 	}
-	LogsStub        func(string, string, string, <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)
+	LogsStub        func(string, string, string, *models.LogStart, <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)
 	logsMutex       sync.RWMutex
 	logsArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
-		arg4 <-chan struct{}
+		arg4 *models.LogStart
+		arg5 <-chan struct{}
 	}
 	logsReturns struct {
 		result1 <-chan *api.StreamFrame
@@ -103,18 +246,80 @@ type FakeNomad struct {
 		result1 []*models.Namespace
 		result2 error
 	}
-	JobStatusStub        func(string, *nomad.SearchOptions) (*models.JobStatus, error)
-	jobstatusMutex       sync.RWMutex
-	jobstatusArgsForCall []struct {
+	NodeDetailStub        func(string) (*models.NodeDetail, error)
+	nodeDetailMutex       sync.RWMutex
+	nodeDetailArgsForCall []struct {
 		arg1 string
-		arg2 *nomad.SearchOptions
 	}
-	jobstatusReturns struct {
-		result1 *models.JobStatus
+	nodeDetailReturns struct {
+		result1 *models.NodeDetail
 		result2 error
 	}
-	jobstatusReturnsOnCall map[int]struct {
-		result1 *models.JobStatus
+	nodeDetailReturnsOnCall map[int]struct {
+		result1 *models.NodeDetail
+		result2 error
+	}
+	NodePoolsStub        func() ([]*models.NodePool, error)
+	nodePoolsMutex       sync.RWMutex
+	nodePoolsArgsForCall []struct {
+	}
+	nodePoolsReturns struct {
+		result1 []*models.NodePool
+		result2 error
+	}
+	nodePoolsReturnsOnCall map[int]struct {
+		result1 []*models.NodePool
+		result2 error
+	}
+	NodesStub        func() ([]*models.Node, error)
+	nodesMutex       sync.RWMutex
+	nodesArgsForCall []struct {
+	}
+	nodesReturns struct {
+		result1 []*models.Node
+		result2 error
+	}
+	nodesReturnsOnCall map[int]struct {
+		result1 []*models.Node
+		result2 error
+	}
+	ServersStub        func() (*models.Cluster, error)
+	serversMutex       sync.RWMutex
+	serversArgsForCall []struct {
+	}
+	serversReturns struct {
+		result1 *models.Cluster
+		result2 error
+	}
+	serversReturnsOnCall map[int]struct {
+		result1 *models.Cluster
+		result2 error
+	}
+	ServiceInstancesStub        func(string, string) ([]*models.ServiceInstance, error)
+	serviceInstancesMutex       sync.RWMutex
+	serviceInstancesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	serviceInstancesReturns struct {
+		result1 []*models.ServiceInstance
+		result2 error
+	}
+	serviceInstancesReturnsOnCall map[int]struct {
+		result1 []*models.ServiceInstance
+		result2 error
+	}
+	ServicesStub        func(*nomad.SearchOptions) ([]*models.Service, error)
+	servicesMutex       sync.RWMutex
+	servicesArgsForCall []struct {
+		arg1 *nomad.SearchOptions
+	}
+	servicesReturns struct {
+		result1 []*models.Service
+		result2 error
+	}
+	servicesReturnsOnCall map[int]struct {
+		result1 []*models.Service
 		result2 error
 	}
 	StreamStub        func(nomad.Topics, uint64) (<-chan *api.Events, error)
@@ -131,6 +336,22 @@ type FakeNomad struct {
 		result1 <-chan *api.Events
 		result2 error
 	}
+	StreamFileStub        func(string, string, *models.LogStart, <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)
+	streamFileMutex       sync.RWMutex
+	streamFileArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *models.LogStart
+		arg4 <-chan struct{}
+	}
+	streamFileReturns struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}
+	streamFileReturnsOnCall map[int]struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}
 	TaskGroupsStub        func(string, *nomad.SearchOptions) ([]*models.TaskGroup, error)
 	taskGroupsMutex       sync.RWMutex
 	taskGroupsArgsForCall []struct {
@@ -145,10 +366,218 @@ type FakeNomad struct {
 		result1 []*models.TaskGroup
 		result2 error
 	}
+	VariablesStub        func(*nomad.SearchOptions) ([]*models.Variable, error)
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct {
+		arg1 *nomad.SearchOptions
+	}
+	variablesReturns struct {
+		result1 []*models.Variable
+		result2 error
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 []*models.Variable
+		result2 error
+	}
+	VolumeStub        func(string, string) (*models.Volume, error)
+	volumeMutex       sync.RWMutex
+	volumeArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	volumeReturns struct {
+		result1 *models.Volume
+		result2 error
+	}
+	volumeReturnsOnCall map[int]struct {
+		result1 *models.Volume
+		result2 error
+	}
+	VolumesStub        func(*nomad.SearchOptions) ([]*models.Volume, error)
+	volumesMutex       sync.RWMutex
+	volumesArgsForCall []struct {
+		arg1 *nomad.SearchOptions
+	}
+	volumesReturns struct {
+		result1 []*models.Volume
+		result2 error
+	}
+	volumesReturnsOnCall map[int]struct {
+		result1 []*models.Volume
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNomad) ACLPolicies() ([]*models.ACLPolicy, error) {
+	fake.aCLPoliciesMutex.Lock()
+	ret, specificReturn := fake.aCLPoliciesReturnsOnCall[len(fake.aCLPoliciesArgsForCall)]
+	fake.aCLPoliciesArgsForCall = append(fake.aCLPoliciesArgsForCall, struct {
+	}{})
+	stub := fake.ACLPoliciesStub
+	fakeReturns := fake.aCLPoliciesReturns
+	fake.recordInvocation("ACLPolicies", []interface{}{})
+	fake.aCLPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) ACLPoliciesCallCount() int {
+	fake.aCLPoliciesMutex.RLock()
+	defer fake.aCLPoliciesMutex.RUnlock()
+	return len(fake.aCLPoliciesArgsForCall)
+}
+
+func (fake *FakeNomad) ACLPoliciesCalls(stub func() ([]*models.ACLPolicy, error)) {
+	fake.aCLPoliciesMutex.Lock()
+	defer fake.aCLPoliciesMutex.Unlock()
+	fake.ACLPoliciesStub = stub
+}
+
+func (fake *FakeNomad) ACLPoliciesReturns(result1 []*models.ACLPolicy, result2 error) {
+	fake.aCLPoliciesMutex.Lock()
+	defer fake.aCLPoliciesMutex.Unlock()
+	fake.ACLPoliciesStub = nil
+	fake.aCLPoliciesReturns = struct {
+		result1 []*models.ACLPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) ACLPoliciesReturnsOnCall(i int, result1 []*models.ACLPolicy, result2 error) {
+	fake.aCLPoliciesMutex.Lock()
+	defer fake.aCLPoliciesMutex.Unlock()
+	fake.ACLPoliciesStub = nil
+	if fake.aCLPoliciesReturnsOnCall == nil {
+		fake.aCLPoliciesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ACLPolicy
+			result2 error
+		})
+	}
+	fake.aCLPoliciesReturnsOnCall[i] = struct {
+		result1 []*models.ACLPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) ACLRoles() ([]*models.ACLRole, error) {
+	fake.aCLRolesMutex.Lock()
+	ret, specificReturn := fake.aCLRolesReturnsOnCall[len(fake.aCLRolesArgsForCall)]
+	fake.aCLRolesArgsForCall = append(fake.aCLRolesArgsForCall, struct {
+	}{})
+	stub := fake.ACLRolesStub
+	fakeReturns := fake.aCLRolesReturns
+	fake.recordInvocation("ACLRoles", []interface{}{})
+	fake.aCLRolesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) ACLRolesCallCount() int {
+	fake.aCLRolesMutex.RLock()
+	defer fake.aCLRolesMutex.RUnlock()
+	return len(fake.aCLRolesArgsForCall)
+}
+
+func (fake *FakeNomad) ACLRolesCalls(stub func() ([]*models.ACLRole, error)) {
+	fake.aCLRolesMutex.Lock()
+	defer fake.aCLRolesMutex.Unlock()
+	fake.ACLRolesStub = stub
+}
+
+func (fake *FakeNomad) ACLRolesReturns(result1 []*models.ACLRole, result2 error) {
+	fake.aCLRolesMutex.Lock()
+	defer fake.aCLRolesMutex.Unlock()
+	fake.ACLRolesStub = nil
+	fake.aCLRolesReturns = struct {
+		result1 []*models.ACLRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) ACLRolesReturnsOnCall(i int, result1 []*models.ACLRole, result2 error) {
+	fake.aCLRolesMutex.Lock()
+	defer fake.aCLRolesMutex.Unlock()
+	fake.ACLRolesStub = nil
+	if fake.aCLRolesReturnsOnCall == nil {
+		fake.aCLRolesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ACLRole
+			result2 error
+		})
+	}
+	fake.aCLRolesReturnsOnCall[i] = struct {
+		result1 []*models.ACLRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) ACLTokens() ([]*models.ACLToken, error) {
+	fake.aCLTokensMutex.Lock()
+	ret, specificReturn := fake.aCLTokensReturnsOnCall[len(fake.aCLTokensArgsForCall)]
+	fake.aCLTokensArgsForCall = append(fake.aCLTokensArgsForCall, struct {
+	}{})
+	stub := fake.ACLTokensStub
+	fakeReturns := fake.aCLTokensReturns
+	fake.recordInvocation("ACLTokens", []interface{}{})
+	fake.aCLTokensMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) ACLTokensCallCount() int {
+	fake.aCLTokensMutex.RLock()
+	defer fake.aCLTokensMutex.RUnlock()
+	return len(fake.aCLTokensArgsForCall)
+}
+
+func (fake *FakeNomad) ACLTokensCalls(stub func() ([]*models.ACLToken, error)) {
+	fake.aCLTokensMutex.Lock()
+	defer fake.aCLTokensMutex.Unlock()
+	fake.ACLTokensStub = stub
+}
+
+func (fake *FakeNomad) ACLTokensReturns(result1 []*models.ACLToken, result2 error) {
+	fake.aCLTokensMutex.Lock()
+	defer fake.aCLTokensMutex.Unlock()
+	fake.ACLTokensStub = nil
+	fake.aCLTokensReturns = struct {
+		result1 []*models.ACLToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) ACLTokensReturnsOnCall(i int, result1 []*models.ACLToken, result2 error) {
+	fake.aCLTokensMutex.Lock()
+	defer fake.aCLTokensMutex.Unlock()
+	fake.ACLTokensStub = nil
+	if fake.aCLTokensReturnsOnCall == nil {
+		fake.aCLTokensReturnsOnCall = make(map[int]struct {
+			result1 []*models.ACLToken
+			result2 error
+		})
+	}
+	fake.aCLTokensReturnsOnCall[i] = struct {
+		result1 []*models.ACLToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNomad) Address() string {
 	fake.addressMutex.Lock()
 	ret, specificReturn := fake.addressReturnsOnCall[len(fake.addressArgsForCall)]
@@ -202,16 +631,16 @@ func (fake *FakeNomad) AddressReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeNomad) Allocations(arg1 *nomad.SearchOptions) ([]*models.Alloc, error) {
-	fake.allocationsMutex.Lock()
-	ret, specificReturn := fake.allocationsReturnsOnCall[len(fake.allocationsArgsForCall)]
-	fake.allocationsArgsForCall = append(fake.allocationsArgsForCall, struct {
-		arg1 *nomad.SearchOptions
+func (fake *FakeNomad) AllocDetail(arg1 string) (*models.AllocDetail, error) {
+	fake.allocDetailMutex.Lock()
+	ret, specificReturn := fake.allocDetailReturnsOnCall[len(fake.allocDetailArgsForCall)]
+	fake.allocDetailArgsForCall = append(fake.allocDetailArgsForCall, struct {
+		arg1 string
 	}{arg1})
-	stub := fake.AllocationsStub
-	fakeReturns := fake.allocationsReturns
-	fake.recordInvocation("Allocations", []interface{}{arg1})
-	fake.allocationsMutex.Unlock()
+	stub := fake.AllocDetailStub
+	fakeReturns := fake.allocDetailReturns
+	fake.recordInvocation("AllocDetail", []interface{}{arg1})
+	fake.allocDetailMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -221,61 +650,61 @@ func (fake *FakeNomad) Allocations(arg1 *nomad.SearchOptions) ([]*models.Alloc,
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeNomad) AllocationsCallCount() int {
-	fake.allocationsMutex.RLock()
-	defer fake.allocationsMutex.RUnlock()
-	return len(fake.allocationsArgsForCall)
+func (fake *FakeNomad) AllocDetailCallCount() int {
+	fake.allocDetailMutex.RLock()
+	defer fake.allocDetailMutex.RUnlock()
+	return len(fake.allocDetailArgsForCall)
 }
 
-func (fake *FakeNomad) AllocationsCalls(stub func(*nomad.SearchOptions) ([]*models.Alloc, error)) {
-	fake.allocationsMutex.Lock()
-	defer fake.allocationsMutex.Unlock()
-	fake.AllocationsStub = stub
+func (fake *FakeNomad) AllocDetailCalls(stub func(string) (*models.AllocDetail, error)) {
+	fake.allocDetailMutex.Lock()
+	defer fake.allocDetailMutex.Unlock()
+	fake.AllocDetailStub = stub
 }
 
-func (fake *FakeNomad) AllocationsArgsForCall(i int) *nomad.SearchOptions {
-	fake.allocationsMutex.RLock()
-	defer fake.allocationsMutex.RUnlock()
-	argsForCall := fake.allocationsArgsForCall[i]
+func (fake *FakeNomad) AllocDetailArgsForCall(i int) string {
+	fake.allocDetailMutex.RLock()
+	defer fake.allocDetailMutex.RUnlock()
+	argsForCall := fake.allocDetailArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeNomad) AllocationsReturns(result1 []*models.Alloc, result2 error) {
-	fake.allocationsMutex.Lock()
-	defer fake.allocationsMutex.Unlock()
-	fake.AllocationsStub = nil
-	fake.allocationsReturns = struct {
-		result1 []*models.Alloc
+func (fake *FakeNomad) AllocDetailReturns(result1 *models.AllocDetail, result2 error) {
+	fake.allocDetailMutex.Lock()
+	defer fake.allocDetailMutex.Unlock()
+	fake.AllocDetailStub = nil
+	fake.allocDetailReturns = struct {
+		result1 *models.AllocDetail
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) AllocationsReturnsOnCall(i int, result1 []*models.Alloc, result2 error) {
-	fake.allocationsMutex.Lock()
-	defer fake.allocationsMutex.Unlock()
-	fake.AllocationsStub = nil
-	if fake.allocationsReturnsOnCall == nil {
-		fake.allocationsReturnsOnCall = make(map[int]struct {
-			result1 []*models.Alloc
+func (fake *FakeNomad) AllocDetailReturnsOnCall(i int, result1 *models.AllocDetail, result2 error) {
+	fake.allocDetailMutex.Lock()
+	defer fake.allocDetailMutex.Unlock()
+	fake.AllocDetailStub = nil
+	if fake.allocDetailReturnsOnCall == nil {
+		fake.allocDetailReturnsOnCall = make(map[int]struct {
+			result1 *models.AllocDetail
 			result2 error
 		})
 	}
-	fake.allocationsReturnsOnCall[i] = struct {
-		result1 []*models.Alloc
+	fake.allocDetailReturnsOnCall[i] = struct {
+		result1 *models.AllocDetail
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) Deployments(arg1 *nomad.SearchOptions) ([]*models.Deployment, error) {
-	fake.deploymentsMutex.Lock()
-	ret, specificReturn := fake.deploymentsReturnsOnCall[len(fake.deploymentsArgsForCall)]
-	fake.deploymentsArgsForCall = append(fake.deploymentsArgsForCall, struct {
-		arg1 *nomad.SearchOptions
+func (fake *FakeNomad) AllocStats(arg1 string) (*models.AllocUsage, error) {
+	fake.allocStatsMutex.Lock()
+	ret, specificReturn := fake.allocStatsReturnsOnCall[len(fake.allocStatsArgsForCall)]
+	fake.allocStatsArgsForCall = append(fake.allocStatsArgsForCall, struct {
+		arg1 string
 	}{arg1})
-	stub := fake.DeploymentsStub
-	fakeReturns := fake.deploymentsReturns
-	fake.recordInvocation("Deployments", []interface{}{arg1})
-	fake.deploymentsMutex.Unlock()
+	stub := fake.AllocStatsStub
+	fakeReturns := fake.allocStatsReturns
+	fake.recordInvocation("AllocStats", []interface{}{arg1})
+	fake.allocStatsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -285,10 +714,323 @@ func (fake *FakeNomad) Deployments(arg1 *nomad.SearchOptions) ([]*models.Deploym
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeNomad) DeploymentsCallCount() int {
-	fake.deploymentsMutex.RLock()
-	defer fake.deploymentsMutex.RUnlock()
-	return len(fake.deploymentsArgsForCall)
+func (fake *FakeNomad) AllocStatsCallCount() int {
+	fake.allocStatsMutex.RLock()
+	defer fake.allocStatsMutex.RUnlock()
+	return len(fake.allocStatsArgsForCall)
+}
+
+func (fake *FakeNomad) AllocStatsCalls(stub func(string) (*models.AllocUsage, error)) {
+	fake.allocStatsMutex.Lock()
+	defer fake.allocStatsMutex.Unlock()
+	fake.AllocStatsStub = stub
+}
+
+func (fake *FakeNomad) AllocStatsArgsForCall(i int) string {
+	fake.allocStatsMutex.RLock()
+	defer fake.allocStatsMutex.RUnlock()
+	argsForCall := fake.allocStatsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) AllocStatsReturns(result1 *models.AllocUsage, result2 error) {
+	fake.allocStatsMutex.Lock()
+	defer fake.allocStatsMutex.Unlock()
+	fake.AllocStatsStub = nil
+	fake.allocStatsReturns = struct {
+		result1 *models.AllocUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) AllocStatsReturnsOnCall(i int, result1 *models.AllocUsage, result2 error) {
+	fake.allocStatsMutex.Lock()
+	defer fake.allocStatsMutex.Unlock()
+	fake.AllocStatsStub = nil
+	if fake.allocStatsReturnsOnCall == nil {
+		fake.allocStatsReturnsOnCall = make(map[int]struct {
+			result1 *models.AllocUsage
+			result2 error
+		})
+	}
+	fake.allocStatsReturnsOnCall[i] = struct {
+		result1 *models.AllocUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Allocations(arg1 *nomad.SearchOptions) ([]*models.Alloc, error) {
+	fake.allocationsMutex.Lock()
+	ret, specificReturn := fake.allocationsReturnsOnCall[len(fake.allocationsArgsForCall)]
+	fake.allocationsArgsForCall = append(fake.allocationsArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.AllocationsStub
+	fakeReturns := fake.allocationsReturns
+	fake.recordInvocation("Allocations", []interface{}{arg1})
+	fake.allocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) AllocationsCallCount() int {
+	fake.allocationsMutex.RLock()
+	defer fake.allocationsMutex.RUnlock()
+	return len(fake.allocationsArgsForCall)
+}
+
+func (fake *FakeNomad) AllocationsCalls(stub func(*nomad.SearchOptions) ([]*models.Alloc, error)) {
+	fake.allocationsMutex.Lock()
+	defer fake.allocationsMutex.Unlock()
+	fake.AllocationsStub = stub
+}
+
+func (fake *FakeNomad) AllocationsArgsForCall(i int) *nomad.SearchOptions {
+	fake.allocationsMutex.RLock()
+	defer fake.allocationsMutex.RUnlock()
+	argsForCall := fake.allocationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) AllocationsReturns(result1 []*models.Alloc, result2 error) {
+	fake.allocationsMutex.Lock()
+	defer fake.allocationsMutex.Unlock()
+	fake.AllocationsStub = nil
+	fake.allocationsReturns = struct {
+		result1 []*models.Alloc
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) AllocationsReturnsOnCall(i int, result1 []*models.Alloc, result2 error) {
+	fake.allocationsMutex.Lock()
+	defer fake.allocationsMutex.Unlock()
+	fake.AllocationsStub = nil
+	if fake.allocationsReturnsOnCall == nil {
+		fake.allocationsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Alloc
+			result2 error
+		})
+	}
+	fake.allocationsReturnsOnCall[i] = struct {
+		result1 []*models.Alloc
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) CSIPlugins(arg1 *nomad.SearchOptions) ([]*models.CSIPlugin, error) {
+	fake.cSIPluginsMutex.Lock()
+	ret, specificReturn := fake.cSIPluginsReturnsOnCall[len(fake.cSIPluginsArgsForCall)]
+	fake.cSIPluginsArgsForCall = append(fake.cSIPluginsArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.CSIPluginsStub
+	fakeReturns := fake.cSIPluginsReturns
+	fake.recordInvocation("CSIPlugins", []interface{}{arg1})
+	fake.cSIPluginsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) CSIPluginsCallCount() int {
+	fake.cSIPluginsMutex.RLock()
+	defer fake.cSIPluginsMutex.RUnlock()
+	return len(fake.cSIPluginsArgsForCall)
+}
+
+func (fake *FakeNomad) CSIPluginsCalls(stub func(*nomad.SearchOptions) ([]*models.CSIPlugin, error)) {
+	fake.cSIPluginsMutex.Lock()
+	defer fake.cSIPluginsMutex.Unlock()
+	fake.CSIPluginsStub = stub
+}
+
+func (fake *FakeNomad) CSIPluginsArgsForCall(i int) *nomad.SearchOptions {
+	fake.cSIPluginsMutex.RLock()
+	defer fake.cSIPluginsMutex.RUnlock()
+	argsForCall := fake.cSIPluginsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) CSIPluginsReturns(result1 []*models.CSIPlugin, result2 error) {
+	fake.cSIPluginsMutex.Lock()
+	defer fake.cSIPluginsMutex.Unlock()
+	fake.CSIPluginsStub = nil
+	fake.cSIPluginsReturns = struct {
+		result1 []*models.CSIPlugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) CSIPluginsReturnsOnCall(i int, result1 []*models.CSIPlugin, result2 error) {
+	fake.cSIPluginsMutex.Lock()
+	defer fake.cSIPluginsMutex.Unlock()
+	fake.CSIPluginsStub = nil
+	if fake.cSIPluginsReturnsOnCall == nil {
+		fake.cSIPluginsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CSIPlugin
+			result2 error
+		})
+	}
+	fake.cSIPluginsReturnsOnCall[i] = struct {
+		result1 []*models.CSIPlugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Cluster() (*models.Cluster, error) {
+	fake.clusterMutex.Lock()
+	ret, specificReturn := fake.clusterReturnsOnCall[len(fake.clusterArgsForCall)]
+	fake.clusterArgsForCall = append(fake.clusterArgsForCall, struct {
+	}{})
+	stub := fake.ClusterStub
+	fakeReturns := fake.clusterReturns
+	fake.recordInvocation("Cluster", []interface{}{})
+	fake.clusterMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) ClusterCallCount() int {
+	fake.clusterMutex.RLock()
+	defer fake.clusterMutex.RUnlock()
+	return len(fake.clusterArgsForCall)
+}
+
+func (fake *FakeNomad) ClusterCalls(stub func() (*models.Cluster, error)) {
+	fake.clusterMutex.Lock()
+	defer fake.clusterMutex.Unlock()
+	fake.ClusterStub = stub
+}
+
+func (fake *FakeNomad) ClusterReturns(result1 *models.Cluster, result2 error) {
+	fake.clusterMutex.Lock()
+	defer fake.clusterMutex.Unlock()
+	fake.ClusterStub = nil
+	fake.clusterReturns = struct {
+		result1 *models.Cluster
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) ClusterReturnsOnCall(i int, result1 *models.Cluster, result2 error) {
+	fake.clusterMutex.Lock()
+	defer fake.clusterMutex.Unlock()
+	fake.ClusterStub = nil
+	if fake.clusterReturnsOnCall == nil {
+		fake.clusterReturnsOnCall = make(map[int]struct {
+			result1 *models.Cluster
+			result2 error
+		})
+	}
+	fake.clusterReturnsOnCall[i] = struct {
+		result1 *models.Cluster
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) DeploymentDetail(arg1 string, arg2 string) (*models.DeploymentDetail, error) {
+	fake.deploymentDetailMutex.Lock()
+	ret, specificReturn := fake.deploymentDetailReturnsOnCall[len(fake.deploymentDetailArgsForCall)]
+	fake.deploymentDetailArgsForCall = append(fake.deploymentDetailArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeploymentDetailStub
+	fakeReturns := fake.deploymentDetailReturns
+	fake.recordInvocation("DeploymentDetail", []interface{}{arg1, arg2})
+	fake.deploymentDetailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) DeploymentDetailCallCount() int {
+	fake.deploymentDetailMutex.RLock()
+	defer fake.deploymentDetailMutex.RUnlock()
+	return len(fake.deploymentDetailArgsForCall)
+}
+
+func (fake *FakeNomad) DeploymentDetailCalls(stub func(string, string) (*models.DeploymentDetail, error)) {
+	fake.deploymentDetailMutex.Lock()
+	defer fake.deploymentDetailMutex.Unlock()
+	fake.DeploymentDetailStub = stub
+}
+
+func (fake *FakeNomad) DeploymentDetailArgsForCall(i int) (string, string) {
+	fake.deploymentDetailMutex.RLock()
+	defer fake.deploymentDetailMutex.RUnlock()
+	argsForCall := fake.deploymentDetailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNomad) DeploymentDetailReturns(result1 *models.DeploymentDetail, result2 error) {
+	fake.deploymentDetailMutex.Lock()
+	defer fake.deploymentDetailMutex.Unlock()
+	fake.DeploymentDetailStub = nil
+	fake.deploymentDetailReturns = struct {
+		result1 *models.DeploymentDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) DeploymentDetailReturnsOnCall(i int, result1 *models.DeploymentDetail, result2 error) {
+	fake.deploymentDetailMutex.Lock()
+	defer fake.deploymentDetailMutex.Unlock()
+	fake.DeploymentDetailStub = nil
+	if fake.deploymentDetailReturnsOnCall == nil {
+		fake.deploymentDetailReturnsOnCall = make(map[int]struct {
+			result1 *models.DeploymentDetail
+			result2 error
+		})
+	}
+	fake.deploymentDetailReturnsOnCall[i] = struct {
+		result1 *models.DeploymentDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Deployments(arg1 *nomad.SearchOptions) ([]*models.Deployment, error) {
+	fake.deploymentsMutex.Lock()
+	ret, specificReturn := fake.deploymentsReturnsOnCall[len(fake.deploymentsArgsForCall)]
+	fake.deploymentsArgsForCall = append(fake.deploymentsArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.DeploymentsStub
+	fakeReturns := fake.deploymentsReturns
+	fake.recordInvocation("Deployments", []interface{}{arg1})
+	fake.deploymentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) DeploymentsCallCount() int {
+	fake.deploymentsMutex.RLock()
+	defer fake.deploymentsMutex.RUnlock()
+	return len(fake.deploymentsArgsForCall)
 }
 
 func (fake *FakeNomad) DeploymentsCalls(stub func(*nomad.SearchOptions) ([]*models.Deployment, error)) {
@@ -330,6 +1072,70 @@ func (fake *FakeNomad) DeploymentsReturnsOnCall(i int, result1 []*models.Deploym
 	}{result1, result2}
 }
 
+func (fake *FakeNomad) Evaluations(arg1 *nomad.SearchOptions) ([]*models.Evaluation, error) {
+	fake.evaluationsMutex.Lock()
+	ret, specificReturn := fake.evaluationsReturnsOnCall[len(fake.evaluationsArgsForCall)]
+	fake.evaluationsArgsForCall = append(fake.evaluationsArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.EvaluationsStub
+	fakeReturns := fake.evaluationsReturns
+	fake.recordInvocation("Evaluations", []interface{}{arg1})
+	fake.evaluationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) EvaluationsCallCount() int {
+	fake.evaluationsMutex.RLock()
+	defer fake.evaluationsMutex.RUnlock()
+	return len(fake.evaluationsArgsForCall)
+}
+
+func (fake *FakeNomad) EvaluationsCalls(stub func(*nomad.SearchOptions) ([]*models.Evaluation, error)) {
+	fake.evaluationsMutex.Lock()
+	defer fake.evaluationsMutex.Unlock()
+	fake.EvaluationsStub = stub
+}
+
+func (fake *FakeNomad) EvaluationsArgsForCall(i int) *nomad.SearchOptions {
+	fake.evaluationsMutex.RLock()
+	defer fake.evaluationsMutex.RUnlock()
+	argsForCall := fake.evaluationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) EvaluationsReturns(result1 []*models.Evaluation, result2 error) {
+	fake.evaluationsMutex.Lock()
+	defer fake.evaluationsMutex.Unlock()
+	fake.EvaluationsStub = nil
+	fake.evaluationsReturns = struct {
+		result1 []*models.Evaluation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) EvaluationsReturnsOnCall(i int, result1 []*models.Evaluation, result2 error) {
+	fake.evaluationsMutex.Lock()
+	defer fake.evaluationsMutex.Unlock()
+	fake.EvaluationsStub = nil
+	if fake.evaluationsReturnsOnCall == nil {
+		fake.evaluationsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Evaluation
+			result2 error
+		})
+	}
+	fake.evaluationsReturnsOnCall[i] = struct {
+		result1 []*models.Evaluation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNomad) JobAllocs(arg1 string, arg2 *nomad.SearchOptions) ([]*models.Alloc, error) {
 	fake.jobAllocsMutex.Lock()
 	ret, specificReturn := fake.jobAllocsReturnsOnCall[len(fake.jobAllocsArgsForCall)]
@@ -395,6 +1201,136 @@ func (fake *FakeNomad) JobAllocsReturnsOnCall(i int, result1 []*models.Alloc, re
 	}{result1, result2}
 }
 
+func (fake *FakeNomad) JobStatus(arg1 string, arg2 *nomad.SearchOptions) (*models.JobStatus, error) {
+	fake.jobStatusMutex.Lock()
+	ret, specificReturn := fake.jobStatusReturnsOnCall[len(fake.jobStatusArgsForCall)]
+	fake.jobStatusArgsForCall = append(fake.jobStatusArgsForCall, struct {
+		arg1 string
+		arg2 *nomad.SearchOptions
+	}{arg1, arg2})
+	stub := fake.JobStatusStub
+	fakeReturns := fake.jobStatusReturns
+	fake.recordInvocation("JobStatus", []interface{}{arg1, arg2})
+	fake.jobStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) JobStatusCallCount() int {
+	fake.jobStatusMutex.RLock()
+	defer fake.jobStatusMutex.RUnlock()
+	return len(fake.jobStatusArgsForCall)
+}
+
+func (fake *FakeNomad) JobStatusCalls(stub func(string, *nomad.SearchOptions) (*models.JobStatus, error)) {
+	fake.jobStatusMutex.Lock()
+	defer fake.jobStatusMutex.Unlock()
+	fake.JobStatusStub = stub
+}
+
+func (fake *FakeNomad) JobStatusArgsForCall(i int) (string, *nomad.SearchOptions) {
+	fake.jobStatusMutex.RLock()
+	defer fake.jobStatusMutex.RUnlock()
+	argsForCall := fake.jobStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNomad) JobStatusReturns(result1 *models.JobStatus, result2 error) {
+	fake.jobStatusMutex.Lock()
+	defer fake.jobStatusMutex.Unlock()
+	fake.JobStatusStub = nil
+	fake.jobStatusReturns = struct {
+		result1 *models.JobStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) JobStatusReturnsOnCall(i int, result1 *models.JobStatus, result2 error) {
+	fake.jobStatusMutex.Lock()
+	defer fake.jobStatusMutex.Unlock()
+	fake.JobStatusStub = nil
+	if fake.jobStatusReturnsOnCall == nil {
+		fake.jobStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.JobStatus
+			result2 error
+		})
+	}
+	fake.jobStatusReturnsOnCall[i] = struct {
+		result1 *models.JobStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) JobVersions(arg1 string, arg2 *nomad.SearchOptions) ([]*models.JobVersion, error) {
+	fake.jobVersionsMutex.Lock()
+	ret, specificReturn := fake.jobVersionsReturnsOnCall[len(fake.jobVersionsArgsForCall)]
+	fake.jobVersionsArgsForCall = append(fake.jobVersionsArgsForCall, struct {
+		arg1 string
+		arg2 *nomad.SearchOptions
+	}{arg1, arg2})
+	stub := fake.JobVersionsStub
+	fakeReturns := fake.jobVersionsReturns
+	fake.recordInvocation("JobVersions", []interface{}{arg1, arg2})
+	fake.jobVersionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) JobVersionsCallCount() int {
+	fake.jobVersionsMutex.RLock()
+	defer fake.jobVersionsMutex.RUnlock()
+	return len(fake.jobVersionsArgsForCall)
+}
+
+func (fake *FakeNomad) JobVersionsCalls(stub func(string, *nomad.SearchOptions) ([]*models.JobVersion, error)) {
+	fake.jobVersionsMutex.Lock()
+	defer fake.jobVersionsMutex.Unlock()
+	fake.JobVersionsStub = stub
+}
+
+func (fake *FakeNomad) JobVersionsArgsForCall(i int) (string, *nomad.SearchOptions) {
+	fake.jobVersionsMutex.RLock()
+	defer fake.jobVersionsMutex.RUnlock()
+	argsForCall := fake.jobVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNomad) JobVersionsReturns(result1 []*models.JobVersion, result2 error) {
+	fake.jobVersionsMutex.Lock()
+	defer fake.jobVersionsMutex.Unlock()
+	fake.JobVersionsStub = nil
+	fake.jobVersionsReturns = struct {
+		result1 []*models.JobVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) JobVersionsReturnsOnCall(i int, result1 []*models.JobVersion, result2 error) {
+	fake.jobVersionsMutex.Lock()
+	defer fake.jobVersionsMutex.Unlock()
+	fake.JobVersionsStub = nil
+	if fake.jobVersionsReturnsOnCall == nil {
+		fake.jobVersionsReturnsOnCall = make(map[int]struct {
+			result1 []*models.JobVersion
+			result2 error
+		})
+	}
+	fake.jobVersionsReturnsOnCall[i] = struct {
+		result1 []*models.JobVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNomad) Jobs(arg1 *nomad.SearchOptions) ([]*models.Job, error) {
 	fake.jobsMutex.Lock()
 	ret, specificReturn := fake.jobsReturnsOnCall[len(fake.jobsArgsForCall)]
@@ -459,21 +1395,325 @@ func (fake *FakeNomad) JobsReturnsOnCall(i int, result1 []*models.Job, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) Logs(arg1 string, arg2 string, arg3 string, arg4 <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
-	fake.logsMutex.Lock()
-	ret, specificReturn := fake.logsReturnsOnCall[len(fake.logsArgsForCall)]
-	fake.logsArgsForCall = append(fake.logsArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 string
-		arg4 <-chan struct{}
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.LogsStub
-	fakeReturns := fake.logsReturns
-	fake.recordInvocation("Logs", []interface{}{arg1, arg2, arg3, arg4})
-	fake.logsMutex.Unlock()
+func (fake *FakeNomad) Logs(arg1 string, arg2 string, arg3 string, arg4 *models.LogStart, arg5 <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
+	fake.logsMutex.Lock()
+	ret, specificReturn := fake.logsReturnsOnCall[len(fake.logsArgsForCall)]
+	fake.logsArgsForCall = append(fake.logsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *models.LogStart
+		arg5 <-chan struct{}
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.LogsStub
+	fakeReturns := fake.logsReturns
+	fake.recordInvocation("Logs", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.logsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) LogsCallCount() int {
+	fake.logsMutex.RLock()
+	defer fake.logsMutex.RUnlock()
+	return len(fake.logsArgsForCall)
+}
+
+func (fake *FakeNomad) LogsCalls(stub func(string, string, string, *models.LogStart, <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)) {
+	fake.logsMutex.Lock()
+	defer fake.logsMutex.Unlock()
+	fake.LogsStub = stub
+}
+
+func (fake *FakeNomad) LogsArgsForCall(i int) (string, string, string, *models.LogStart, <-chan struct{}) {
+	fake.logsMutex.RLock()
+	defer fake.logsMutex.RUnlock()
+	argsForCall := fake.logsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeNomad) LogsReturns(result1 <-chan *api.StreamFrame, result2 <-chan error) {
+	fake.logsMutex.Lock()
+	defer fake.logsMutex.Unlock()
+	fake.LogsStub = nil
+	fake.logsReturns = struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) LogsReturnsOnCall(i int, result1 <-chan *api.StreamFrame, result2 <-chan error) {
+	fake.logsMutex.Lock()
+	defer fake.logsMutex.Unlock()
+	fake.LogsStub = nil
+	if fake.logsReturnsOnCall == nil {
+		fake.logsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *api.StreamFrame
+			result2 <-chan error
+		})
+	}
+	fake.logsReturnsOnCall[i] = struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Namespaces(arg1 *nomad.SearchOptions) ([]*models.Namespace, error) {
+	fake.namespacesMutex.Lock()
+	ret, specificReturn := fake.namespacesReturnsOnCall[len(fake.namespacesArgsForCall)]
+	fake.namespacesArgsForCall = append(fake.namespacesArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.NamespacesStub
+	fakeReturns := fake.namespacesReturns
+	fake.recordInvocation("Namespaces", []interface{}{arg1})
+	fake.namespacesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) NamespacesCallCount() int {
+	fake.namespacesMutex.RLock()
+	defer fake.namespacesMutex.RUnlock()
+	return len(fake.namespacesArgsForCall)
+}
+
+func (fake *FakeNomad) NamespacesCalls(stub func(*nomad.SearchOptions) ([]*models.Namespace, error)) {
+	fake.namespacesMutex.Lock()
+	defer fake.namespacesMutex.Unlock()
+	fake.NamespacesStub = stub
+}
+
+func (fake *FakeNomad) NamespacesArgsForCall(i int) *nomad.SearchOptions {
+	fake.namespacesMutex.RLock()
+	defer fake.namespacesMutex.RUnlock()
+	argsForCall := fake.namespacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) NamespacesReturns(result1 []*models.Namespace, result2 error) {
+	fake.namespacesMutex.Lock()
+	defer fake.namespacesMutex.Unlock()
+	fake.NamespacesStub = nil
+	fake.namespacesReturns = struct {
+		result1 []*models.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) NamespacesReturnsOnCall(i int, result1 []*models.Namespace, result2 error) {
+	fake.namespacesMutex.Lock()
+	defer fake.namespacesMutex.Unlock()
+	fake.NamespacesStub = nil
+	if fake.namespacesReturnsOnCall == nil {
+		fake.namespacesReturnsOnCall = make(map[int]struct {
+			result1 []*models.Namespace
+			result2 error
+		})
+	}
+	fake.namespacesReturnsOnCall[i] = struct {
+		result1 []*models.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) NodeDetail(arg1 string) (*models.NodeDetail, error) {
+	fake.nodeDetailMutex.Lock()
+	ret, specificReturn := fake.nodeDetailReturnsOnCall[len(fake.nodeDetailArgsForCall)]
+	fake.nodeDetailArgsForCall = append(fake.nodeDetailArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NodeDetailStub
+	fakeReturns := fake.nodeDetailReturns
+	fake.recordInvocation("NodeDetail", []interface{}{arg1})
+	fake.nodeDetailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) NodeDetailCallCount() int {
+	fake.nodeDetailMutex.RLock()
+	defer fake.nodeDetailMutex.RUnlock()
+	return len(fake.nodeDetailArgsForCall)
+}
+
+func (fake *FakeNomad) NodeDetailCalls(stub func(string) (*models.NodeDetail, error)) {
+	fake.nodeDetailMutex.Lock()
+	defer fake.nodeDetailMutex.Unlock()
+	fake.NodeDetailStub = stub
+}
+
+func (fake *FakeNomad) NodeDetailArgsForCall(i int) string {
+	fake.nodeDetailMutex.RLock()
+	defer fake.nodeDetailMutex.RUnlock()
+	argsForCall := fake.nodeDetailArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) NodeDetailReturns(result1 *models.NodeDetail, result2 error) {
+	fake.nodeDetailMutex.Lock()
+	defer fake.nodeDetailMutex.Unlock()
+	fake.NodeDetailStub = nil
+	fake.nodeDetailReturns = struct {
+		result1 *models.NodeDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) NodeDetailReturnsOnCall(i int, result1 *models.NodeDetail, result2 error) {
+	fake.nodeDetailMutex.Lock()
+	defer fake.nodeDetailMutex.Unlock()
+	fake.NodeDetailStub = nil
+	if fake.nodeDetailReturnsOnCall == nil {
+		fake.nodeDetailReturnsOnCall = make(map[int]struct {
+			result1 *models.NodeDetail
+			result2 error
+		})
+	}
+	fake.nodeDetailReturnsOnCall[i] = struct {
+		result1 *models.NodeDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) NodePools() ([]*models.NodePool, error) {
+	fake.nodePoolsMutex.Lock()
+	ret, specificReturn := fake.nodePoolsReturnsOnCall[len(fake.nodePoolsArgsForCall)]
+	fake.nodePoolsArgsForCall = append(fake.nodePoolsArgsForCall, struct {
+	}{})
+	stub := fake.NodePoolsStub
+	fakeReturns := fake.nodePoolsReturns
+	fake.recordInvocation("NodePools", []interface{}{})
+	fake.nodePoolsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) NodePoolsCallCount() int {
+	fake.nodePoolsMutex.RLock()
+	defer fake.nodePoolsMutex.RUnlock()
+	return len(fake.nodePoolsArgsForCall)
+}
+
+func (fake *FakeNomad) NodePoolsCalls(stub func() ([]*models.NodePool, error)) {
+	fake.nodePoolsMutex.Lock()
+	defer fake.nodePoolsMutex.Unlock()
+	fake.NodePoolsStub = stub
+}
+
+func (fake *FakeNomad) NodePoolsReturns(result1 []*models.NodePool, result2 error) {
+	fake.nodePoolsMutex.Lock()
+	defer fake.nodePoolsMutex.Unlock()
+	fake.NodePoolsStub = nil
+	fake.nodePoolsReturns = struct {
+		result1 []*models.NodePool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) NodePoolsReturnsOnCall(i int, result1 []*models.NodePool, result2 error) {
+	fake.nodePoolsMutex.Lock()
+	defer fake.nodePoolsMutex.Unlock()
+	fake.NodePoolsStub = nil
+	if fake.nodePoolsReturnsOnCall == nil {
+		fake.nodePoolsReturnsOnCall = make(map[int]struct {
+			result1 []*models.NodePool
+			result2 error
+		})
+	}
+	fake.nodePoolsReturnsOnCall[i] = struct {
+		result1 []*models.NodePool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Nodes() ([]*models.Node, error) {
+	fake.nodesMutex.Lock()
+	ret, specificReturn := fake.nodesReturnsOnCall[len(fake.nodesArgsForCall)]
+	fake.nodesArgsForCall = append(fake.nodesArgsForCall, struct {
+	}{})
+	stub := fake.NodesStub
+	fakeReturns := fake.nodesReturns
+	fake.recordInvocation("Nodes", []interface{}{})
+	fake.nodesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) NodesCallCount() int {
+	fake.nodesMutex.RLock()
+	defer fake.nodesMutex.RUnlock()
+	return len(fake.nodesArgsForCall)
+}
+
+func (fake *FakeNomad) NodesCalls(stub func() ([]*models.Node, error)) {
+	fake.nodesMutex.Lock()
+	defer fake.nodesMutex.Unlock()
+	fake.NodesStub = stub
+}
+
+func (fake *FakeNomad) NodesReturns(result1 []*models.Node, result2 error) {
+	fake.nodesMutex.Lock()
+	defer fake.nodesMutex.Unlock()
+	fake.NodesStub = nil
+	fake.nodesReturns = struct {
+		result1 []*models.Node
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) NodesReturnsOnCall(i int, result1 []*models.Node, result2 error) {
+	fake.nodesMutex.Lock()
+	defer fake.nodesMutex.Unlock()
+	fake.NodesStub = nil
+	if fake.nodesReturnsOnCall == nil {
+		fake.nodesReturnsOnCall = make(map[int]struct {
+			result1 []*models.Node
+			result2 error
+		})
+	}
+	fake.nodesReturnsOnCall[i] = struct {
+		result1 []*models.Node
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Servers() (*models.Cluster, error) {
+	fake.serversMutex.Lock()
+	ret, specificReturn := fake.serversReturnsOnCall[len(fake.serversArgsForCall)]
+	fake.serversArgsForCall = append(fake.serversArgsForCall, struct {
+	}{})
+	stub := fake.ServersStub
+	fakeReturns := fake.serversReturns
+	fake.recordInvocation("Servers", []interface{}{})
+	fake.serversMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -481,63 +1721,57 @@ func (fake *FakeNomad) Logs(arg1 string, arg2 string, arg3 string, arg4 <-chan s
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeNomad) LogsCallCount() int {
-	fake.logsMutex.RLock()
-	defer fake.logsMutex.RUnlock()
-	return len(fake.logsArgsForCall)
-}
-
-func (fake *FakeNomad) LogsCalls(stub func(string, string, string, <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)) {
-	fake.logsMutex.Lock()
-	defer fake.logsMutex.Unlock()
-	fake.LogsStub = stub
+func (fake *FakeNomad) ServersCallCount() int {
+	fake.serversMutex.RLock()
+	defer fake.serversMutex.RUnlock()
+	return len(fake.serversArgsForCall)
 }
 
-func (fake *FakeNomad) LogsArgsForCall(i int) (string, string, string, <-chan struct{}) {
-	fake.logsMutex.RLock()
-	defer fake.logsMutex.RUnlock()
-	argsForCall := fake.logsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeNomad) ServersCalls(stub func() (*models.Cluster, error)) {
+	fake.serversMutex.Lock()
+	defer fake.serversMutex.Unlock()
+	fake.ServersStub = stub
 }
 
-func (fake *FakeNomad) LogsReturns(result1 <-chan *api.StreamFrame, result2 <-chan error) {
-	fake.logsMutex.Lock()
-	defer fake.logsMutex.Unlock()
-	fake.LogsStub = nil
-	fake.logsReturns = struct {
-		result1 <-chan *api.StreamFrame
-		result2 <-chan error
+func (fake *FakeNomad) ServersReturns(result1 *models.Cluster, result2 error) {
+	fake.serversMutex.Lock()
+	defer fake.serversMutex.Unlock()
+	fake.ServersStub = nil
+	fake.serversReturns = struct {
+		result1 *models.Cluster
+		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) LogsReturnsOnCall(i int, result1 <-chan *api.StreamFrame, result2 <-chan error) {
-	fake.logsMutex.Lock()
-	defer fake.logsMutex.Unlock()
-	fake.LogsStub = nil
-	if fake.logsReturnsOnCall == nil {
-		fake.logsReturnsOnCall = make(map[int]struct {
-			result1 <-chan *api.StreamFrame
-			result2 <-chan error
+func (fake *FakeNomad) ServersReturnsOnCall(i int, result1 *models.Cluster, result2 error) {
+	fake.serversMutex.Lock()
+	defer fake.serversMutex.Unlock()
+	fake.ServersStub = nil
+	if fake.serversReturnsOnCall == nil {
+		fake.serversReturnsOnCall = make(map[int]struct {
+			result1 *models.Cluster
+			result2 error
 		})
 	}
-	fake.logsReturnsOnCall[i] = struct {
-		result1 <-chan *api.StreamFrame
-		result2 <-chan error
+	fake.serversReturnsOnCall[i] = struct {
+		result1 *models.Cluster
+		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) Namespaces(arg1 *nomad.SearchOptions) ([]*models.Namespace, error) {
-	fake.namespacesMutex.Lock()
-	ret, specificReturn := fake.namespacesReturnsOnCall[len(fake.namespacesArgsForCall)]
-	fake.namespacesArgsForCall = append(fake.namespacesArgsForCall, struct {
-		arg1 *nomad.SearchOptions
-	}{arg1})
-	stub := fake.NamespacesStub
-	fakeReturns := fake.namespacesReturns
-	fake.recordInvocation("Namespaces", []interface{}{arg1})
-	fake.namespacesMutex.Unlock()
+func (fake *FakeNomad) ServiceInstances(arg1 string, arg2 string) ([]*models.ServiceInstance, error) {
+	fake.serviceInstancesMutex.Lock()
+	ret, specificReturn := fake.serviceInstancesReturnsOnCall[len(fake.serviceInstancesArgsForCall)]
+	fake.serviceInstancesArgsForCall = append(fake.serviceInstancesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ServiceInstancesStub
+	fakeReturns := fake.serviceInstancesReturns
+	fake.recordInvocation("ServiceInstances", []interface{}{arg1, arg2})
+	fake.serviceInstancesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -545,83 +1779,63 @@ func (fake *FakeNomad) Namespaces(arg1 *nomad.SearchOptions) ([]*models.Namespac
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeNomad) NamespacesCallCount() int {
-	fake.namespacesMutex.RLock()
-	defer fake.namespacesMutex.RUnlock()
-	return len(fake.namespacesArgsForCall)
+func (fake *FakeNomad) ServiceInstancesCallCount() int {
+	fake.serviceInstancesMutex.RLock()
+	defer fake.serviceInstancesMutex.RUnlock()
+	return len(fake.serviceInstancesArgsForCall)
 }
 
-func (fake *FakeNomad) NamespacesCalls(stub func(*nomad.SearchOptions) ([]*models.Namespace, error)) {
-	fake.namespacesMutex.Lock()
-	defer fake.namespacesMutex.Unlock()
-	fake.NamespacesStub = stub
+func (fake *FakeNomad) ServiceInstancesCalls(stub func(string, string) ([]*models.ServiceInstance, error)) {
+	fake.serviceInstancesMutex.Lock()
+	defer fake.serviceInstancesMutex.Unlock()
+	fake.ServiceInstancesStub = stub
 }
 
-func (fake *FakeNomad) NamespacesArgsForCall(i int) *nomad.SearchOptions {
-	fake.namespacesMutex.RLock()
-	defer fake.namespacesMutex.RUnlock()
-	argsForCall := fake.namespacesArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeNomad) ServiceInstancesArgsForCall(i int) (string, string) {
+	fake.serviceInstancesMutex.RLock()
+	defer fake.serviceInstancesMutex.RUnlock()
+	argsForCall := fake.serviceInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeNomad) NamespacesReturns(result1 []*models.Namespace, result2 error) {
-	fake.namespacesMutex.Lock()
-	defer fake.namespacesMutex.Unlock()
-	fake.NamespacesStub = nil
-	fake.namespacesReturns = struct {
-		result1 []*models.Namespace
+func (fake *FakeNomad) ServiceInstancesReturns(result1 []*models.ServiceInstance, result2 error) {
+	fake.serviceInstancesMutex.Lock()
+	defer fake.serviceInstancesMutex.Unlock()
+	fake.ServiceInstancesStub = nil
+	fake.serviceInstancesReturns = struct {
+		result1 []*models.ServiceInstance
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) NamespacesReturnsOnCall(i int, result1 []*models.Namespace, result2 error) {
-	fake.namespacesMutex.Lock()
-	defer fake.namespacesMutex.Unlock()
-	fake.NamespacesStub = nil
-	if fake.namespacesReturnsOnCall == nil {
-		fake.namespacesReturnsOnCall = make(map[int]struct {
-			result1 []*models.Namespace
+func (fake *FakeNomad) ServiceInstancesReturnsOnCall(i int, result1 []*models.ServiceInstance, result2 error) {
+	fake.serviceInstancesMutex.Lock()
+	defer fake.serviceInstancesMutex.Unlock()
+	fake.ServiceInstancesStub = nil
+	if fake.serviceInstancesReturnsOnCall == nil {
+		fake.serviceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []*models.ServiceInstance
 			result2 error
 		})
 	}
-	fake.namespacesReturnsOnCall[i] = struct {
-		result1 []*models.Namespace
+	fake.serviceInstancesReturnsOnCall[i] = struct {
+		result1 []*models.ServiceInstance
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) JobStatusCallCount() int {
-	fake.jobstatusMutex.RLock()
-	defer fake.jobstatusMutex.RUnlock()
-	return len(fake.jobstatusArgsForCall)
-}
-
-func (fake *FakeNomad) JobStatusCalls(stub func(string, *nomad.SearchOptions) (*models.JobStatus, error)) {
-	fake.jobstatusMutex.Lock()
-	defer fake.jobstatusMutex.Unlock()
-	fake.JobStatusStub = stub
-}
-
-func (fake *FakeNomad) JobStatusForCall(i int) *nomad.SearchOptions {
-	fake.namespacesMutex.RLock()
-	defer fake.namespacesMutex.RUnlock()
-	argsForCall := fake.namespacesArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakeNomad) JobStatus(arg1 string, arg2 *nomad.SearchOptions) (*models.JobStatus, error) {
-	fake.jobstatusMutex.Lock()
-	ret, specificReturn := fake.jobstatusReturnsOnCall[len(fake.jobstatusArgsForCall)]
-	fake.jobstatusArgsForCall = append(fake.jobstatusArgsForCall, struct {
-		arg1 string
-		arg2 *nomad.SearchOptions
-	}{arg1, arg2})
-	stub := fake.JobStatusStub
-	fakeReturns := fake.jobstatusReturns
-	fake.recordInvocation("JobStatus", []interface{}{arg1})
-	fake.jobstatusMutex.Unlock()
+func (fake *FakeNomad) Services(arg1 *nomad.SearchOptions) ([]*models.Service, error) {
+	fake.servicesMutex.Lock()
+	ret, specificReturn := fake.servicesReturnsOnCall[len(fake.servicesArgsForCall)]
+	fake.servicesArgsForCall = append(fake.servicesArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.ServicesStub
+	fakeReturns := fake.servicesReturns
+	fake.recordInvocation("Services", []interface{}{arg1})
+	fake.servicesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -629,28 +1843,47 @@ func (fake *FakeNomad) JobStatus(arg1 string, arg2 *nomad.SearchOptions) (*model
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeNomad) JobStatusReturnsOnCall(i int, result1 *models.JobStatus, result2 error) {
-	fake.jobstatusMutex.Lock()
-	defer fake.jobstatusMutex.Unlock()
-	fake.JobStatusStub = nil
-	if fake.jobstatusReturnsOnCall == nil {
-		fake.jobstatusReturnsOnCall = make(map[int]struct {
-			result1 *models.JobStatus
-			result2 error
-		})
-	}
-	fake.jobstatusReturnsOnCall[i] = struct {
-		result1 *models.JobStatus
+func (fake *FakeNomad) ServicesCallCount() int {
+	fake.servicesMutex.RLock()
+	defer fake.servicesMutex.RUnlock()
+	return len(fake.servicesArgsForCall)
+}
+
+func (fake *FakeNomad) ServicesCalls(stub func(*nomad.SearchOptions) ([]*models.Service, error)) {
+	fake.servicesMutex.Lock()
+	defer fake.servicesMutex.Unlock()
+	fake.ServicesStub = stub
+}
+
+func (fake *FakeNomad) ServicesArgsForCall(i int) *nomad.SearchOptions {
+	fake.servicesMutex.RLock()
+	defer fake.servicesMutex.RUnlock()
+	argsForCall := fake.servicesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) ServicesReturns(result1 []*models.Service, result2 error) {
+	fake.servicesMutex.Lock()
+	defer fake.servicesMutex.Unlock()
+	fake.ServicesStub = nil
+	fake.servicesReturns = struct {
+		result1 []*models.Service
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeNomad) JobStatusReturns(result1 *models.JobStatus, result2 error) {
-	fake.jobstatusMutex.Lock()
-	defer fake.jobstatusMutex.Unlock()
-	fake.JobStatusStub = nil
-	fake.jobstatusReturns = struct {
-		result1 *models.JobStatus
+func (fake *FakeNomad) ServicesReturnsOnCall(i int, result1 []*models.Service, result2 error) {
+	fake.servicesMutex.Lock()
+	defer fake.servicesMutex.Unlock()
+	fake.ServicesStub = nil
+	if fake.servicesReturnsOnCall == nil {
+		fake.servicesReturnsOnCall = make(map[int]struct {
+			result1 []*models.Service
+			result2 error
+		})
+	}
+	fake.servicesReturnsOnCall[i] = struct {
+		result1 []*models.Service
 		result2 error
 	}{result1, result2}
 }
@@ -720,6 +1953,73 @@ func (fake *FakeNomad) StreamReturnsOnCall(i int, result1 <-chan *api.Events, re
 	}{result1, result2}
 }
 
+func (fake *FakeNomad) StreamFile(arg1 string, arg2 string, arg3 *models.LogStart, arg4 <-chan struct{}) (<-chan *api.StreamFrame, <-chan error) {
+	fake.streamFileMutex.Lock()
+	ret, specificReturn := fake.streamFileReturnsOnCall[len(fake.streamFileArgsForCall)]
+	fake.streamFileArgsForCall = append(fake.streamFileArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *models.LogStart
+		arg4 <-chan struct{}
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StreamFileStub
+	fakeReturns := fake.streamFileReturns
+	fake.recordInvocation("StreamFile", []interface{}{arg1, arg2, arg3, arg4})
+	fake.streamFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) StreamFileCallCount() int {
+	fake.streamFileMutex.RLock()
+	defer fake.streamFileMutex.RUnlock()
+	return len(fake.streamFileArgsForCall)
+}
+
+func (fake *FakeNomad) StreamFileCalls(stub func(string, string, *models.LogStart, <-chan struct{}) (<-chan *api.StreamFrame, <-chan error)) {
+	fake.streamFileMutex.Lock()
+	defer fake.streamFileMutex.Unlock()
+	fake.StreamFileStub = stub
+}
+
+func (fake *FakeNomad) StreamFileArgsForCall(i int) (string, string, *models.LogStart, <-chan struct{}) {
+	fake.streamFileMutex.RLock()
+	defer fake.streamFileMutex.RUnlock()
+	argsForCall := fake.streamFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeNomad) StreamFileReturns(result1 <-chan *api.StreamFrame, result2 <-chan error) {
+	fake.streamFileMutex.Lock()
+	defer fake.streamFileMutex.Unlock()
+	fake.StreamFileStub = nil
+	fake.streamFileReturns = struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) StreamFileReturnsOnCall(i int, result1 <-chan *api.StreamFrame, result2 <-chan error) {
+	fake.streamFileMutex.Lock()
+	defer fake.streamFileMutex.Unlock()
+	fake.StreamFileStub = nil
+	if fake.streamFileReturnsOnCall == nil {
+		fake.streamFileReturnsOnCall = make(map[int]struct {
+			result1 <-chan *api.StreamFrame
+			result2 <-chan error
+		})
+	}
+	fake.streamFileReturnsOnCall[i] = struct {
+		result1 <-chan *api.StreamFrame
+		result2 <-chan error
+	}{result1, result2}
+}
+
 func (fake *FakeNomad) TaskGroups(arg1 string, arg2 *nomad.SearchOptions) ([]*models.TaskGroup, error) {
 	fake.taskGroupsMutex.Lock()
 	ret, specificReturn := fake.taskGroupsReturnsOnCall[len(fake.taskGroupsArgsForCall)]
@@ -785,29 +2085,262 @@ func (fake *FakeNomad) TaskGroupsReturnsOnCall(i int, result1 []*models.TaskGrou
 	}{result1, result2}
 }
 
+func (fake *FakeNomad) Variables(arg1 *nomad.SearchOptions) ([]*models.Variable, error) {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.VariablesStub
+	fakeReturns := fake.variablesReturns
+	fake.recordInvocation("Variables", []interface{}{arg1})
+	fake.variablesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakeNomad) VariablesCalls(stub func(*nomad.SearchOptions) ([]*models.Variable, error)) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = stub
+}
+
+func (fake *FakeNomad) VariablesArgsForCall(i int) *nomad.SearchOptions {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	argsForCall := fake.variablesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) VariablesReturns(result1 []*models.Variable, result2 error) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 []*models.Variable
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) VariablesReturnsOnCall(i int, result1 []*models.Variable, result2 error) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 []*models.Variable
+			result2 error
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 []*models.Variable
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Volume(arg1 string, arg2 string) (*models.Volume, error) {
+	fake.volumeMutex.Lock()
+	ret, specificReturn := fake.volumeReturnsOnCall[len(fake.volumeArgsForCall)]
+	fake.volumeArgsForCall = append(fake.volumeArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.VolumeStub
+	fakeReturns := fake.volumeReturns
+	fake.recordInvocation("Volume", []interface{}{arg1, arg2})
+	fake.volumeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) VolumeCallCount() int {
+	fake.volumeMutex.RLock()
+	defer fake.volumeMutex.RUnlock()
+	return len(fake.volumeArgsForCall)
+}
+
+func (fake *FakeNomad) VolumeCalls(stub func(string, string) (*models.Volume, error)) {
+	fake.volumeMutex.Lock()
+	defer fake.volumeMutex.Unlock()
+	fake.VolumeStub = stub
+}
+
+func (fake *FakeNomad) VolumeArgsForCall(i int) (string, string) {
+	fake.volumeMutex.RLock()
+	defer fake.volumeMutex.RUnlock()
+	argsForCall := fake.volumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNomad) VolumeReturns(result1 *models.Volume, result2 error) {
+	fake.volumeMutex.Lock()
+	defer fake.volumeMutex.Unlock()
+	fake.VolumeStub = nil
+	fake.volumeReturns = struct {
+		result1 *models.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) VolumeReturnsOnCall(i int, result1 *models.Volume, result2 error) {
+	fake.volumeMutex.Lock()
+	defer fake.volumeMutex.Unlock()
+	fake.VolumeStub = nil
+	if fake.volumeReturnsOnCall == nil {
+		fake.volumeReturnsOnCall = make(map[int]struct {
+			result1 *models.Volume
+			result2 error
+		})
+	}
+	fake.volumeReturnsOnCall[i] = struct {
+		result1 *models.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) Volumes(arg1 *nomad.SearchOptions) ([]*models.Volume, error) {
+	fake.volumesMutex.Lock()
+	ret, specificReturn := fake.volumesReturnsOnCall[len(fake.volumesArgsForCall)]
+	fake.volumesArgsForCall = append(fake.volumesArgsForCall, struct {
+		arg1 *nomad.SearchOptions
+	}{arg1})
+	stub := fake.VolumesStub
+	fakeReturns := fake.volumesReturns
+	fake.recordInvocation("Volumes", []interface{}{arg1})
+	fake.volumesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNomad) VolumesCallCount() int {
+	fake.volumesMutex.RLock()
+	defer fake.volumesMutex.RUnlock()
+	return len(fake.volumesArgsForCall)
+}
+
+func (fake *FakeNomad) VolumesCalls(stub func(*nomad.SearchOptions) ([]*models.Volume, error)) {
+	fake.volumesMutex.Lock()
+	defer fake.volumesMutex.Unlock()
+	fake.VolumesStub = stub
+}
+
+func (fake *FakeNomad) VolumesArgsForCall(i int) *nomad.SearchOptions {
+	fake.volumesMutex.RLock()
+	defer fake.volumesMutex.RUnlock()
+	argsForCall := fake.volumesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNomad) VolumesReturns(result1 []*models.Volume, result2 error) {
+	fake.volumesMutex.Lock()
+	defer fake.volumesMutex.Unlock()
+	fake.VolumesStub = nil
+	fake.volumesReturns = struct {
+		result1 []*models.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNomad) VolumesReturnsOnCall(i int, result1 []*models.Volume, result2 error) {
+	fake.volumesMutex.Lock()
+	defer fake.volumesMutex.Unlock()
+	fake.VolumesStub = nil
+	if fake.volumesReturnsOnCall == nil {
+		fake.volumesReturnsOnCall = make(map[int]struct {
+			result1 []*models.Volume
+			result2 error
+		})
+	}
+	fake.volumesReturnsOnCall[i] = struct {
+		result1 []*models.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNomad) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aCLPoliciesMutex.RLock()
+	defer fake.aCLPoliciesMutex.RUnlock()
+	fake.aCLRolesMutex.RLock()
+	defer fake.aCLRolesMutex.RUnlock()
+	fake.aCLTokensMutex.RLock()
+	defer fake.aCLTokensMutex.RUnlock()
 	fake.addressMutex.RLock()
 	defer fake.addressMutex.RUnlock()
+	fake.allocDetailMutex.RLock()
+	defer fake.allocDetailMutex.RUnlock()
+	fake.allocStatsMutex.RLock()
+	defer fake.allocStatsMutex.RUnlock()
 	fake.allocationsMutex.RLock()
 	defer fake.allocationsMutex.RUnlock()
+	fake.cSIPluginsMutex.RLock()
+	defer fake.cSIPluginsMutex.RUnlock()
+	fake.clusterMutex.RLock()
+	defer fake.clusterMutex.RUnlock()
+	fake.deploymentDetailMutex.RLock()
+	defer fake.deploymentDetailMutex.RUnlock()
 	fake.deploymentsMutex.RLock()
 	defer fake.deploymentsMutex.RUnlock()
+	fake.evaluationsMutex.RLock()
+	defer fake.evaluationsMutex.RUnlock()
 	fake.jobAllocsMutex.RLock()
 	defer fake.jobAllocsMutex.RUnlock()
+	fake.jobStatusMutex.RLock()
+	defer fake.jobStatusMutex.RUnlock()
+	fake.jobVersionsMutex.RLock()
+	defer fake.jobVersionsMutex.RUnlock()
 	fake.jobsMutex.RLock()
 	defer fake.jobsMutex.RUnlock()
 	fake.logsMutex.RLock()
 	defer fake.logsMutex.RUnlock()
 	fake.namespacesMutex.RLock()
 	defer fake.namespacesMutex.RUnlock()
+	fake.nodeDetailMutex.RLock()
+	defer fake.nodeDetailMutex.RUnlock()
+	fake.nodePoolsMutex.RLock()
+	defer fake.nodePoolsMutex.RUnlock()
+	fake.nodesMutex.RLock()
+	defer fake.nodesMutex.RUnlock()
+	fake.serversMutex.RLock()
+	defer fake.serversMutex.RUnlock()
+	fake.serviceInstancesMutex.RLock()
+	defer fake.serviceInstancesMutex.RUnlock()
+	fake.servicesMutex.RLock()
+	defer fake.servicesMutex.RUnlock()
 	fake.streamMutex.RLock()
 	defer fake.streamMutex.RUnlock()
+	fake.streamFileMutex.RLock()
+	defer fake.streamFileMutex.RUnlock()
 	fake.taskGroupsMutex.RLock()
 	defer fake.taskGroupsMutex.RUnlock()
-	fake.jobstatusMutex.RLock()
-	defer fake.jobstatusMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	fake.volumeMutex.RLock()
+	defer fake.volumeMutex.RUnlock()
+	fake.volumesMutex.RLock()
+	defer fake.volumesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value